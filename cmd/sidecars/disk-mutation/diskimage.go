@@ -78,9 +78,10 @@ func OnDefineDomain(log *log.Logger, vmiJSON, domainXML []byte) (string, error)
 }
 
 func main() {
-	var vmiJSON, domainXML string
+	var vmiJSON, domainXML, migrationContext string
 	pflag.StringVar(&vmiJSON, "vmi", "", "VMI to change in JSON format")
 	pflag.StringVar(&domainXML, "domain", "", "Domain spec in XML format")
+	pflag.StringVar(&migrationContext, "migration-context", "", "Migration context in JSON format, only set on the migration target")
 	pflag.Parse()
 
 	logger := log.New(os.Stderr, "diskimage", log.Ldate)
@@ -89,6 +90,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	if migrationContext != "" {
+		// The domain received from the source was already mutated
+		fmt.Println(domainXML)
+		return
+	}
+
 	domainXML, err := OnDefineDomain(logger, []byte(vmiJSON), []byte(domainXML))
 	if err != nil {
 		logger.Printf("OnDefineDomain failed: %s", err)
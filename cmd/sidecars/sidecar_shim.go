@@ -46,6 +46,7 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 )
 
 const (
@@ -53,8 +54,15 @@ const (
 	preCloudInitIsoLoggingMessage = "PreCloudInitIso method has been called"
 	onShutdownMessage             = "Hook's Shutdown callback method has been called"
 
-	onDefineDomainBin  = "onDefineDomain"
-	preCloudInitIsoBin = "preCloudInitIso"
+	onDefineDomainBin        = "onDefineDomain"
+	preCloudInitIsoBin       = "preCloudInitIso"
+	onVMStartedBin           = "onVMStarted"
+	preMigrationBin          = "preMigration"
+	postMigrationBin         = "postMigration"
+	onHotplugBin             = "onHotplug"
+	onPauseBin               = "onPause"
+	onUnpauseBin             = "onUnpause"
+	onGuestAgentConnectedBin = "onGuestAgentConnected"
 )
 
 type infoServer struct {
@@ -67,6 +75,15 @@ func (s infoServer) Info(ctx context.Context, params *hooksInfo.InfoParams) (*ho
 		hooksInfo.OnDefineDomainHookPointName:  onDefineDomainBin,
 		hooksInfo.PreCloudInitIsoHookPointName: preCloudInitIsoBin,
 	}
+	if s.Version == hooksV1alpha4.Version {
+		supportedHookPoints[hooksInfo.OnVMStartedHookPointName] = onVMStartedBin
+		supportedHookPoints[hooksInfo.PreMigrationHookPointName] = preMigrationBin
+		supportedHookPoints[hooksInfo.PostMigrationHookPointName] = postMigrationBin
+		supportedHookPoints[hooksInfo.OnHotplugHookPointName] = onHotplugBin
+		supportedHookPoints[hooksInfo.OnPauseHookPointName] = onPauseBin
+		supportedHookPoints[hooksInfo.OnUnpauseHookPointName] = onUnpauseBin
+		supportedHookPoints[hooksInfo.OnGuestAgentConnectedHookPointName] = onGuestAgentConnectedBin
+	}
 	var hookPoints = []*hooksInfo.HookPoint{}
 
 	// Shutdown fixes proper termination of Sidecars. It isn't related to
@@ -106,6 +123,74 @@ type v1Alpha2Server struct{}
 type v1Alpha3Server struct {
 	done chan struct{}
 }
+type v1Alpha4Server struct {
+	done chan struct{}
+}
+
+func (s v1Alpha4Server) OnDefineDomain(_ context.Context, params *hooksV1alpha4.OnDefineDomainParams) (*hooksV1alpha4.OnDefineDomainResult, error) {
+	log.Log.Info(onDefineDomainLoggingMessage)
+	var extraArgs []string
+	if migrationContext := params.GetMigrationContext(); migrationContext != nil {
+		extraArgs = append(extraArgs, "--migration-context", string(migrationContext))
+	}
+	newDomainXML, err := runOnDefineDomain(params.GetVmi(), params.GetDomainXML(), extraArgs...)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed OnDefineDomain")
+		return nil, err
+	}
+	return &hooksV1alpha4.OnDefineDomainResult{
+		DomainXML: newDomainXML,
+	}, nil
+}
+
+func (s v1Alpha4Server) PreCloudInitIso(_ context.Context, params *hooksV1alpha4.PreCloudInitIsoParams) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	log.Log.Info(preCloudInitIsoLoggingMessage)
+	cloudInitData, err := runPreCloudInitIso(params.GetVmi(), params.GetCloudInitData())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed ProCloudInitIso")
+		return nil, err
+	}
+	return &hooksV1alpha4.PreCloudInitIsoResult{
+		CloudInitData: cloudInitData,
+	}, nil
+}
+
+func (s v1Alpha4Server) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	log.Log.Info(onShutdownMessage)
+	s.done <- struct{}{}
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
+
+func (s v1Alpha4Server) OnVMStarted(_ context.Context, params *hooksV1alpha4.OnVMStartedParams) (*hooksV1alpha4.OnVMStartedResult, error) {
+	return &hooksV1alpha4.OnVMStartedResult{}, runLifecycleHook(onVMStartedBin, params.GetVmi())
+}
+
+func (s v1Alpha4Server) PreMigration(_ context.Context, params *hooksV1alpha4.PreMigrationParams) (*hooksV1alpha4.PreMigrationResult, error) {
+	return &hooksV1alpha4.PreMigrationResult{}, runLifecycleHook(preMigrationBin, params.GetVmi())
+}
+
+func (s v1Alpha4Server) PostMigration(_ context.Context, params *hooksV1alpha4.PostMigrationParams) (*hooksV1alpha4.PostMigrationResult, error) {
+	return &hooksV1alpha4.PostMigrationResult{}, runLifecycleHook(postMigrationBin, params.GetVmi())
+}
+
+func (s v1Alpha4Server) OnHotplug(_ context.Context, params *hooksV1alpha4.OnHotplugParams) (*hooksV1alpha4.OnHotplugResult, error) {
+	return &hooksV1alpha4.OnHotplugResult{}, runLifecycleHook(onHotplugBin, params.GetVmi(),
+		"--device-type", params.GetDeviceType(),
+		"--device-name", params.GetDeviceName(),
+		"--action", params.GetAction())
+}
+
+func (s v1Alpha4Server) OnPause(_ context.Context, params *hooksV1alpha4.OnPauseParams) (*hooksV1alpha4.OnPauseResult, error) {
+	return &hooksV1alpha4.OnPauseResult{}, runLifecycleHook(onPauseBin, params.GetVmi())
+}
+
+func (s v1Alpha4Server) OnUnpause(_ context.Context, params *hooksV1alpha4.OnUnpauseParams) (*hooksV1alpha4.OnUnpauseResult, error) {
+	return &hooksV1alpha4.OnUnpauseResult{}, runLifecycleHook(onUnpauseBin, params.GetVmi())
+}
+
+func (s v1Alpha4Server) OnGuestAgentConnected(_ context.Context, params *hooksV1alpha4.OnGuestAgentConnectedParams) (*hooksV1alpha4.OnGuestAgentConnectedResult, error) {
+	return &hooksV1alpha4.OnGuestAgentConnectedResult{}, runLifecycleHook(onGuestAgentConnectedBin, params.GetVmi())
+}
 
 func (s v1Alpha3Server) OnDefineDomain(_ context.Context, params *hooksV1alpha3.OnDefineDomainParams) (*hooksV1alpha3.OnDefineDomainResult, error) {
 	log.Log.Info(onDefineDomainLoggingMessage)
@@ -205,7 +290,7 @@ func runPreCloudInitIso(vmiJSON []byte, cloudInitDataJSON []byte) ([]byte, error
 	return command.Output()
 }
 
-func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
+func runOnDefineDomain(vmiJSON []byte, domainXML []byte, extraArgs ...string) ([]byte, error) {
 	if _, err := exec.LookPath(onDefineDomainBin); err != nil {
 		return nil, fmt.Errorf("Failed in finding %s in $PATH due %v", onDefineDomainBin, err)
 	}
@@ -218,6 +303,7 @@ func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
 	args := append([]string{},
 		"--vmi", string(vmiJSON),
 		"--domain", string(domainXML))
+	args = append(args, extraArgs...)
 
 	log.Log.Infof("Executing %s", onDefineDomainBin)
 	command := exec.Command(onDefineDomainBin, args...)
@@ -229,6 +315,29 @@ func runOnDefineDomain(vmiJSON []byte, domainXML []byte) ([]byte, error) {
 	return command.Output()
 }
 
+// runLifecycleHook runs the user binary of a lifecycle hook point, a non zero exit code is returned as error
+func runLifecycleHook(bin string, vmiJSON []byte, extraArgs ...string) error {
+	log.Log.Infof("%s method has been called", bin)
+	if _, err := exec.LookPath(bin); err != nil {
+		return fmt.Errorf("Failed in finding %s in $PATH due %v", bin, err)
+	}
+
+	args := append([]string{"--vmi", string(vmiJSON)}, extraArgs...)
+
+	log.Log.Infof("Executing %s", bin)
+	command := exec.Command(bin, args...)
+	if reader, err := command.StderrPipe(); err != nil {
+		log.Log.Reason(err).Infof("Could not pipe stderr")
+	} else {
+		go logStderr(reader, bin)
+	}
+	if _, err := command.Output(); err != nil {
+		log.Log.Reason(err).Errorf("Failed %s", bin)
+		return err
+	}
+	return nil
+}
+
 func logStderr(reader io.Reader, hookName string) {
 	scanner := bufio.NewScanner(reader)
 	scanner.Buffer(make([]byte, 1024), 512*1024)
@@ -242,7 +351,7 @@ func logStderr(reader io.Reader, hookName string) {
 }
 
 func parseCommandLineArgs() (string, error) {
-	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3"}
+	supportedVersions := []string{"v1alpha1", "v1alpha2", "v1alpha3", "v1alpha4"}
 	version := ""
 
 	pflag.StringVar(&version, "version", "", "hook version to use")
@@ -314,6 +423,7 @@ func main() {
 
 	shutdownChan := make(chan struct{})
 	hooksV1alpha3.RegisterCallbacksServer(server, v1Alpha3Server{done: shutdownChan})
+	hooksV1alpha4.RegisterCallbacksServer(server, v1Alpha4Server{done: shutdownChan})
 
 	// Handle signals to properly shutdown process
 	signalStopChan := make(chan os.Signal, 1)
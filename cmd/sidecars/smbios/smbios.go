@@ -87,9 +87,10 @@ func onDefineDomain(vmiJSON, domainXML []byte) (string, error) {
 }
 
 func main() {
-	var vmiJSON, domainXML string
+	var vmiJSON, domainXML, migrationContext string
 	pflag.StringVar(&vmiJSON, "vmi", "", "VMI to change in JSON format")
 	pflag.StringVar(&domainXML, "domain", "", "Domain spec in XML format")
+	pflag.StringVar(&migrationContext, "migration-context", "", "Migration context in JSON format, only set on the migration target")
 	pflag.Parse()
 
 	logger := log.New(os.Stderr, "smbios", log.Ldate)
@@ -98,6 +99,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	if migrationContext != "" {
+		// The domain received from the source was already mutated
+		fmt.Println(domainXML)
+		return
+	}
+
 	domainXML, err := onDefineDomain([]byte(vmiJSON), []byte(domainXML))
 	if err != nil {
 		logger.Printf("onDefineDomain failed: %s", err)
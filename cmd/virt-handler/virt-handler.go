@@ -110,6 +110,9 @@ const (
 	// Default port that virt-handler listens to console requests
 	defaultConsoleServerPort = 8186
 
+	// Default port that virt-handler accepts decentralized migrations from other clusters on
+	defaultMigrationEndpointPort = 8187
+
 	// Default period for resyncing virt-launcher domain cache
 	defaultDomainResyncPeriodSeconds = 300
 
@@ -119,6 +122,9 @@ const (
 	// Default ConfigMap name of CA
 	defaultCAConfigMapName = "kubevirt-ca"
 
+	// ConfigMap name of the CAs of the clusters exchanging decentralized migrations
+	migrationEndpointCAConfigMapName = "kubevirt-migration-endpoint-ca"
+
 	// Default certificate and key paths
 	defaultClientCertFilePath = "/etc/virt-handler/clientcertificates/tls.crt"
 	defaultClientKeyFilePath  = "/etc/virt-handler/clientcertificates/tls.key"
@@ -164,6 +170,10 @@ type virtHandlerApp struct {
 	clusterConfig         *virtconfig.ClusterConfig
 	reloadableRateLimiter *ratelimiter.ReloadableRateLimiter
 	caManager             kvtls.ClientCAManager
+
+	migrationEndpointServerTLSConfig *tls.Config
+	migrationEndpointClientTLSConfig *tls.Config
+	migrationEndpointPort            int
 }
 
 var (
@@ -255,6 +265,7 @@ func (app *virtHandlerApp) Run() {
 
 	vmiSourceInformer := factory.VMISourceHost(app.HostOverride)
 	vmiTargetInformer := factory.VMITargetHost(app.HostOverride)
+	migrationInformer := factory.VirtualMachineInstanceMigration()
 
 	// Wire Domain controller
 	domainSharedInformer, err := virtcache.NewSharedInformer(app.VirtShareDir, int(app.WatchdogTimeoutDuration.Seconds()), recorder, vmiSourceInformer.GetStore(), time.Duration(app.domainResyncPeriodSeconds)*time.Second)
@@ -302,7 +313,7 @@ func (app *virtHandlerApp) Run() {
 
 	app.clusterConfig.SetConfigModifiedCallback(vsockConfigCallback)
 
-	migrationProxy := migrationproxy.NewMigrationProxyManager(app.serverTLSConfig, app.clientTLSConfig, app.clusterConfig)
+	migrationProxy := migrationproxy.NewMigrationProxyManager(app.serverTLSConfig, app.clientTLSConfig, app.migrationEndpointClientTLSConfig, app.clusterConfig)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -340,6 +351,7 @@ func (app *virtHandlerApp) Run() {
 		app.KubeletPodsDir,
 		vmiSourceInformer,
 		vmiTargetInformer,
+		migrationInformer,
 		domainSharedInformer,
 		app.MaxDevices,
 		app.clusterConfig,
@@ -421,6 +433,9 @@ func (app *virtHandlerApp) Run() {
 
 	errCh := make(chan error)
 	go app.runServer(errCh, consoleHandler, lifecycleHandler)
+	if app.clusterConfig.DecentralizedLiveMigrationEnabled() {
+		go app.runMigrationEndpoint(errCh, stop, factory)
+	}
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt,
@@ -557,6 +572,7 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/unpause").To(lifecycleHandler.UnpauseHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/freeze").To(lifecycleHandler.FreezeHandler).Reads(v1.FreezeUnfreezeTimeout{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/unfreeze").To(lifecycleHandler.UnfreezeHandler))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestexec").To(lifecycleHandler.GuestExecHandler).Reads(v1.GuestExecOptions{}).Produces(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.GuestExecResult{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/softreboot").To(lifecycleHandler.SoftRebootHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo").To(lifecycleHandler.GetGuestInfo).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
@@ -576,6 +592,26 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	errCh <- server.ListenAndServeTLS("", "")
 }
 
+// runMigrationEndpoint accepts decentralized migrations from other clusters. It is only started if the
+// DecentralizedLiveMigration feature gate is enabled when virt-handler starts.
+func (app *virtHandlerApp) runMigrationEndpoint(errCh chan error, stop chan struct{}, factory controller.KubeInformerFactory) {
+	migrationInformer := factory.DecentralizedMigration()
+	vmiInformer := factory.VMIMigrationTarget()
+	factory.Start(stop)
+	cache.WaitForCacheSync(stop, migrationInformer.HasSynced, vmiInformer.HasSynced)
+
+	endpoint := migrationproxy.NewMigrationEndpoint(
+		app.ServiceListen.BindAddress,
+		app.migrationEndpointPort,
+		app.migrationEndpointServerTLSConfig,
+		app.clientTLSConfig,
+		migrationproxy.NewEndpointTargetLookup(migrationInformer.GetIndexer(), vmiInformer.GetStore(), app.virtCli, app.clusterConfig),
+	)
+	if err := endpoint.Run(stop); err != nil {
+		errCh <- fmt.Errorf("migration endpoint failed: %v", err)
+	}
+}
+
 func (app *virtHandlerApp) AddFlags() {
 	app.InitFlags()
 
@@ -638,6 +674,9 @@ func (app *virtHandlerApp) AddFlags() {
 	flag.IntVar(&app.consoleServerPort, "console-server-port", defaultConsoleServerPort,
 		"The port virt-handler listens on for console requests")
 
+	flag.IntVar(&app.migrationEndpointPort, "migration-endpoint-port", defaultMigrationEndpointPort,
+		"The port virt-handler accepts decentralized migrations from other clusters on")
+
 	flag.IntVar(&app.domainResyncPeriodSeconds, "domain-resync-period-seconds", defaultDomainResyncPeriodSeconds,
 		"Recurring period for resyncing all known virt-launcher domains.")
 
@@ -657,6 +696,11 @@ func (app *virtHandlerApp) setupTLS(factory controller.KubeInformerFactory) erro
 	app.serverTLSConfig = kvtls.SetupTLSForVirtHandlerServer(app.caManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
 	app.clientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(app.caManager, app.clientcertmanager, app.externallyManaged)
 
+	// virt-handlers of other clusters are authenticated with the CAs of the clusters exchanging decentralized migrations
+	migrationEndpointCAManager := kvtls.NewCAManager(factory.MigrationEndpointCAConfigMap().GetStore(), app.namespace, migrationEndpointCAConfigMapName)
+	app.migrationEndpointServerTLSConfig = kvtls.SetupTLSForVirtHandlerServer(migrationEndpointCAManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
+	app.migrationEndpointClientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(migrationEndpointCAManager, app.clientcertmanager, app.externallyManaged)
+
 	return nil
 }
 
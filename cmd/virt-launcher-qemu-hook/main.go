@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+// virt-launcher-qemu-hook is installed as libvirt qemu hook in virt-launcher pods with hook sidecars.
+// On the target of a live migration, libvirt passes the domain received from the source to the hook,
+// which lets the hook sidecars rewrite host specific elements before the domain is created.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+)
+
+const (
+	migrateOperation = "migrate"
+	collectTimeout   = 30 * time.Second
+)
+
+func main() {
+	log.InitializeLogging("virt-launcher-qemu-hook")
+
+	// libvirt calls the hook with the domain name, the operation, the sub-operation and an extra argument,
+	// the domain XML is passed on stdin. It is read in any case, so libvirt never writes to a closed pipe.
+	domainXML, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to read the domain XML")
+		os.Exit(1)
+	}
+
+	if len(os.Args) < 3 || os.Args[2] != migrateOperation {
+		os.Exit(0)
+	}
+
+	// All sidecars were already collected by virt-launcher, they are expected to be ready
+	sockets, err := os.ReadDir(hooks.HookSocketsSharedDirectory)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to list hook sidecar sockets")
+		os.Exit(1)
+	}
+
+	hookManager := hooks.GetManager()
+	if err := hookManager.Collect(uint(len(sockets)), collectTimeout); err != nil {
+		log.Log.Reason(err).Error("Failed to collect hook sidecars")
+		os.Exit(1)
+	}
+
+	newDomainXML, err := hookManager.OnDefineMigrationTargetDomain(string(domainXML))
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to run the migration target domain hooks")
+		os.Exit(1)
+	}
+
+	// libvirt replaces the incoming domain with the output
+	fmt.Fprint(os.Stdout, newDomainXML)
+}
@@ -47,6 +47,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	putil "kubevirt.io/kubevirt/pkg/util"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	virtlauncher "kubevirt.io/kubevirt/pkg/virt-launcher"
@@ -410,6 +411,12 @@ func main() {
 		panic(err)
 	}
 
+	if *hookSidecars > 0 {
+		if err := l.InstallQemuHook(); err != nil {
+			panic(err)
+		}
+	}
+
 	l.StartVirtqemud(stopChan)
 	// only single domain should be present
 	domainName := api.VMINamespaceKeyFunc(vmi)
@@ -497,6 +504,11 @@ func main() {
 		// exits, the wait loop breaks.
 		mon.RunForever(*qemuTimeout, signalStopChan)
 
+		// Allow network binding plugins to release the resources of the interfaces
+		if err := netbinding.GetPluginManager().Teardown(); err != nil {
+			log.Log.Reason(err).Error("Failed to tear down the network binding plugin interfaces")
+		}
+
 		// Allow hooks to gracefully shutdown
 		hookManager.Shutdown()
 
@@ -154,6 +154,7 @@ libguestfstools_extra="
 
 exportserverbase_main="
   tar
+  zstd
 "
 
 pr_helper="
@@ -57,6 +57,8 @@ import (
 	"kubevirt.io/api/core"
 	kubev1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/api/hooks"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	"kubevirt.io/api/migrations"
@@ -80,6 +82,9 @@ const (
 	NotOperatorLabel = kubev1.ManagedByLabel + " notin (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
 )
 
+// DecentralizedMigrationIndex indexes the migrations of decentralized migrations by namespace and migration ID
+const DecentralizedMigrationIndex = "decentralizedMigration"
+
 var unexpectedObjectError = errors.New("unexpected object")
 
 type newSharedInformer func() cache.SharedIndexInformer
@@ -102,6 +107,9 @@ type KubeInformerFactory interface {
 	// as a migration target
 	VMITargetHost(hostName string) cache.SharedIndexInformer
 
+	// Watches for vmi objects which are the target of a migration
+	VMIMigrationTarget() cache.SharedIndexInformer
+
 	// Watches for VirtualMachineInstanceReplicaSet objects
 	VMIReplicaSet() cache.SharedIndexInformer
 
@@ -123,6 +131,9 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineInstanceMigration objects
 	VirtualMachineInstanceMigration() cache.SharedIndexInformer
 
+	// Watches VirtualMachineInstanceMigration objects of decentralized migrations
+	DecentralizedMigration() cache.SharedIndexInformer
+
 	// Watches VirtualMachineExport objects
 	VirtualMachineExport() cache.SharedIndexInformer
 
@@ -132,12 +143,18 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineSnapshot objects
 	VirtualMachineSnapshotContent() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches HookSidecar objects
+	HookSidecar() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -162,6 +179,9 @@ type KubeInformerFactory interface {
 	// Watches for the kubevirt export CA config map
 	KubeVirtExportCAConfigMap() cache.SharedIndexInformer
 
+	// Watches for the config map with the CAs of the clusters exchanging decentralized migrations
+	MigrationEndpointCAConfigMap() cache.SharedIndexInformer
+
 	// Watches for the export route config map
 	ExportRouteConfigMap() cache.SharedIndexInformer
 
@@ -189,6 +209,12 @@ type KubeInformerFactory interface {
 	// Fake CDI DataSource informer used when feature gate is disabled
 	DummyDataSource() cache.SharedIndexInformer
 
+	// Watches for CDI ObjectTransfer objects
+	ObjectTransfer() cache.SharedIndexInformer
+
+	// Fake CDI ObjectTransfer informer used when feature gate is disabled
+	DummyObjectTransfer() cache.SharedIndexInformer
+
 	// Watches for CDI StorageProfile objects
 	StorageProfile() cache.SharedIndexInformer
 
@@ -489,6 +515,18 @@ func (f *kubeInformerFactory) VMITargetHost(hostName string) cache.SharedIndexIn
 	})
 }
 
+func (f *kubeInformerFactory) VMIMigrationTarget() cache.SharedIndexInformer {
+	labelSelector, err := labels.Parse(kubev1.MigrationTargetNodeNameLabel)
+	if err != nil {
+		panic(err)
+	}
+
+	return f.getInformer("vmiInformer-migrationTargets", func() cache.SharedIndexInformer {
+		lw := NewListWatchFromClient(f.restClient, "virtualmachineinstances", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachineInstance{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) VMIReplicaSet() cache.SharedIndexInformer {
 	return f.getInformer("vmirsInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachineinstancereplicasets", k8sv1.NamespaceAll, fields.Everything())
@@ -517,6 +555,24 @@ func (f *kubeInformerFactory) VirtualMachineInstanceMigration() cache.SharedInde
 	})
 }
 
+func (f *kubeInformerFactory) DecentralizedMigration() cache.SharedIndexInformer {
+	labelSelector, err := labels.Parse(kubev1.MigrationIDLabel)
+	if err != nil {
+		panic(err)
+	}
+
+	return f.getInformer("vmimInformer-decentralized", func() cache.SharedIndexInformer {
+		lw := NewListWatchFromClient(f.restClient, "virtualmachineinstancemigrations", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &kubev1.VirtualMachineInstanceMigration{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+			DecentralizedMigrationIndex: func(obj interface{}) ([]string, error) {
+				migration := obj.(*kubev1.VirtualMachineInstanceMigration)
+				return []string{NamespacedKey(migration.Namespace, migration.Labels[kubev1.MigrationIDLabel])}, nil
+			},
+		})
+	})
+}
+
 func (f *kubeInformerFactory) KubeVirtPod() cache.SharedIndexInformer {
 	return f.getInformer("kubeVirtPodInformer", func() cache.SharedIndexInformer {
 		// Watch all pods with the kubevirt app label
@@ -601,7 +657,11 @@ func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 			if export.Spec.Source.APIGroup != nil &&
 				*export.Spec.Source.APIGroup == snapshotv1.SchemeGroupVersion.Group &&
 				export.Spec.Source.Kind == "VirtualMachineSnapshot" {
-				return []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}, nil
+				keys := []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}
+				if export.Spec.BaseSnapshot != nil {
+					keys = append(keys, fmt.Sprintf("%s/%s", export.Namespace, *export.Spec.BaseSnapshot))
+				}
+				return keys, nil
 			}
 
 			return nil, nil
@@ -644,6 +704,18 @@ func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", vms.Namespace, vms.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"schedule": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if schedule, ok := vms.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, schedule)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -682,6 +754,13 @@ func (f *kubeInformerFactory) VirtualMachineSnapshotContent() cache.SharedIndexI
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func GetVirtualMachineRestoreInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
@@ -716,6 +795,13 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) HookSidecar() cache.SharedIndexInformer {
+	return f.getInformer("hookSidecarInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().HooksV1alpha1().RESTClient(), hooks.ResourceHookSidecars, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &hooksv1alpha1.HookSidecar{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clonev1alpha1.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
@@ -770,12 +856,56 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 				return nil, unexpectedObjectError
 			}
 
-			if vmClone.Status.Phase == clonev1alpha1.Succeeded && vmClone.Status.RestoreName != nil {
-				return []string{getkey(vmClone, *vmClone.Status.RestoreName)}, nil
+			if vmClone.Status.Phase != clonev1alpha1.Succeeded {
+				return nil, nil
+			}
+
+			if vmClone.Status.RestoreName != nil {
+				keys := []string{getkey(vmClone, *vmClone.Status.RestoreName)}
+				// restored volumes of a cross namespace clone are bound in the clone namespace
+				// and are then transferred into the target namespace
+				if targetNamespace := vmClone.Spec.TargetNamespace; targetNamespace != nil && *targetNamespace != "" && *targetNamespace != vmClone.Namespace {
+					keys = append(keys, fmt.Sprintf("%s/%s", *targetNamespace, *vmClone.Status.RestoreName))
+				}
+				return keys, nil
+			}
+
+			var keys []string
+			for _, replica := range vmClone.Status.Replicas {
+				if replica.RestoreName != nil {
+					keys = append(keys, getkey(vmClone, *replica.RestoreName))
+				}
+			}
+			if len(keys) > 0 {
+				return keys, nil
 			}
 
 			return nil, nil
 		},
+		// Gets: target vm key. Returns: clones that create the specified vm
+		"vmTarget": func(obj interface{}) ([]string, error) {
+			vmClone, ok := obj.(*clonev1alpha1.VirtualMachineClone)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			targetNamespace := vmClone.Namespace
+			if vmClone.Spec.TargetNamespace != nil && *vmClone.Spec.TargetNamespace != "" {
+				targetNamespace = *vmClone.Spec.TargetNamespace
+			}
+
+			var keys []string
+			if target := vmClone.Spec.Target; target != nil && target.Name != "" {
+				keys = append(keys, fmt.Sprintf("%s/%s", targetNamespace, target.Name))
+			}
+			for _, replica := range vmClone.Status.Replicas {
+				if replica.TargetName != nil {
+					keys = append(keys, fmt.Sprintf("%s/%s", targetNamespace, *replica.TargetName))
+				}
+			}
+
+			return keys, nil
+		},
 	}
 }
 
@@ -842,6 +972,20 @@ func (f *kubeInformerFactory) DummyDataSource() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) ObjectTransfer() cache.SharedIndexInformer {
+	return f.getInformer("objectTransferInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.CdiClient().CdiV1beta1().RESTClient(), "objecttransfers", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &cdiv1.ObjectTransfer{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) DummyObjectTransfer() cache.SharedIndexInformer {
+	return f.getInformer("fakeObjectTransferInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&cdiv1.ObjectTransfer{})
+		return informer
+	})
+}
+
 func (f *kubeInformerFactory) StorageProfile() cache.SharedIndexInformer {
 	return f.getInformer("storageProfileInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CdiClient().CdiV1beta1().RESTClient(), "storageprofiles", k8sv1.NamespaceAll, fields.Everything())
@@ -915,6 +1059,15 @@ func (f *kubeInformerFactory) KubeVirtExportCAConfigMap() cache.SharedIndexInfor
 	})
 }
 
+func (f *kubeInformerFactory) MigrationEndpointCAConfigMap() cache.SharedIndexInformer {
+	return f.getInformer("extensionsMigrationEndpointCAConfigMapInformer", func() cache.SharedIndexInformer {
+		restClient := f.clientSet.CoreV1().RESTClient()
+		fieldSelector := fields.OneTermEqualSelector("metadata.name", "kubevirt-migration-endpoint-ca")
+		lw := cache.NewListWatchFromClient(restClient, "configmaps", f.kubevirtNamespace, fieldSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.ConfigMap{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) ExportRouteConfigMap() cache.SharedIndexInformer {
 	return f.getInformer("extensionsExportRouteConfigMapInformer", func() cache.SharedIndexInformer {
 		restClient := f.clientSet.CoreV1().RESTClient()
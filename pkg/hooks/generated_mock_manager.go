@@ -55,6 +55,27 @@ func (_mr *_MockManagerRecorder) OnDefineDomain(arg0, arg1 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnDefineDomain", arg0, arg1)
 }
 
+func (_m *MockManager) OnPrepareMigrationTarget(_param0 *api.DomainSpec, _param1 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "OnPrepareMigrationTarget", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnPrepareMigrationTarget(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnPrepareMigrationTarget", arg0, arg1)
+}
+
+func (_m *MockManager) OnDefineMigrationTargetDomain(_param0 string) (string, error) {
+	ret := _m.ctrl.Call(_m, "OnDefineMigrationTargetDomain", _param0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockManagerRecorder) OnDefineMigrationTargetDomain(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnDefineMigrationTargetDomain", arg0)
+}
+
 func (_m *MockManager) PreCloudInitIso(_param0 *v1.VirtualMachineInstance, _param1 *cloud_init.CloudInitData) (*cloud_init.CloudInitData, error) {
 	ret := _m.ctrl.Call(_m, "PreCloudInitIso", _param0, _param1)
 	ret0, _ := ret[0].(*cloud_init.CloudInitData)
@@ -75,3 +96,83 @@ func (_m *MockManager) Shutdown() error {
 func (_mr *_MockManagerRecorder) Shutdown() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Shutdown")
 }
+
+func (_m *MockManager) OnVMStarted(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "OnVMStarted", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnVMStarted(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnVMStarted", arg0)
+}
+
+func (_m *MockManager) PreMigration(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "PreMigration", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) PreMigration(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PreMigration", arg0)
+}
+
+func (_m *MockManager) PostMigration(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "PostMigration", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) PostMigration(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PostMigration", arg0)
+}
+
+func (_m *MockManager) OnHotplug(vmi *v1.VirtualMachineInstance, deviceType string, deviceName string, action string) error {
+	ret := _m.ctrl.Call(_m, "OnHotplug", vmi, deviceType, deviceName, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnHotplug(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnHotplug", arg0, arg1, arg2, arg3)
+}
+
+func (_m *MockManager) OnPause(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "OnPause", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnPause(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnPause", arg0)
+}
+
+func (_m *MockManager) OnUnpause(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "OnUnpause", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnUnpause(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnUnpause", arg0)
+}
+
+func (_m *MockManager) OnGuestAgentConnected(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "OnGuestAgentConnected", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) OnGuestAgentConnected(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "OnGuestAgentConnected", arg0)
+}
+
+func (_m *MockManager) NetBindingPluginSockets() map[string]string {
+	ret := _m.ctrl.Call(_m, "NetBindingPluginSockets")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+func (_mr *_MockManagerRecorder) NetBindingPluginSockets() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NetBindingPluginSockets")
+}
@@ -28,8 +28,18 @@ import (
 )
 
 const HookSidecarListAnnotationName = "hooks.kubevirt.io/hookSidecars"
+
+// HookSidecarNamesAnnotationName holds a comma separated list of HookSidecar objects the VMI requests
+const HookSidecarNamesAnnotationName = "hooks.kubevirt.io/hookSidecarNames"
+
+// SelectedHookSidecarNamesAnnotationName holds the HookSidecar objects whose selector matched the VMI on creation,
+// so the same sidecars are injected into the pods of its migration targets
+const SelectedHookSidecarNamesAnnotationName = "hooks.kubevirt.io/selectedHookSidecarNames"
 const HookSocketsSharedDirectory = "/var/run/kubevirt-hooks"
 
+// MigrationTargetVMIFile holds the VMI on the migration target, for the hooks called from the libvirt qemu hook
+const MigrationTargetVMIFile = "/var/run/kubevirt-private/migration-target-vmi.json"
+
 type HookSidecarList []HookSidecar
 
 type ConfigMap struct {
@@ -52,6 +62,7 @@ type HookSidecar struct {
 	ConfigMap       *ConfigMap                       `json:"configMap,omitempty"`
 	PVC             *PVC                             `json:"pvc,omitempty"`
 	DownwardAPI     v1.NetworkBindingDownwardAPIType `json:"-"`
+	Resources       *k8sv1.ResourceRequirements      `json:"-"`
 }
 
 func UnmarshalHookSidecarList(vmiObject *v1.VirtualMachineInstance) (HookSidecarList, error) {
@@ -22,3 +22,10 @@ package info
 const OnDefineDomainHookPointName = "OnDefineDomain"
 const PreCloudInitIsoHookPointName = "PreCloudInitIso"
 const ShutdownHookPointName = "Shutdown"
+const OnVMStartedHookPointName = "OnVMStarted"
+const PreMigrationHookPointName = "PreMigration"
+const PostMigrationHookPointName = "PostMigration"
+const OnHotplugHookPointName = "OnHotplug"
+const OnPauseHookPointName = "OnPause"
+const OnUnpauseHookPointName = "OnUnpause"
+const OnGuestAgentConnectedHookPointName = "OnGuestAgentConnected"
@@ -31,6 +31,8 @@ import (
 	"sync"
 	"time"
 
+	"google.golang.org/grpc"
+
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
@@ -39,17 +41,38 @@ import (
 	hooksV1alpha1 "kubevirt.io/kubevirt/pkg/hooks/v1alpha1"
 	hooksV1alpha2 "kubevirt.io/kubevirt/pkg/hooks/v1alpha2"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 //go:generate mockgen -source $GOFILE -package=$GOPACKAGE -destination=generated_mock_$GOFILE
 
-const dialSockErr = "Failed to Dial hook socket: %s"
+const (
+	dialSockErr = "Failed to Dial hook socket: %s"
+
+	// hookCallTimeout bounds the hook points whose result is used by virt-launcher
+	hookCallTimeout = time.Minute
+	// notifyTimeout bounds the lifecycle hook points, the sidecars are only informed by them
+	notifyTimeout = 10 * time.Second
+	// notificationsQueueSize is the number of pending lifecycle notifications, newer ones are dropped when it is full
+	notificationsQueueSize = 64
+)
+
+// SupportedVersions lists the hook API versions known to KubeVirt.
+// The order matters. We should match newer versions first.
+var SupportedVersions = []string{
+	hooksV1alpha4.Version,
+	hooksV1alpha3.Version,
+	hooksV1alpha2.Version,
+	hooksV1alpha1.Version,
+}
 
 type callBackClient struct {
 	SocketPath           string
 	Version              string
+	Name                 string
 	subscribedHookPoints []*hooksInfo.HookPoint
 }
 
@@ -60,12 +83,34 @@ type (
 	Manager interface {
 		Collect(uint, time.Duration) error
 		OnDefineDomain(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) (string, error)
+		OnPrepareMigrationTarget(*virtwrapApi.DomainSpec, *v1.VirtualMachineInstance) error
+		OnDefineMigrationTargetDomain(string) (string, error)
 		PreCloudInitIso(*v1.VirtualMachineInstance, *cloudinit.CloudInitData) (*cloudinit.CloudInitData, error)
 		Shutdown() error
+		OnVMStarted(*v1.VirtualMachineInstance) error
+		PreMigration(*v1.VirtualMachineInstance) error
+		PostMigration(*v1.VirtualMachineInstance) error
+		OnHotplug(vmi *v1.VirtualMachineInstance, deviceType, deviceName, action string) error
+		OnPause(*v1.VirtualMachineInstance) error
+		OnUnpause(*v1.VirtualMachineInstance) error
+		OnGuestAgentConnected(*v1.VirtualMachineInstance) error
+		NetBindingPluginSockets() map[string]string
 	}
 	hookManager struct {
 		CallbacksPerHookPoint     map[string][]*callBackClient
+		netBindingPluginSockets   map[string]string
 		hookSocketSharedDirectory string
+		migrationTargetVMIFile    string
+		notifications             chan notification
+		notifierOnce              sync.Once
+	}
+
+	notificationCall func(context.Context, hooksV1alpha4.CallbacksClient, []byte) error
+
+	notification struct {
+		hookPointName string
+		vmiJSON       []byte
+		call          notificationCall
 	}
 )
 
@@ -77,27 +122,42 @@ func GetManager() Manager {
 }
 
 func newManager(baseDir string) *hookManager {
-	return &hookManager{CallbacksPerHookPoint: make(map[string][]*callBackClient), hookSocketSharedDirectory: baseDir}
+	return &hookManager{
+		CallbacksPerHookPoint:     make(map[string][]*callBackClient),
+		netBindingPluginSockets:   make(map[string]string),
+		hookSocketSharedDirectory: baseDir,
+		migrationTargetVMIFile:    MigrationTargetVMIFile,
+		notifications:             make(chan notification, notificationsQueueSize),
+	}
 }
 
 func (m *hookManager) Collect(numberOfRequestedHookSidecars uint, timeout time.Duration) error {
-	callbacksPerHookPoint, err := m.collectSideCarSockets(numberOfRequestedHookSidecars, timeout)
+	callbacksPerHookPoint, netBindingPluginSockets, err := m.collectSideCarSockets(numberOfRequestedHookSidecars, timeout)
 	if err != nil {
 		return err
 	}
 	log.Log.Info("Collected all requested hook sidecar sockets")
+	log.Log.Infof("Collected network binding plugin sockets: %v", netBindingPluginSockets)
 
 	sortCallbacksPerHookPoint(callbacksPerHookPoint)
 	log.Log.Infof("Sorted all collected sidecar sockets per hook point based on their priority and name: %v", callbacksPerHookPoint)
 
 	m.CallbacksPerHookPoint = callbacksPerHookPoint
+	m.netBindingPluginSockets = netBindingPluginSockets
 
 	return nil
 }
 
+// NetBindingPluginSockets returns the sockets of the network binding plugin sidecars serving the BindingPlugin
+// service, keyed by the binding name.
+func (m *hookManager) NetBindingPluginSockets() map[string]string {
+	return m.netBindingPluginSockets
+}
+
 // TODO: Handle sockets in parallel, when a socket appears, run a goroutine trying to read Info from it
-func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint, timeout time.Duration) (map[string][]*callBackClient, error) {
+func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint, timeout time.Duration) (map[string][]*callBackClient, map[string]string, error) {
 	callbacksPerHookPoint := make(map[string][]*callBackClient)
+	netBindingPluginSockets := make(map[string]string)
 	processedSockets := make(map[string]bool)
 
 	timeoutCh := time.After(timeout)
@@ -105,13 +165,13 @@ func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint,
 	for uint(len(processedSockets)) < numberOfRequestedHookSidecars {
 		sockets, err := os.ReadDir(m.hookSocketSharedDirectory)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		for _, socket := range sockets {
 			select {
 			case <-timeoutCh:
-				return nil, fmt.Errorf("Failed to collect all expected sidecar hook sockets within given timeout")
+				return nil, nil, fmt.Errorf("Failed to collect all expected sidecar hook sockets within given timeout")
 			default:
 				if _, processed := processedSockets[socket.Name()]; processed {
 					continue
@@ -123,7 +183,11 @@ func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint,
 					continue
 				} else if err != nil {
 					log.Log.Reason(err).Infof("Failed to process sidecar socket: %s", socket.Name())
-					return nil, err
+					return nil, nil, err
+				}
+
+				if callBackClient.Version == netbindingv2.Version {
+					netBindingPluginSockets[callBackClient.Name] = callBackClient.SocketPath
 				}
 
 				for _, subscribedHookPoint := range callBackClient.subscribedHookPoints {
@@ -137,7 +201,7 @@ func (m *hookManager) collectSideCarSockets(numberOfRequestedHookSidecars uint,
 		time.Sleep(time.Second)
 	}
 
-	return callbacksPerHookPoint, nil
+	return callbacksPerHookPoint, netBindingPluginSockets, nil
 }
 
 func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
@@ -161,14 +225,16 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 		versionsSet[version] = true
 	}
 
-	// The order matters. We should match newer versions first.
-	supportedVersions := []string{
-		hooksV1alpha3.Version,
-		hooksV1alpha2.Version,
-		hooksV1alpha1.Version,
+	// Network binding plugins serving the BindingPlugin service are called directly, not through hook points.
+	if versionsSet[netbindingv2.Version] {
+		return &callBackClient{
+			SocketPath: socketPath,
+			Version:    netbindingv2.Version,
+			Name:       info.GetName(),
+		}, false, nil
 	}
 
-	for _, version := range supportedVersions {
+	for _, version := range SupportedVersions {
 		if _, found := versionsSet[version]; found {
 			return &callBackClient{
 				SocketPath:           socketPath,
@@ -180,7 +246,7 @@ func processSideCarSocket(socketPath string) (*callBackClient, bool, error) {
 
 	return nil, false,
 		fmt.Errorf("Hook sidecar does not expose a supported version. Exposed versions: %v, supported versions: %v",
-			info.GetVersions(), supportedVersions)
+			info.GetVersions(), SupportedVersions)
 }
 
 func sortCallbacksPerHookPoint(callbacksPerHookPoint map[string][]*callBackClient) {
@@ -214,7 +280,7 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 	}
 
 	for _, callback := range callbacks {
-		domainSpecXML, err = m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON)
+		domainSpecXML, err = m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON, nil)
 		if err != nil {
 			return "", err
 		}
@@ -223,56 +289,134 @@ func (m *hookManager) OnDefineDomain(domainSpec *virtwrapApi.DomainSpec, vmi *v1
 	return string(domainSpecXML), nil
 }
 
-func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpecXML, vmiJSON []byte) ([]byte, error) {
-	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+// OnPrepareMigrationTarget calls OnDefineDomain on the sidecars which predate v1alpha4, so that additional setup
+// done by them also happens in the target pod. The resulting domain is discarded, as the target domain is received
+// from the source. v1alpha4 sidecars are instead called by OnDefineMigrationTargetDomain, the VMI is stored for it.
+func (m *hookManager) OnPrepareMigrationTarget(domainSpec *virtwrapApi.DomainSpec, vmi *v1.VirtualMachineInstance) error {
+	callbacks, found := m.CallbacksPerHookPoint[hooksInfo.OnDefineDomainHookPointName]
+	if !found {
+		return nil
+	}
+
+	domainSpecXML, err := xml.MarshalIndent(domainSpec, "", "\t")
 	if err != nil {
-		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
-		return nil, err
+		return fmt.Errorf("Failed to marshal domain spec: %v", domainSpec)
 	}
-	defer conn.Close()
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-	defer cancel()
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
 
-	switch callback.Version {
-	case hooksV1alpha1.Version:
-		client := hooksV1alpha1.NewCallbacksClient(conn)
-		result, err := client.OnDefineDomain(ctx, &hooksV1alpha1.OnDefineDomainParams{
-			DomainXML: domainSpecXML,
-			Vmi:       vmiJSON,
-		})
-		if err != nil {
-			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
-			return nil, err
+	mutatesMigrationTargetDomain := false
+	for _, callback := range callbacks {
+		if callback.Version == hooksV1alpha4.Version {
+			mutatesMigrationTargetDomain = true
+			continue
 		}
-		domainSpecXML = result.GetDomainXML()
-	case hooksV1alpha2.Version:
-		client := hooksV1alpha2.NewCallbacksClient(conn)
-		result, err := client.OnDefineDomain(ctx, &hooksV1alpha2.OnDefineDomainParams{
-			DomainXML: domainSpecXML,
-			Vmi:       vmiJSON,
-		})
-		if err != nil {
-			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
-			return nil, err
+		if _, err := m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON, nil); err != nil {
+			return err
 		}
-		domainSpecXML = result.GetDomainXML()
-	case hooksV1alpha3.Version:
-		client := hooksV1alpha3.NewCallbacksClient(conn)
-		result, err := client.OnDefineDomain(ctx, &hooksV1alpha3.OnDefineDomainParams{
-			DomainXML: domainSpecXML,
-			Vmi:       vmiJSON,
-		})
+	}
+
+	if mutatesMigrationTargetDomain {
+		if err := os.WriteFile(m.migrationTargetVMIFile, vmiJSON, 0600); err != nil {
+			return fmt.Errorf("failed to store VMI for the migration target domain hooks: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// OnDefineMigrationTargetDomain calls OnDefineDomain on the v1alpha4 sidecars with the domain received from the
+// migration source and the migration context, so they can rewrite host specific elements like device paths.
+// It relies on the VMI stored by OnPrepareMigrationTarget.
+func (m *hookManager) OnDefineMigrationTargetDomain(domainXML string) (string, error) {
+	var callbacks []*callBackClient
+	for _, callback := range m.CallbacksPerHookPoint[hooksInfo.OnDefineDomainHookPointName] {
+		if callback.Version == hooksV1alpha4.Version {
+			callbacks = append(callbacks, callback)
+		}
+	}
+	if len(callbacks) == 0 {
+		return domainXML, nil
+	}
+
+	vmiJSON, err := os.ReadFile(m.migrationTargetVMIFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read VMI for the migration target domain hooks: %v", err)
+	}
+
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, vmi); err != nil {
+		return "", fmt.Errorf("failed to unmarshal VMI for the migration target domain hooks: %v", err)
+	}
+
+	migrationContextJSON, err := json.Marshal(newMigrationContext(vmi))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal migration context: %v", err)
+	}
+
+	domainSpecXML := []byte(domainXML)
+	for _, callback := range callbacks {
+		domainSpecXML, err = m.onDefineDomainCallback(callback, domainSpecXML, vmiJSON, migrationContextJSON)
 		if err != nil {
-			log.Log.Reason(err).Error("Failed to call OnDefineDomain")
-			return nil, err
+			return "", err
 		}
-		domainSpecXML = result.GetDomainXML()
-	default:
-		log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 	}
 
-	return domainSpecXML, nil
+	return string(domainSpecXML), nil
+}
+
+func newMigrationContext(vmi *v1.VirtualMachineInstance) *hooksV1alpha4.MigrationContext {
+	migrationContext := &hooksV1alpha4.MigrationContext{}
+	if migrationState := vmi.Status.MigrationState; migrationState != nil {
+		migrationContext.MigrationUID = string(migrationState.MigrationUID)
+		migrationContext.SourceNode = migrationState.SourceNode
+		migrationContext.SourcePod = migrationState.SourcePod
+		migrationContext.TargetNode = migrationState.TargetNode
+		migrationContext.TargetPod = migrationState.TargetPod
+	}
+	return migrationContext
+}
+
+func (m *hookManager) onDefineDomainCallback(callback *callBackClient, domainSpecXML, vmiJSON, migrationContextJSON []byte) ([]byte, error) {
+	var result interface{ GetDomainXML() []byte }
+	err := callCallback(callback, hookCallTimeout, func(ctx context.Context, conn *grpc.ClientConn) (err error) {
+		switch callback.Version {
+		case hooksV1alpha1.Version:
+			result, err = hooksV1alpha1.NewCallbacksClient(conn).OnDefineDomain(ctx, &hooksV1alpha1.OnDefineDomainParams{
+				DomainXML: domainSpecXML,
+				Vmi:       vmiJSON,
+			})
+		case hooksV1alpha2.Version:
+			result, err = hooksV1alpha2.NewCallbacksClient(conn).OnDefineDomain(ctx, &hooksV1alpha2.OnDefineDomainParams{
+				DomainXML: domainSpecXML,
+				Vmi:       vmiJSON,
+			})
+		case hooksV1alpha3.Version:
+			result, err = hooksV1alpha3.NewCallbacksClient(conn).OnDefineDomain(ctx, &hooksV1alpha3.OnDefineDomainParams{
+				DomainXML: domainSpecXML,
+				Vmi:       vmiJSON,
+			})
+		case hooksV1alpha4.Version:
+			result, err = hooksV1alpha4.NewCallbacksClient(conn).OnDefineDomain(ctx, &hooksV1alpha4.OnDefineDomainParams{
+				DomainXML:        domainSpecXML,
+				Vmi:              vmiJSON,
+				MigrationContext: migrationContextJSON,
+			})
+		}
+		return err
+	})
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to call OnDefineDomain")
+		return nil, err
+	}
+	if result == nil {
+		return domainSpecXML, nil
+	}
+
+	return result.GetDomainXML(), nil
 }
 
 func preCloudInitIsoDataToJSON(vmi *v1.VirtualMachineInstance, cloudInitData *cloudinit.CloudInitData) ([]byte, []byte, []byte, error) {
@@ -338,53 +482,39 @@ func (m *hookManager) PreCloudInitIso(vmi *v1.VirtualMachineInstance, cloudInitD
 	}
 
 	for _, callback := range callbacks {
-		switch callback.Version {
-		case hooksV1alpha2.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
-			if err != nil {
-				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
-				return cloudInitData, err
-			}
-			defer conn.Close()
-
-			client := hooksV1alpha2.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
-
-			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha2.PreCloudInitIsoParams{
-				CloudInitData:          cloudInitDataJSON,
-				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
-				Vmi:                    vmiJSON,
-			})
-			if err != nil {
-				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
-				return cloudInitData, err
-			}
-			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
-		case hooksV1alpha3.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
-			if err != nil {
-				log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
-				return cloudInitData, err
-			}
-			defer conn.Close()
-
-			client := hooksV1alpha3.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
-
-			result, err := client.PreCloudInitIso(ctx, &hooksV1alpha3.PreCloudInitIsoParams{
-				CloudInitData:          cloudInitDataJSON,
-				CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
-				Vmi:                    vmiJSON,
-			})
-			if err != nil {
-				log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
-				return cloudInitData, err
+		var result interface {
+			GetCloudInitData() []byte
+			GetCloudInitNoCloudSource() []byte
+		}
+		err := callCallback(callback, hookCallTimeout, func(ctx context.Context, conn *grpc.ClientConn) (err error) {
+			switch callback.Version {
+			case hooksV1alpha2.Version:
+				result, err = hooksV1alpha2.NewCallbacksClient(conn).PreCloudInitIso(ctx, &hooksV1alpha2.PreCloudInitIsoParams{
+					CloudInitData:          cloudInitDataJSON,
+					CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
+					Vmi:                    vmiJSON,
+				})
+			case hooksV1alpha3.Version:
+				result, err = hooksV1alpha3.NewCallbacksClient(conn).PreCloudInitIso(ctx, &hooksV1alpha3.PreCloudInitIsoParams{
+					CloudInitData:          cloudInitDataJSON,
+					CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
+					Vmi:                    vmiJSON,
+				})
+			case hooksV1alpha4.Version:
+				result, err = hooksV1alpha4.NewCallbacksClient(conn).PreCloudInitIso(ctx, &hooksV1alpha4.PreCloudInitIsoParams{
+					CloudInitData:          cloudInitDataJSON,
+					CloudInitNoCloudSource: cloudInitNoCloudSourceJSON,
+					Vmi:                    vmiJSON,
+				})
 			}
+			return err
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to call PreCloudInitIso")
+			return cloudInitData, err
+		}
+		if result != nil {
 			return preCloudInitIsoValidateResult(cloudInitData.DataSource, result.GetCloudInitData(), result.GetCloudInitNoCloudSource())
-		default:
-			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
 		}
 	}
 	return cloudInitData, nil
@@ -396,26 +526,147 @@ func (m *hookManager) Shutdown() error {
 		return nil
 	}
 	for _, callback := range callbacks {
-		switch callback.Version {
-		case hooksV1alpha3.Version:
-			conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
-			if err != nil {
-				log.Log.Reason(err).Error("Failed to run Shutdown")
-				return err
+		err := callCallback(callback, hookCallTimeout, func(ctx context.Context, conn *grpc.ClientConn) (err error) {
+			switch callback.Version {
+			case hooksV1alpha3.Version:
+				_, err = hooksV1alpha3.NewCallbacksClient(conn).Shutdown(ctx, &hooksV1alpha3.ShutdownParams{})
+			case hooksV1alpha4.Version:
+				_, err = hooksV1alpha4.NewCallbacksClient(conn).Shutdown(ctx, &hooksV1alpha4.ShutdownParams{})
 			}
-			defer conn.Close()
+			return err
+		})
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to run Shutdown")
+			return err
+		}
+	}
+	return nil
+}
 
-			client := hooksV1alpha3.NewCallbacksClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-			defer cancel()
+// callCallback dials the sidecar of the callback and calls it with the given deadline
+func callCallback(callback *callBackClient, timeout time.Duration, call func(context.Context, *grpc.ClientConn) error) error {
+	conn, err := grpcutil.DialSocketWithTimeout(callback.SocketPath, 1)
+	if err != nil {
+		log.Log.Reason(err).Errorf(dialSockErr, callback.SocketPath)
+		return err
+	}
+	defer conn.Close()
 
-			if _, err := client.Shutdown(ctx, &hooksV1alpha3.ShutdownParams{}); err != nil {
-				log.Log.Reason(err).Error("Failed to run Shutdown")
-				return err
-			}
-		default:
-			log.Log.Errorf("Unsupported callback version: %s", callback.Version)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return call(ctx, conn)
+}
+
+func (m *hookManager) OnVMStarted(vmi *v1.VirtualMachineInstance) error {
+	return m.notifyAsync(hooksInfo.OnVMStartedHookPointName, vmi, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnVMStarted(ctx, &hooksV1alpha4.OnVMStartedParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+// PreMigration is called synchronously, as the sidecars can veto the migration
+func (m *hookManager) PreMigration(vmi *v1.VirtualMachineInstance) error {
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	return m.notify(hooksInfo.PreMigrationHookPointName, vmiJSON, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.PreMigration(ctx, &hooksV1alpha4.PreMigrationParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+func (m *hookManager) PostMigration(vmi *v1.VirtualMachineInstance) error {
+	return m.notifyAsync(hooksInfo.PostMigrationHookPointName, vmi, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.PostMigration(ctx, &hooksV1alpha4.PostMigrationParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+func (m *hookManager) OnHotplug(vmi *v1.VirtualMachineInstance, deviceType, deviceName, action string) error {
+	return m.notifyAsync(hooksInfo.OnHotplugHookPointName, vmi, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnHotplug(ctx, &hooksV1alpha4.OnHotplugParams{
+			Vmi:        vmiJSON,
+			DeviceType: deviceType,
+			DeviceName: deviceName,
+			Action:     action,
+		})
+		return err
+	})
+}
+
+func (m *hookManager) OnPause(vmi *v1.VirtualMachineInstance) error {
+	return m.notifyAsync(hooksInfo.OnPauseHookPointName, vmi, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnPause(ctx, &hooksV1alpha4.OnPauseParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+func (m *hookManager) OnUnpause(vmi *v1.VirtualMachineInstance) error {
+	return m.notifyAsync(hooksInfo.OnUnpauseHookPointName, vmi, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnUnpause(ctx, &hooksV1alpha4.OnUnpauseParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+func (m *hookManager) OnGuestAgentConnected(vmi *v1.VirtualMachineInstance) error {
+	return m.notifyAsync(hooksInfo.OnGuestAgentConnectedHookPointName, vmi, func(ctx context.Context, client hooksV1alpha4.CallbacksClient, vmiJSON []byte) error {
+		_, err := client.OnGuestAgentConnected(ctx, &hooksV1alpha4.OnGuestAgentConnectedParams{Vmi: vmiJSON})
+		return err
+	})
+}
+
+// notifyAsync queues the lifecycle hook point for the subscribed sidecars and returns without waiting for them.
+// The notifications are sent in order by a single goroutine, a failing or slow sidecar is only logged.
+func (m *hookManager) notifyAsync(hookPointName string, vmi *v1.VirtualMachineInstance, call notificationCall) error {
+	if _, found := m.CallbacksPerHookPoint[hookPointName]; !found {
+		return nil
+	}
+
+	vmiJSON, err := json.Marshal(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to marshal VMI spec: %v, err: %v", vmi, err)
+	}
+
+	m.notifierOnce.Do(func() {
+		go m.runNotifier()
+	})
+
+	select {
+	case m.notifications <- notification{hookPointName: hookPointName, vmiJSON: vmiJSON, call: call}:
+		return nil
+	default:
+		return fmt.Errorf("too many pending notifications, dropping %s", hookPointName)
+	}
+}
+
+func (m *hookManager) runNotifier() {
+	for n := range m.notifications {
+		if err := m.notify(n.hookPointName, n.vmiJSON, n.call); err != nil {
+			log.Log.Reason(err).Errorf("executing %s hooks failed", n.hookPointName)
+		}
+	}
+}
+
+// notify calls the lifecycle hook point on all the subscribed sidecars.
+// Lifecycle hook points were introduced with v1alpha4, older sidecars subscribing to them are skipped.
+func (m *hookManager) notify(hookPointName string, vmiJSON []byte, call notificationCall) error {
+	for _, callback := range m.CallbacksPerHookPoint[hookPointName] {
+		if callback.Version != hooksV1alpha4.Version {
+			log.Log.Errorf("Unsupported callback version for hook point %s: %s", hookPointName, callback.Version)
+			continue
+		}
+
+		err := callCallback(callback, notifyTimeout, func(ctx context.Context, conn *grpc.ClientConn) error {
+			return call(ctx, hooksV1alpha4.NewCallbacksClient(conn), vmiJSON)
+		})
+		if err != nil {
+			log.Log.Reason(err).Errorf("Failed to call %s", hookPointName)
+			return err
 		}
 	}
+
 	return nil
 }
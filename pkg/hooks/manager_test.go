@@ -21,10 +21,13 @@ package hooks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
@@ -32,8 +35,15 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
 	hooksInfo "kubevirt.io/kubevirt/pkg/hooks/info"
 	hooksV1alpha3 "kubevirt.io/kubevirt/pkg/hooks/v1alpha3"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
+	virtwrapApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 type dynamicInfoServer struct {
@@ -78,6 +88,138 @@ func hookListenAndServe(socketPath string, hookName string, hookPointName string
 	return socket, nil
 }
 
+type lifecycleCallbacksServer struct {
+	err   error
+	lock  sync.Mutex
+	calls []string
+}
+
+func (s *lifecycleCallbacksServer) Calls() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]string{}, s.calls...)
+}
+
+func (s *lifecycleCallbacksServer) record(call string, vmiJSON []byte, args ...string) error {
+	vmi := &v1.VirtualMachineInstance{}
+	if err := json.Unmarshal(vmiJSON, vmi); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.calls = append(s.calls, strings.Join(append([]string{call, vmi.Name}, args...), " "))
+	return s.err
+}
+
+func (s *lifecycleCallbacksServer) OnDefineDomain(_ context.Context, params *hooksV1alpha4.OnDefineDomainParams) (*hooksV1alpha4.OnDefineDomainResult, error) {
+	if params.GetMigrationContext() == nil {
+		return &hooksV1alpha4.OnDefineDomainResult{DomainXML: params.GetDomainXML()}, s.record("OnDefineDomain", params.GetVmi())
+	}
+
+	migrationContext := &hooksV1alpha4.MigrationContext{}
+	if err := json.Unmarshal(params.GetMigrationContext(), migrationContext); err != nil {
+		return nil, err
+	}
+	// Rewrite the host specific elements of the source domain
+	domainXML := strings.ReplaceAll(string(params.GetDomainXML()), migrationContext.SourceNode, migrationContext.TargetNode)
+	return &hooksV1alpha4.OnDefineDomainResult{DomainXML: []byte(domainXML)}, s.record("OnDefineDomain", params.GetVmi(), migrationContext.TargetNode)
+}
+
+func (s *lifecycleCallbacksServer) PreCloudInitIso(_ context.Context, params *hooksV1alpha4.PreCloudInitIsoParams) (*hooksV1alpha4.PreCloudInitIsoResult, error) {
+	return &hooksV1alpha4.PreCloudInitIsoResult{CloudInitData: params.GetCloudInitData()}, nil
+}
+
+func (s *lifecycleCallbacksServer) Shutdown(_ context.Context, _ *hooksV1alpha4.ShutdownParams) (*hooksV1alpha4.ShutdownResult, error) {
+	return &hooksV1alpha4.ShutdownResult{}, nil
+}
+
+func (s *lifecycleCallbacksServer) OnVMStarted(_ context.Context, params *hooksV1alpha4.OnVMStartedParams) (*hooksV1alpha4.OnVMStartedResult, error) {
+	return &hooksV1alpha4.OnVMStartedResult{}, s.record("OnVMStarted", params.GetVmi())
+}
+
+func (s *lifecycleCallbacksServer) PreMigration(_ context.Context, params *hooksV1alpha4.PreMigrationParams) (*hooksV1alpha4.PreMigrationResult, error) {
+	return &hooksV1alpha4.PreMigrationResult{}, s.record("PreMigration", params.GetVmi())
+}
+
+func (s *lifecycleCallbacksServer) PostMigration(_ context.Context, params *hooksV1alpha4.PostMigrationParams) (*hooksV1alpha4.PostMigrationResult, error) {
+	return &hooksV1alpha4.PostMigrationResult{}, s.record("PostMigration", params.GetVmi())
+}
+
+func (s *lifecycleCallbacksServer) OnHotplug(_ context.Context, params *hooksV1alpha4.OnHotplugParams) (*hooksV1alpha4.OnHotplugResult, error) {
+	return &hooksV1alpha4.OnHotplugResult{}, s.record("OnHotplug", params.GetVmi(), params.GetDeviceType(), params.GetDeviceName(), params.GetAction())
+}
+
+func (s *lifecycleCallbacksServer) OnPause(_ context.Context, params *hooksV1alpha4.OnPauseParams) (*hooksV1alpha4.OnPauseResult, error) {
+	return &hooksV1alpha4.OnPauseResult{}, s.record("OnPause", params.GetVmi())
+}
+
+func (s *lifecycleCallbacksServer) OnUnpause(_ context.Context, params *hooksV1alpha4.OnUnpauseParams) (*hooksV1alpha4.OnUnpauseResult, error) {
+	return &hooksV1alpha4.OnUnpauseResult{}, s.record("OnUnpause", params.GetVmi())
+}
+
+func (s *lifecycleCallbacksServer) OnGuestAgentConnected(_ context.Context, params *hooksV1alpha4.OnGuestAgentConnectedParams) (*hooksV1alpha4.OnGuestAgentConnectedResult, error) {
+	return &hooksV1alpha4.OnGuestAgentConnectedResult{}, s.record("OnGuestAgentConnected", params.GetVmi())
+}
+
+type versionedInfoServer struct {
+	hookPointName string
+}
+
+func (s versionedInfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	return &hooksInfo.InfoResult{
+		Name: "hook1",
+		Versions: []string{
+			hooksV1alpha3.Version,
+			hooksV1alpha4.Version,
+		},
+		HookPoints: []*hooksInfo.HookPoint{
+			{
+				Name: s.hookPointName,
+			},
+		},
+	}, nil
+}
+
+func hookListenAndServeWithCallbacks(socketPath, hookPointName string, callbacks hooksV1alpha4.CallbacksServer) (net.Listener, error) {
+	socket, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer([]grpc.ServerOption{}...)
+	hooksInfo.RegisterInfoServer(server, versionedInfoServer{hookPointName: hookPointName})
+	hooksV1alpha4.RegisterCallbacksServer(server, callbacks)
+	go func() {
+		server.Serve(socket)
+	}()
+	return socket, nil
+}
+
+type netBindingPluginInfoServer struct {
+	bindingName string
+}
+
+func (s netBindingPluginInfoServer) Info(_ context.Context, _ *hooksInfo.InfoParams) (*hooksInfo.InfoResult, error) {
+	return &hooksInfo.InfoResult{
+		Name:     s.bindingName,
+		Versions: []string{netbindingv2.Version},
+	}, nil
+}
+
+func netBindingPluginListenAndServe(socketPath, bindingName string) (net.Listener, error) {
+	socket, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer([]grpc.ServerOption{}...)
+	hooksInfo.RegisterInfoServer(server, netBindingPluginInfoServer{bindingName: bindingName})
+	go func() {
+		server.Serve(socket)
+	}()
+	return socket, nil
+}
+
 var _ = Describe("HooksManager", func() {
 	Context("With existing sockets", func() {
 		var socketDir string
@@ -128,6 +270,155 @@ var _ = Describe("HooksManager", func() {
 			Expect(callbackMaps[hookPointName]).Should(HaveLen(len(hookNames)))
 		})
 
+		It("Should collect network binding plugin sockets by binding name", func() {
+			pluginSocketPath := filepath.Join(socketDir, "plugin.sock")
+			pluginSocket, err := netBindingPluginListenAndServe(pluginSocketPath, "mybinding")
+			Expect(err).ToNot(HaveOccurred())
+			defer pluginSocket.Close()
+			defer os.Remove(pluginSocketPath)
+
+			hookSocketPath := filepath.Join(socketDir, "hook1.sock")
+			hookSocket, err := hookListenAndServe(hookSocketPath, "hook1", hooksInfo.OnDefineDomainHookPointName, 0)
+			Expect(err).ToNot(HaveOccurred())
+			defer hookSocket.Close()
+			defer os.Remove(hookSocketPath)
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(2, 10*time.Second)).To(Succeed())
+			Expect(manager.NetBindingPluginSockets()).To(Equal(map[string]string{"mybinding": pluginSocketPath}))
+			Expect(manager.CallbacksPerHookPoint).To(HaveKey(hooksInfo.OnDefineDomainHookPointName))
+			Expect(manager.CallbacksPerHookPoint[hooksInfo.OnDefineDomainHookPointName]).To(HaveLen(1))
+		})
+
+		It("Should prefer the newest supported version", func() {
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := hookListenAndServeWithCallbacks(socketPath, hooksInfo.OnVMStartedHookPointName, &lifecycleCallbacksServer{})
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+			defer os.Remove(socketPath)
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			Expect(manager.CallbacksPerHookPoint[hooksInfo.OnVMStartedHookPointName]).To(HaveLen(1))
+			Expect(manager.CallbacksPerHookPoint[hooksInfo.OnVMStartedHookPointName][0].Version).To(Equal(hooksV1alpha4.Version))
+		})
+
+		DescribeTable("Should dispatch lifecycle hook points to v1alpha4 sidecars", func(hookPointName string, notify func(Manager, *v1.VirtualMachineInstance) error, expected string) {
+			callbacks := &lifecycleCallbacksServer{}
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := hookListenAndServeWithCallbacks(socketPath, hookPointName, callbacks)
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+			defer os.Remove(socketPath)
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+
+			vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}
+			Expect(notify(manager, vmi)).To(Succeed())
+			Eventually(callbacks.Calls).Should(ConsistOf(expected))
+		},
+			Entry("VM started", hooksInfo.OnVMStartedHookPointName, Manager.OnVMStarted, "OnVMStarted testvmi"),
+			Entry("pre-migration", hooksInfo.PreMigrationHookPointName, Manager.PreMigration, "PreMigration testvmi"),
+			Entry("post-migration", hooksInfo.PostMigrationHookPointName, Manager.PostMigration, "PostMigration testvmi"),
+			Entry("hotplug", hooksInfo.OnHotplugHookPointName, func(m Manager, vmi *v1.VirtualMachineInstance) error {
+				return m.OnHotplug(vmi, hooksV1alpha4.HotplugDeviceTypeDisk, "disk1", hooksV1alpha4.HotplugActionUnplug)
+			}, "OnHotplug testvmi disk disk1 unplug"),
+			Entry("pause", hooksInfo.OnPauseHookPointName, Manager.OnPause, "OnPause testvmi"),
+			Entry("unpause", hooksInfo.OnUnpauseHookPointName, Manager.OnUnpause, "OnUnpause testvmi"),
+			Entry("guest agent connected", hooksInfo.OnGuestAgentConnectedHookPointName, Manager.OnGuestAgentConnected, "OnGuestAgentConnected testvmi"),
+		)
+
+		It("Should return the error of a failing lifecycle hook", func() {
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := hookListenAndServeWithCallbacks(socketPath, hooksInfo.PreMigrationHookPointName, &lifecycleCallbacksServer{err: fmt.Errorf("license not transferable")})
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+			defer os.Remove(socketPath)
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			Expect(manager.PreMigration(&v1.VirtualMachineInstance{})).To(MatchError(ContainSubstring("license not transferable")))
+		})
+
+		It("Should not return the error of a failing lifecycle notification", func() {
+			callbacks := &lifecycleCallbacksServer{err: fmt.Errorf("sidecar failed")}
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := hookListenAndServeWithCallbacks(socketPath, hooksInfo.OnPauseHookPointName, callbacks)
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+			defer os.Remove(socketPath)
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}
+			Expect(manager.OnPause(vmi)).To(Succeed())
+			Expect(manager.OnUnpause(vmi)).To(Succeed())
+			Eventually(callbacks.Calls).Should(Equal([]string{"OnPause testvmi"}))
+		})
+
+		It("Should skip older sidecars subscribed to lifecycle hook points", func() {
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := hookListenAndServe(socketPath, "hook1", hooksInfo.OnVMStartedHookPointName, 0)
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+			defer os.Remove(socketPath)
+
+			manager := newManager(socketDir)
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+			Expect(manager.OnVMStarted(&v1.VirtualMachineInstance{})).To(Succeed())
+		})
+
+		It("Should pass the source domain and the migration context to v1alpha4 sidecars on the migration target", func() {
+			callbacks := &lifecycleCallbacksServer{}
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := hookListenAndServeWithCallbacks(socketPath, hooksInfo.OnDefineDomainHookPointName, callbacks)
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+			defer os.Remove(socketPath)
+
+			manager := newManager(socketDir)
+			manager.migrationTargetVMIFile = filepath.Join(GinkgoT().TempDir(), "migration-target-vmi.json")
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+
+			vmi := &v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvmi"},
+				Status: v1.VirtualMachineInstanceStatus{
+					MigrationState: &v1.VirtualMachineInstanceMigrationState{
+						SourceNode: "node01",
+						TargetNode: "node02",
+					},
+				},
+			}
+			Expect(manager.OnPrepareMigrationTarget(&virtwrapApi.DomainSpec{}, vmi)).To(Succeed())
+			Expect(callbacks.calls).To(BeEmpty())
+
+			domainXML, err := manager.OnDefineMigrationTargetDomain(`<domain><source dev="/dev/node01/disk"/></domain>`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainXML).To(Equal(`<domain><source dev="/dev/node02/disk"/></domain>`))
+			Expect(callbacks.calls).To(ConsistOf("OnDefineDomain testvmi node02"))
+		})
+
+		It("Should call older sidecars only when preparing the migration target", func() {
+			socketPath := filepath.Join(socketDir, "hook1.sock")
+			socket, err := hookListenAndServe(socketPath, "hook1", hooksInfo.OnDefineDomainHookPointName, 0)
+			Expect(err).ToNot(HaveOccurred())
+			defer socket.Close()
+			defer os.Remove(socketPath)
+
+			manager := newManager(socketDir)
+			manager.migrationTargetVMIFile = filepath.Join(GinkgoT().TempDir(), "migration-target-vmi.json")
+			Expect(manager.Collect(1, 10*time.Second)).To(Succeed())
+
+			// The test sidecar does not serve callbacks, reaching it fails
+			Expect(manager.OnPrepareMigrationTarget(&virtwrapApi.DomainSpec{}, &v1.VirtualMachineInstance{})).ToNot(Succeed())
+			Expect(manager.migrationTargetVMIFile).ToNot(BeAnExistingFile())
+
+			domainXML, err := manager.OnDefineMigrationTargetDomain("<domain/>")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domainXML).To(Equal("<domain/>"))
+		})
+
 		It("Should find multiple sidecars on different hook points", func() {
 			hookNameMap := map[string]string{
 				"hook1": hooksInfo.OnDefineDomainHookPointName,
@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package hooks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
+)
+
+// HookSidecarNames returns the names of the HookSidecar objects referenced by the VMI
+func HookSidecarNames(vmi *v1.VirtualMachineInstance) []string {
+	return splitHookSidecarNames(vmi.Annotations[HookSidecarNamesAnnotationName])
+}
+
+// SelectedHookSidecarNames returns the names of the HookSidecar objects recorded as selected on the VMI
+func SelectedHookSidecarNames(vmi *v1.VirtualMachineInstance) []string {
+	return splitHookSidecarNames(vmi.Annotations[SelectedHookSidecarNamesAnnotationName])
+}
+
+func splitHookSidecarNames(annotation string) []string {
+	var names []string
+	for _, name := range strings.Split(annotation, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// SelectHookSidecarNames returns the sorted names of the HookSidecar objects whose selector matches the VMI labels
+// and which the VMI does not reference by name. It is resolved once on creation and recorded on the VMI.
+func SelectHookSidecarNames(vmi *v1.VirtualMachineInstance, store cache.Store) ([]string, error) {
+	requested := map[string]bool{}
+	for _, name := range HookSidecarNames(vmi) {
+		requested[name] = true
+	}
+
+	var selected []string
+	for _, obj := range store.List() {
+		hookSidecar := obj.(*hooksv1alpha1.HookSidecar)
+		if hookSidecar.Spec.Selector == nil || requested[hookSidecar.Name] {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(hookSidecar.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of hook sidecar %s: %v", hookSidecar.Name, err)
+		}
+		if selector.Matches(labels.Set(vmi.Labels)) {
+			selected = append(selected, hookSidecar.Name)
+		}
+	}
+	sort.Strings(selected)
+
+	return selected, nil
+}
+
+// RegisteredHookSidecarList returns the hook sidecars published as HookSidecar objects which the VMI
+// references by name, followed by the ones recorded as selected on its creation
+func RegisteredHookSidecarList(vmi *v1.VirtualMachineInstance, store cache.Store) (HookSidecarList, error) {
+	var hookSidecarList HookSidecarList
+	injected := map[string]bool{}
+	for _, name := range append(HookSidecarNames(vmi), SelectedHookSidecarNames(vmi)...) {
+		if injected[name] {
+			continue
+		}
+		obj, exists, err := store.GetByKey(name)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("hook sidecar %s does not exist", name)
+		}
+		injected[name] = true
+		hookSidecarList = append(hookSidecarList, newRegisteredHookSidecar(obj.(*hooksv1alpha1.HookSidecar)))
+	}
+	return hookSidecarList, nil
+}
+
+// RegisteredHookSidecarImages returns the images published as HookSidecar objects
+func RegisteredHookSidecarImages(store cache.Store) map[string]bool {
+	images := map[string]bool{}
+	for _, obj := range store.List() {
+		images[obj.(*hooksv1alpha1.HookSidecar).Spec.Image] = true
+	}
+	return images
+}
+
+func newRegisteredHookSidecar(hookSidecar *hooksv1alpha1.HookSidecar) HookSidecar {
+	var args []string
+	if hookSidecar.Spec.Version != "" {
+		args = append(args, "--version", hookSidecar.Spec.Version)
+	}
+	args = append(args, hookSidecar.Spec.Args...)
+
+	return HookSidecar{
+		Image:           hookSidecar.Spec.Image,
+		ImagePullPolicy: hookSidecar.Spec.ImagePullPolicy,
+		Args:            args,
+		Resources:       hookSidecar.Spec.Resources.DeepCopy(),
+	}
+}
@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package hooks_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+)
+
+var _ = Describe("HookSidecar registry", func() {
+	var store cache.Store
+
+	newHookSidecar := func(name string, selector *metav1.LabelSelector) *hooksv1alpha1.HookSidecar {
+		return &hooksv1alpha1.HookSidecar{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: hooksv1alpha1.HookSidecarSpec{
+				Image:    name + ":v1",
+				Selector: selector,
+			},
+		}
+	}
+
+	newVMI := func(hookSidecarNames string, labels map[string]string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      labels,
+				Annotations: map[string]string{},
+			},
+		}
+		if hookSidecarNames != "" {
+			vmi.Annotations[hooks.HookSidecarNamesAnnotationName] = hookSidecarNames
+		}
+		return vmi
+	}
+
+	newVMIWithSelected := func(hookSidecarNames string, labels map[string]string) *v1.VirtualMachineInstance {
+		vmi := newVMI(hookSidecarNames, labels)
+		selected, err := hooks.SelectHookSidecarNames(vmi, store)
+		Expect(err).ToNot(HaveOccurred())
+		vmi.Annotations[hooks.SelectedHookSidecarNamesAnnotationName] = strings.Join(selected, ",")
+		return vmi
+	}
+
+	images := func(hookSidecarList hooks.HookSidecarList) []string {
+		var result []string
+		for _, hookSidecar := range hookSidecarList {
+			result = append(result, hookSidecar.Image)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		store = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		Expect(store.Add(newHookSidecar("smbios", nil))).To(Succeed())
+		Expect(store.Add(newHookSidecar("disk-mutation", nil))).To(Succeed())
+		Expect(store.Add(newHookSidecar("numa", &metav1.LabelSelector{MatchLabels: map[string]string{"numa": "true"}}))).To(Succeed())
+		Expect(store.Add(newHookSidecar("audit", &metav1.LabelSelector{}))).To(Succeed())
+	})
+
+	It("should parse the referenced hook sidecar names", func() {
+		Expect(hooks.HookSidecarNames(newVMI(" smbios, ,disk-mutation ", nil))).To(Equal([]string{"smbios", "disk-mutation"}))
+		Expect(hooks.HookSidecarNames(newVMI("", nil))).To(BeEmpty())
+	})
+
+	It("should return the referenced hook sidecars in order followed by the selected ones", func() {
+		hookSidecarList, err := hooks.RegisteredHookSidecarList(newVMIWithSelected("smbios,disk-mutation,smbios", map[string]string{"numa": "true"}), store)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(images(hookSidecarList)).To(Equal([]string{"smbios:v1", "disk-mutation:v1", "audit:v1", "numa:v1"}))
+	})
+
+	It("should not inject hook sidecars twice when referenced and selected", func() {
+		hookSidecarList, err := hooks.RegisteredHookSidecarList(newVMIWithSelected("numa", map[string]string{"numa": "true"}), store)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(images(hookSidecarList)).To(Equal([]string{"numa:v1", "audit:v1"}))
+	})
+
+	It("should select the hook sidecars matching the VMI labels", func() {
+		selected, err := hooks.SelectHookSidecarNames(newVMI("audit", map[string]string{"numa": "true"}), store)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selected).To(Equal([]string{"numa"}))
+	})
+
+	It("should only inject the hook sidecars recorded as selected", func() {
+		vmi := newVMIWithSelected("", nil)
+		Expect(store.Add(newHookSidecar("late", &metav1.LabelSelector{}))).To(Succeed())
+		hookSidecarList, err := hooks.RegisteredHookSidecarList(vmi, store)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(images(hookSidecarList)).To(Equal([]string{"audit:v1"}))
+	})
+
+	It("should return the registered images", func() {
+		Expect(hooks.RegisteredHookSidecarImages(store)).To(Equal(map[string]bool{
+			"smbios:v1": true, "disk-mutation:v1": true, "numa:v1": true, "audit:v1": true,
+		}))
+	})
+
+	It("should fail when a referenced hook sidecar does not exist", func() {
+		_, err := hooks.RegisteredHookSidecarList(newVMI("missing", nil), store)
+		Expect(err).To(MatchError(ContainSubstring("hook sidecar missing does not exist")))
+	})
+
+	It("should pass the version, arguments and resources to the sidecar", func() {
+		hookSidecar := newHookSidecar("versioned", nil)
+		hookSidecar.Spec.Version = "v1alpha3"
+		hookSidecar.Spec.Args = []string{"--debug"}
+		hookSidecar.Spec.ImagePullPolicy = k8sv1.PullAlways
+		hookSidecar.Spec.Resources = &k8sv1.ResourceRequirements{
+			Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("64Mi")},
+		}
+		Expect(store.Add(hookSidecar)).To(Succeed())
+
+		hookSidecarList, err := hooks.RegisteredHookSidecarList(newVMIWithSelected("versioned", nil), store)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hookSidecarList).To(HaveLen(2))
+		Expect(hookSidecarList[0]).To(Equal(hooks.HookSidecar{
+			Image:           "versioned:v1",
+			ImagePullPolicy: k8sv1.PullAlways,
+			Args:            []string{"--version", "v1alpha3", "--debug"},
+			Resources:       hookSidecar.Spec.Resources,
+		}))
+	})
+})
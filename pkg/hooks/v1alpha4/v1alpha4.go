@@ -0,0 +1,41 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package v1alpha4
+
+const Version = "v1alpha4"
+
+// Device types and actions passed to the OnHotplug callback
+const (
+	HotplugDeviceTypeDisk      = "disk"
+	HotplugDeviceTypeInterface = "interface"
+
+	HotplugActionPlug   = "plug"
+	HotplugActionUnplug = "unplug"
+)
+
+// MigrationContext is passed to OnDefineDomain when the domain is defined on the target of a live migration.
+// It allows sidecars to rewrite host specific elements of the domain received from the source.
+type MigrationContext struct {
+	MigrationUID string `json:"migrationUID"`
+	SourceNode   string `json:"sourceNode"`
+	SourcePod    string `json:"sourcePod"`
+	TargetNode   string `json:"targetNode"`
+	TargetPod    string `json:"targetPod"`
+}
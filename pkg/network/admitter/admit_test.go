@@ -51,6 +51,8 @@ var _ = Describe("Validating VMI network spec", func() {
 	},
 		Entry("is empty", v1.InterfaceState("")),
 		Entry("is absent when bridge binding is used", v1.InterfaceStateAbsent),
+		Entry("is up", v1.InterfaceStateUp),
+		Entry("is down", v1.InterfaceStateDown),
 	)
 
 	It("network interface state value is invalid", func() {
@@ -85,6 +87,25 @@ var _ = Describe("Validating VMI network spec", func() {
 			}))
 	})
 
+	It("network interface state value of down is not supported when SR-IOV binding is used", func() {
+		vm := api.NewMinimalVMI("testvm")
+		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "foo",
+			State:                  v1.InterfaceStateDown,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+		}}
+		vm.Spec.Networks = []v1.Network{
+			{Name: "foo", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "net"}}},
+		}
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vm.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(
+			ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "\"foo\" interface's state \"down\" is not supported for SR-IOV binding",
+				Field:   "fake.domain.devices.interfaces[0].state",
+			}))
+	})
+
 	It("network interface state value of absent is not supported on the default network", func() {
 		vm := api.NewMinimalVMI("testvm")
 		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
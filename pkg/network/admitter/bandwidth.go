@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package admitter
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// minBandwidthValue is the smallest limit that can be expressed in the kibibytes units used by libvirt.
+var minBandwidthValue = resource.MustParse("1Ki")
+
+func validateInterfaceBandwidth(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.Bandwidth == nil {
+			continue
+		}
+		bandwidthField := field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+		// The limits are only applied to the tap devices created for the bridge and masquerade bindings
+		if iface.Bridge == nil && iface.Masquerade == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q interface's bandwidth is only supported for bridge and masquerade bindings", iface.Name),
+				Field:   bandwidthField.String(),
+			})
+			continue
+		}
+		causes = append(causes, validateBandwidthLimit(bandwidthField.Child("inbound"), iface.Bandwidth.Inbound)...)
+		causes = append(causes, validateBandwidthLimit(bandwidthField.Child("outbound"), iface.Bandwidth.Outbound)...)
+	}
+	return causes
+}
+
+func validateBandwidthLimit(field *k8sfield.Path, limit *v1.InterfaceBandwidthLimit) []metav1.StatusCause {
+	if limit == nil {
+		return nil
+	}
+	var causes []metav1.StatusCause
+	if limit.Average.Cmp(minBandwidthValue) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least %s", field.Child("average").String(), minBandwidthValue.String()),
+			Field:   field.Child("average").String(),
+		})
+	}
+	if limit.Peak != nil && limit.Peak.Cmp(limit.Average) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be lower than the average", field.Child("peak").String()),
+			Field:   field.Child("peak").String(),
+		})
+	}
+	if limit.Burst != nil && limit.Burst.Cmp(minBandwidthValue) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be at least %s", field.Child("burst").String(), minBandwidthValue.String()),
+			Field:   field.Child("burst").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package admitter_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Validate interface bandwidth", func() {
+	DescribeTable("should be accepted", func(bandwidth *v1.InterfaceBandwidth) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Bandwidth:              bandwidth,
+		}}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(BeEmpty())
+	},
+		Entry("without limits", &v1.InterfaceBandwidth{}),
+		Entry("with an inbound average", &v1.InterfaceBandwidth{
+			Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("10Mi")},
+		}),
+		Entry("with all limits", &v1.InterfaceBandwidth{
+			Inbound: &v1.InterfaceBandwidthLimit{
+				Average: resource.MustParse("10Mi"),
+				Peak:    pointer.P(resource.MustParse("20Mi")),
+				Burst:   pointer.P(resource.MustParse("1Mi")),
+			},
+			Outbound: &v1.InterfaceBandwidthLimit{
+				Average: resource.MustParse("1Ki"),
+				Peak:    pointer.P(resource.MustParse("1Ki")),
+			},
+		}),
+	)
+
+	DescribeTable("should be rejected", func(bandwidth *v1.InterfaceBandwidth, expectedCause metav1.StatusCause) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "default",
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			Bandwidth:              bandwidth,
+		}}
+		vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{})
+		Expect(validator.Validate()).To(ConsistOf(expectedCause))
+	},
+		Entry("with an average lower than 1Ki",
+			&v1.InterfaceBandwidth{Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("512")}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "fake.domain.devices.interfaces[0].bandwidth.inbound.average must be at least 1Ki",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.inbound.average",
+			},
+		),
+		Entry("with a peak lower than the average",
+			&v1.InterfaceBandwidth{Outbound: &v1.InterfaceBandwidthLimit{
+				Average: resource.MustParse("10Mi"),
+				Peak:    pointer.P(resource.MustParse("1Mi")),
+			}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "fake.domain.devices.interfaces[0].bandwidth.outbound.peak must not be lower than the average",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.outbound.peak",
+			},
+		),
+		Entry("with a burst lower than 1Ki",
+			&v1.InterfaceBandwidth{Outbound: &v1.InterfaceBandwidthLimit{
+				Average: resource.MustParse("10Mi"),
+				Burst:   pointer.P(resource.MustParse("100")),
+			}},
+			metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "fake.domain.devices.interfaces[0].bandwidth.outbound.burst must be at least 1Ki",
+				Field:   "fake.domain.devices.interfaces[0].bandwidth.outbound.burst",
+			},
+		),
+	)
+
+	DescribeTable("should be rejected for unsupported bindings", func(binding v1.Interface) {
+		vmi := api.NewMinimalVMI("testvmi")
+		iface := binding
+		iface.Name = "default"
+		iface.Bandwidth = &v1.InterfaceBandwidth{
+			Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("10Mi")},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+		vmi.Spec.Networks = []v1.Network{{
+			Name:          "default",
+			NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test-net"}},
+		}}
+
+		validator := admitter.NewValidator(k8sfield.NewPath("fake"), &vmi.Spec, stubClusterConfigChecker{
+			passtFeatureGateEnabled: true,
+			bindingPluginFGEnabled:  true,
+		})
+		Expect(validator.Validate()).To(ContainElement(metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "\"default\" interface's bandwidth is only supported for bridge and masquerade bindings",
+			Field:   "fake.domain.devices.interfaces[0].bandwidth",
+		}))
+	},
+		Entry("SR-IOV", v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}}),
+		Entry("passt", v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{DeprecatedPasst: &v1.DeprecatedInterfacePasst{}}}),
+		Entry("binding plugin", v1.Interface{Binding: &v1.PluginBinding{Name: "test-plugin"}}),
+	)
+})
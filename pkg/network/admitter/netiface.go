@@ -198,7 +198,8 @@ func validatePortConfiguration(field *k8sfield.Path, idx int, iface v1.Interface
 		for portIdx, forwardPort := range iface.Ports {
 			causes = append(causes, validateForwardPortNonZero(field, idx, forwardPort, portIdx)...)
 			causes = append(causes, validateForwardPortInRange(field, idx, forwardPort, portIdx)...)
-			causes = append(causes, validateForwardPortProtocol(field, idx, forwardPort, portIdx)...)
+			causes = append(causes, validateForwardPortProtocol(field, idx, iface, forwardPort, portIdx)...)
+			causes = append(causes, validateForwardPortEndPort(field, idx, iface, forwardPort, portIdx)...)
 		}
 	}
 	return causes
@@ -230,12 +231,20 @@ func validateForwardPortName(field *k8sfield.Path, idx int, ports []v1.Port) []m
 	return causes
 }
 
-func validateForwardPortProtocol(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
+func validateForwardPortProtocol(field *k8sfield.Path, idx int, iface v1.Interface, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
 	if forwardPort.Protocol != "" {
-		if forwardPort.Protocol != "TCP" && forwardPort.Protocol != "UDP" {
+		if forwardPort.Protocol == "ALL" {
+			if iface.Masquerade == nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "Protocol ALL is supported only with masquerade binding",
+					Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("protocol").String(),
+				})
+			}
+		} else if forwardPort.Protocol != "TCP" && forwardPort.Protocol != "UDP" {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Unknown protocol, only TCP or UDP allowed",
+				Message: "Unknown protocol, only TCP, UDP or ALL allowed",
 				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("protocol").String(),
 			})
 		}
@@ -243,6 +252,28 @@ func validateForwardPortProtocol(field *k8sfield.Path, idx int, forwardPort v1.P
 	return causes
 }
 
+func validateForwardPortEndPort(field *k8sfield.Path, idx int, iface v1.Interface, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
+	if forwardPort.EndPort == 0 {
+		return nil
+	}
+	endPortField := field.Child("domain", "devices", "interfaces").Index(idx).Child("ports").Index(portIdx).Child("endPort").String()
+	if iface.Masquerade == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "EndPort is supported only with masquerade binding",
+			Field:   endPortField,
+		})
+	}
+	if forwardPort.EndPort < forwardPort.Port || forwardPort.EndPort > 65535 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "EndPort field must be in range Port <= x < 65536.",
+			Field:   endPortField,
+		})
+	}
+	return causes
+}
+
 func validateForwardPortInRange(field *k8sfield.Path, idx int, forwardPort v1.Port, portIdx int) (causes []metav1.StatusCause) {
 	if forwardPort.Port < 0 || forwardPort.Port > 65536 {
 		causes = append(causes, metav1.StatusCause{
@@ -270,10 +301,88 @@ func validateDHCPOptions(field *k8sfield.Path, idx int, iface v1.Interface) []me
 	if iface.DHCPOptions != nil {
 		causes = append(causes, validateDHCPExtraOptions(field, iface)...)
 		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPRoutes(field, iface, idx)...)
+		causes = append(causes, validateDHCPSearchDomains(field, iface, idx)...)
+		causes = append(causes, validateDHCPMTU(field, iface, idx)...)
+		causes = append(causes, validateDHCPv6Options(field, iface, idx)...)
+	}
+	return causes
+}
+
+func validateDHCPRoutes(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	routesField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "routes")
+	for index, route := range iface.DHCPOptions.Routes {
+		if _, dst, err := net.ParseCIDR(route.Destination); err != nil || dst.IP.To4() == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCP route destination must be a valid IPv4 CIDR.",
+				Field:   routesField.Index(index).Child("destination").String(),
+			})
+		}
+		if route.Gateway != "" && net.ParseIP(route.Gateway).To4() == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCP route gateway must be a valid IPv4 address.",
+				Field:   routesField.Index(index).Child("gateway").String(),
+			})
+		}
 	}
 	return causes
 }
 
+func validateDHCPSearchDomains(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	for index, domain := range iface.DHCPOptions.SearchDomains {
+		if errs := k8svalidation.IsDNS1123Subdomain(domain); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("DHCP search domain is not valid: %v", errs),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "searchDomains").Index(index).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDHCPMTU(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	const minMTU, maxMTU = 68, 65535
+	mtu := iface.DHCPOptions.MTU
+	if mtu != nil && (*mtu < minMTU || *mtu > maxMTU) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("DHCP MTU must be in range %d to %d", minMTU, maxMTU),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "mtu").String(),
+		}}
+	}
+	return nil
+}
+
+func validateDHCPv6Options(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	ipv6Options := iface.DHCPOptions.IPv6
+	if ipv6Options == nil {
+		return nil
+	}
+	ipv6Field := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "ipv6")
+	// The DHCPv6 server and the router advertiser only serve interfaces with masquerade binding
+	if iface.Masquerade == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "DHCPv6 options are only supported for interfaces with masquerade binding.",
+			Field:   ipv6Field.String(),
+		}}
+	}
+	if ipv6Options.DelegatedPrefix == "" {
+		return nil
+	}
+	if ip, prefix, err := net.ParseCIDR(ipv6Options.DelegatedPrefix); err != nil || prefix.IP.To4() != nil || !ip.Equal(prefix.IP) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "DHCPv6 delegated prefix must be a valid IPv6 network in CIDR notation.",
+			Field:   ipv6Field.Child("delegatedPrefix").String(),
+		}}
+	}
+	return nil
+}
+
 func validateDHCPExtraOptions(field *k8sfield.Path, iface v1.Interface) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	privateOptions := iface.DHCPOptions.PrivateOptions
@@ -31,6 +31,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/admitter"
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("Validating VMI network spec", func() {
@@ -257,7 +258,7 @@ var _ = Describe("Validating VMI network spec", func() {
 				[]v1.Port{{Protocol: "bad", Port: 80}},
 				[]metav1.StatusCause{{
 					Type:    "FieldValueInvalid",
-					Message: "Unknown protocol, only TCP or UDP allowed",
+					Message: "Unknown protocol, only TCP, UDP or ALL allowed",
 					Field:   "fake.domain.devices.interfaces[0].ports[0].protocol",
 				}},
 			),
@@ -288,6 +289,49 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].ports[0].name",
 				}},
 			),
+			Entry(
+				"end port lower than the port",
+				[]v1.Port{{Port: 8080, EndPort: 80}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "EndPort field must be in range Port <= x < 65536.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+				}},
+			),
+			Entry(
+				"end port out of range",
+				[]v1.Port{{Port: 8080, EndPort: 80000}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "EndPort field must be in range Port <= x < 65536.",
+					Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+				}},
+			),
+		)
+
+		DescribeTable("should reject a non masquerade interface port with", func(port v1.Port, expectedCause metav1.StatusCause) {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				Ports:                  []v1.Port{port},
+			}}
+			spec.Networks = []v1.Network{{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}}}
+
+			config := stubClusterConfigChecker{bridgeBindingOnPodNetEnabled: true}
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, config)
+			Expect(validator.Validate()).To(ConsistOf(expectedCause))
+		},
+			Entry("ALL protocol", v1.Port{Protocol: "ALL", Port: 53}, metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "Protocol ALL is supported only with masquerade binding",
+				Field:   "fake.domain.devices.interfaces[0].ports[0].protocol",
+			}),
+			Entry("an end port", v1.Port{Port: 5000, EndPort: 5010}, metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "EndPort is supported only with masquerade binding",
+				Field:   "fake.domain.devices.interfaces[0].ports[0].endPort",
+			}),
 		)
 
 		DescribeTable("should accept interface with", func(ports []v1.Port) {
@@ -308,6 +352,9 @@ var _ = Describe("Validating VMI network spec", func() {
 				"multiple ports, same number, different protocols",
 				[]v1.Port{{Port: 80}, {Protocol: "UDP", Port: 80}, {Protocol: "TCP", Port: 80}},
 			),
+			Entry("a port for all protocols", []v1.Port{{Protocol: "ALL", Port: 53}}),
+			Entry("a ports range", []v1.Port{{Port: 5000, EndPort: 5010}}),
+			Entry("a ports range of a single port", []v1.Port{{Port: 5000, EndPort: 5000}}),
 		)
 	})
 
@@ -360,6 +407,64 @@ var _ = Describe("Validating VMI network spec", func() {
 					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ntpServers[1]",
 				}},
 			),
+			Entry(
+				"invalid routes",
+				v1.DHCPOptions{Routes: []v1.DHCPRoute{
+					{Destination: "10.0.0.0"},
+					{Destination: "fd10::/64", Gateway: "fd10::1"},
+				}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP route destination must be a valid IPv4 CIDR.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.routes[0].destination",
+				}, {
+					Type:    "FieldValueInvalid",
+					Message: "DHCP route destination must be a valid IPv4 CIDR.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.routes[1].destination",
+				}, {
+					Type:    "FieldValueInvalid",
+					Message: "DHCP route gateway must be a valid IPv4 address.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.routes[1].gateway",
+				}},
+			),
+			Entry(
+				"invalid search domain",
+				v1.DHCPOptions{SearchDomains: []string{"example.com", "-invalid"}},
+				[]metav1.StatusCause{{
+					Type: "FieldValueInvalid",
+					Message: "DHCP search domain is not valid: [a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, " +
+						"'-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is " +
+						"'[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')]",
+					Field: "fake.domain.devices.interfaces[0].dhcpOptions.searchDomains[1]",
+				}},
+			),
+			Entry(
+				"MTU out of range",
+				v1.DHCPOptions{MTU: pointer.P(uint32(67))},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCP MTU must be in range 68 to 65535",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.mtu",
+				}},
+			),
+			Entry(
+				"IPv4 delegated prefix",
+				v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DelegatedPrefix: "10.0.0.0/8"}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCPv6 delegated prefix must be a valid IPv6 network in CIDR notation.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ipv6.delegatedPrefix",
+				}},
+			),
+			Entry(
+				"delegated prefix with host bits",
+				v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DelegatedPrefix: "fd20:0:1::1/48"}},
+				[]metav1.StatusCause{{
+					Type:    "FieldValueInvalid",
+					Message: "DHCPv6 delegated prefix must be a valid IPv6 network in CIDR notation.",
+					Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ipv6.delegatedPrefix",
+				}},
+			),
 		)
 
 		DescribeTable("should accept interface DHCP options with", func(dhcpOpts v1.DHCPOptions) {
@@ -387,6 +492,38 @@ var _ = Describe("Validating VMI network spec", func() {
 					},
 				},
 			),
+			Entry(
+				"valid routes, search domains, MTU and IPv6 options",
+				v1.DHCPOptions{
+					Routes: []v1.DHCPRoute{
+						{Destination: "0.0.0.0/0", Gateway: "10.0.0.1"},
+						{Destination: "192.168.0.0/16"},
+					},
+					SearchDomains: []string{"example.com"},
+					MTU:           pointer.P(uint32(1400)),
+					IPv6:          &v1.DHCPv6Options{DelegatedPrefix: "fd20:0:1::/48", RouterAdvertisements: true},
+				},
+			),
 		)
+
+		It("should reject DHCPv6 options on an interface with bridge binding", func() {
+			spec := &v1.VirtualMachineInstanceSpec{}
+			spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "secondary",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+				DHCPOptions:            &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{RouterAdvertisements: true}},
+			}}
+			spec.Networks = []v1.Network{{
+				Name:          "secondary",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "test-net"}},
+			}}
+
+			validator := admitter.NewValidator(k8sfield.NewPath("fake"), spec, stubClusterConfigChecker{})
+			Expect(validator.Validate()).To(ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "DHCPv6 options are only supported for interfaces with masquerade binding.",
+				Field:   "fake.domain.devices.interfaces[0].dhcpOptions.ipv6",
+			}))
+		})
 	})
 })
@@ -92,7 +92,7 @@ func (d *configurator) EnsureDHCPServerStarted(podInterfaceName string, dhcpConf
 	_, err := os.Stat(dhcpStartedFile)
 	if errors.Is(err, os.ErrNotExist) {
 		if err := d.handler.StartDHCP(&dhcpConfig, d.advertisingIfaceName, dhcpOptions); err != nil {
-			return fmt.Errorf("failed to start DHCP server for interface %s", podInterfaceName)
+			return fmt.Errorf("failed to start DHCP server for interface %s: %v", podInterfaceName, err)
 		}
 		newFile, err := os.Create(dhcpStartedFile)
 		if err != nil {
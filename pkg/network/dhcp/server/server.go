@@ -43,6 +43,8 @@ const (
 	errorSearchDomainNotValid = "Search domain is not valid"
 	errorSearchDomainTooLong  = "Search domains length exceeded allowable size"
 	errorNTPConfiguration     = "Could not parse NTP server as IPv4 address: %s"
+	errorRouteDestination     = "Could not parse route destination as IPv4 CIDR: %s"
+	errorRouteGateway         = "Could not parse route gateway as IPv4 address: %s"
 )
 
 // simple domain validation regex. Put it here to avoid compiling each time.
@@ -125,6 +127,15 @@ func prepareDHCPOptions(
 		dhcpOptions[dhcp.OptionRouter] = routerIP.To4()
 	}
 
+	if customDHCPOptions != nil && len(customDHCPOptions.Routes) > 0 {
+		log.Log.Infof("Setting dhcp option classless static routes to %v", customDHCPOptions.Routes)
+		customRoutes, err := convertDHCPRoutes(customDHCPOptions.Routes)
+		if err != nil {
+			return nil, err
+		}
+		routes = customRoutes
+	}
+
 	netRoutes := formClasslessRoutes(routes)
 
 	if len(netRoutes) != 0 {
@@ -277,6 +288,29 @@ func formClasslessRoutes(routes *[]netlink.Route) (formattedRoutes []byte) {
 	return
 }
 
+func convertDHCPRoutes(dhcpRoutes []v1.DHCPRoute) (*[]netlink.Route, error) {
+	routes := make([]netlink.Route, 0, len(dhcpRoutes))
+	for _, dhcpRoute := range dhcpRoutes {
+		_, dst, err := net.ParseCIDR(dhcpRoute.Destination)
+		if err != nil || dst.IP.To4() == nil {
+			return nil, fmt.Errorf(errorRouteDestination, dhcpRoute.Destination)
+		}
+		route := netlink.Route{Dst: dst}
+		// Default routes are represented by a nil destination, so they are sorted last
+		if ones, _ := dst.Mask.Size(); ones == 0 {
+			route.Dst = nil
+		}
+		if dhcpRoute.Gateway != "" {
+			route.Gw = net.ParseIP(dhcpRoute.Gateway).To4()
+			if route.Gw == nil {
+				return nil, fmt.Errorf(errorRouteGateway, dhcpRoute.Gateway)
+			}
+		}
+		routes = append(routes, route)
+	}
+	return &routes, nil
+}
+
 func convertSearchDomainsToBytes(searchDomainStrings []string) ([]byte, error) {
 	/*
 	   https://tools.ietf.org/html/rfc3397
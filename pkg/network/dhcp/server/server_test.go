@@ -212,6 +212,40 @@ var _ = Describe("DHCP Server", func() {
 			Expect(options[240]).To(Equal([]byte("private.options.kubevirt.io")))
 		})
 
+		It("should replace the pod routes with the custom routes", func() {
+			gw := net.ParseIP("192.168.2.1")
+			podRoutes := &[]netlink.Route{{Gw: gw}}
+			dhcpOptions := &v1.DHCPOptions{
+				Routes: []v1.DHCPRoute{
+					{Destination: "0.0.0.0/0", Gateway: "192.168.2.254"},
+					{Destination: "10.0.0.0/8", Gateway: "192.168.2.2"},
+					{Destination: "192.168.3.0/24"},
+				},
+			}
+
+			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, podRoutes, nil, 1500, "myhost", dhcpOptions)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options[dhcp4.OptionClasslessRouteFormat]).To(Equal([]byte{
+				8, 10, 192, 168, 2, 2,
+				24, 192, 168, 3, 0, 0, 0, 0,
+				0, 192, 168, 2, 254,
+			}))
+		})
+
+		DescribeTable("should reject invalid custom routes", func(route v1.DHCPRoute) {
+			gw := net.ParseIP("192.168.2.1")
+			dhcpOptions := &v1.DHCPOptions{Routes: []v1.DHCPRoute{route}}
+
+			_, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, nil, nil, 1500, "myhost", dhcpOptions)
+
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("with a destination which is not a CIDR", v1.DHCPRoute{Destination: "10.0.0.0"}),
+			Entry("with an IPv6 destination", v1.DHCPRoute{Destination: "fd10::/64"}),
+			Entry("with an IPv6 gateway", v1.DHCPRoute{Destination: "10.0.0.0/8", Gateway: "fd10::1"}),
+		)
+
 		It("expects the gateway as an IPv4 addresses", func() {
 			gw := net.ParseIP("192.168.2.1")
 			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, nil, nil, 1500, "myhost", nil)
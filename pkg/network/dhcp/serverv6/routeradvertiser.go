@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"golang.org/x/net/ipv6"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	raInterval       = 200 * time.Second
+	raRouterLifetime = 1800 * time.Second
+	raHopLimit       = 255
+	raCurHopLimit    = 64
+	infiniteLifetime = 0xffffffff
+
+	raFlagManaged     = 0x80
+	raFlagOtherConfig = 0x40
+
+	prefixFlagOnLink     = 0x80
+	prefixFlagAutonomous = 0x40
+	// SLAAC builds the addresses from 64 bits interface identifiers
+	slaacPrefixLength = 64
+
+	optionSourceLinkLayerAddress = 1
+	optionPrefixInformation      = 3
+	optionMTU                    = 5
+)
+
+// SingleClientRouterAdvertiser sends IPv6 router advertisements on the server interface,
+// periodically and in reply to router solicitations received on it.
+func SingleClientRouterAdvertiser(clientIP net.IP, clientMask net.IPMask, serverIfaceName string, mtu uint16) error {
+	log.Log.Info("Starting SingleClientRouterAdvertiser")
+
+	iface, err := net.InterfaceByName(serverIfaceName)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertiser, couldn't get the server interface: %v", err)
+	}
+
+	prefix := &net.IPNet{IP: clientIP.Mask(clientMask), Mask: clientMask}
+	advertisement := buildRouterAdvertisement(prefix, mtu, iface.HardwareAddr)
+
+	conn, err := newRouterSolicitationConnection(iface)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertiser: %v", err)
+	}
+	defer conn.Close()
+
+	for {
+		if err := sendRouterAdvertisement(conn, iface, advertisement); err != nil {
+			return fmt.Errorf("failed to send router advertisement: %v", err)
+		}
+		if err := waitForRouterSolicitation(conn, iface, raInterval); err != nil {
+			return fmt.Errorf("failed to receive router solicitation: %v", err)
+		}
+	}
+}
+
+func newRouterSolicitationConnection(iface *net.Interface) (*ipv6.PacketConn, error) {
+	const errorString = "Failed creating connection for router advertiser"
+	c, err := net.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+	conn := ipv6.NewPacketConn(c)
+
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+	if err := conn.SetICMPFilter(&filter); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+	if err := conn.SetControlMessage(ipv6.FlagInterface, true); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+	if err := conn.JoinGroup(iface, &net.IPAddr{IP: net.IPv6linklocalallrouters}); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf(errFmt, errorString, err)
+	}
+	return conn, nil
+}
+
+func sendRouterAdvertisement(conn *ipv6.PacketConn, iface *net.Interface, advertisement []byte) error {
+	cm := &ipv6.ControlMessage{HopLimit: raHopLimit, IfIndex: iface.Index}
+	dst := &net.IPAddr{IP: net.IPv6linklocalallnodes, Zone: iface.Name}
+	_, err := conn.WriteTo(advertisement, cm, dst)
+	return err
+}
+
+func waitForRouterSolicitation(conn *ipv6.PacketConn, iface *net.Interface, timeout time.Duration) error {
+	if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	b := make([]byte, iface.MTU)
+	for {
+		n, cm, _, err := conn.ReadFrom(b)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if n > 0 && ipv6.ICMPType(b[0]) == ipv6.ICMPTypeRouterSolicitation && cm != nil && cm.IfIndex == iface.Index {
+			log.Log.V(4).Info("Router solicitation received")
+			return nil
+		}
+	}
+}
+
+// buildRouterAdvertisement builds the ICMPv6 router advertisement message, see RFC 4861 section 4.2.
+// The prefix is flagged for SLAAC when it is a /64, the managed flag telling the guest to request its
+// address over DHCPv6 as well.
+// The checksum is left empty, it is computed by the kernel for ICMPv6 raw sockets.
+func buildRouterAdvertisement(prefix *net.IPNet, mtu uint16, sourceMAC net.HardwareAddr) []byte {
+	msg := []byte{byte(ipv6.ICMPTypeRouterAdvertisement), 0, 0, 0, raCurHopLimit, raFlagManaged | raFlagOtherConfig}
+	msg = binary.BigEndian.AppendUint16(msg, uint16(raRouterLifetime.Seconds()))
+	// Reachable time and retransmission timer are left unspecified
+	msg = binary.BigEndian.AppendUint32(msg, 0)
+	msg = binary.BigEndian.AppendUint32(msg, 0)
+
+	if len(sourceMAC) != 0 {
+		msg = append(msg, optionSourceLinkLayerAddress, byte((2+len(sourceMAC)+7)/8))
+		msg = append(msg, sourceMAC...)
+		for len(msg)%8 != 0 {
+			msg = append(msg, 0)
+		}
+	}
+
+	msg = append(msg, optionMTU, 1, 0, 0)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(mtu))
+
+	prefixLength, _ := prefix.Mask.Size()
+	prefixFlags := byte(prefixFlagOnLink)
+	if prefixLength == slaacPrefixLength {
+		prefixFlags |= prefixFlagAutonomous
+	}
+	msg = append(msg, optionPrefixInformation, 4, byte(prefixLength), prefixFlags)
+	msg = binary.BigEndian.AppendUint32(msg, infiniteLifetime)
+	msg = binary.BigEndian.AppendUint32(msg, infiniteLifetime)
+	msg = binary.BigEndian.AppendUint32(msg, 0)
+	msg = append(msg, prefix.IP.To16()...)
+
+	return msg
+}
@@ -0,0 +1,61 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package serverv6
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router advertisement", func() {
+	var serverInterfaceMac net.HardwareAddr
+
+	BeforeEach(func() {
+		serverInterfaceMac, _ = net.ParseMAC("12:34:56:78:9A:BC")
+	})
+
+	It("should contain the header, source link-layer address, MTU and prefix options", func() {
+		_, prefix, _ := net.ParseCIDR("fd10:0:2::/64")
+
+		msg := buildRouterAdvertisement(prefix, 1400, serverInterfaceMac)
+
+		Expect(msg).To(Equal([]byte{
+			134, 0, 0, 0, 64, 0xc0, 0x07, 0x08, 0, 0, 0, 0, 0, 0, 0, 0,
+			1, 1, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc,
+			5, 1, 0, 0, 0, 0, 0x05, 0x78,
+			3, 4, 64, 0xc0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0, 0, 0, 0,
+			0xfd, 0x10, 0, 0, 0, 0x02, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		}))
+	})
+
+	DescribeTable("should flag the prefix for SLAAC", func(cidr string, prefixLength, flags byte) {
+		_, prefix, _ := net.ParseCIDR(cidr)
+
+		msg := buildRouterAdvertisement(prefix, 1500, serverInterfaceMac)
+
+		const prefixOptionOffset = 32
+		Expect(msg[prefixOptionOffset : prefixOptionOffset+4]).To(Equal([]byte{3, 4, prefixLength, flags}))
+	},
+		Entry("with a /64 VM network", "fd10:0:2::/64", byte(64), byte(0xc0)),
+		Entry("but not with the default VM network, which is too small", "fd10:0:2::/120", byte(120), byte(0x80)),
+	)
+})
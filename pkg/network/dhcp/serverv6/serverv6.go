@@ -28,6 +28,7 @@ import (
 	"github.com/insomniacslk/dhcp/dhcpv6/server6"
 	"github.com/insomniacslk/dhcp/iana"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 )
 
@@ -36,11 +37,12 @@ const (
 )
 
 type DHCPv6Handler struct {
-	clientIP  net.IP
-	modifiers []dhcpv6.Modifier
+	clientIP        net.IP
+	modifiers       []dhcpv6.Modifier
+	delegatedPrefix *net.IPNet
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
+func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, searchDomains []string, customDHCPOptions *v1.DHCPOptions) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -48,11 +50,17 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 		return fmt.Errorf("couldn't create DHCPv6 server, couldn't get the dhcp6 server interface: %v", err)
 	}
 
-	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr)
+	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, searchDomains)
+
+	delegatedPrefix, err := delegatedPrefixFromDHCPOptions(customDHCPOptions)
+	if err != nil {
+		return fmt.Errorf("couldn't create DHCPv6 server: %v", err)
+	}
 
 	handler := &DHCPv6Handler{
-		clientIP:  clientIP,
-		modifiers: modifiers,
+		clientIP:        clientIP,
+		modifiers:       modifiers,
+		delegatedPrefix: delegatedPrefix,
 	}
 
 	conn, err := NewConnection(iface)
@@ -118,12 +126,34 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 		ianaResponse.IaId = ianaRequest.IaId
 		response.UpdateOption(ianaResponse)
 	}
+
+	iapdRequest := dhcpv6Msg.Options.OneIAPD()
+	if iapdRequest != nil && h.delegatedPrefix != nil {
+		log.Log.V(4).Infof("DHCPv6 - delegating prefix %s", h.delegatedPrefix)
+		optIAPrefix := &dhcpv6.OptIAPrefix{Prefix: h.delegatedPrefix, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
+		dhcpv6.WithIAPD(iapdRequest.IaId, optIAPrefix)(response)
+	}
 	return response, nil
 }
 
-func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr) []dhcpv6.Modifier {
+func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr, searchDomains []string) []dhcpv6.Modifier {
 	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
 	duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: serverInterfaceMac}
 
-	return []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	modifiers := []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	if len(searchDomains) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDomainSearchList(searchDomains...))
+	}
+	return modifiers
+}
+
+func delegatedPrefixFromDHCPOptions(customDHCPOptions *v1.DHCPOptions) (*net.IPNet, error) {
+	if customDHCPOptions == nil || customDHCPOptions.IPv6 == nil || customDHCPOptions.IPv6.DelegatedPrefix == "" {
+		return nil, nil
+	}
+	_, prefix, err := net.ParseCIDR(customDHCPOptions.IPv6.DelegatedPrefix)
+	if err != nil || prefix.IP.To4() != nil {
+		return nil, fmt.Errorf("could not parse delegated prefix as IPv6 CIDR: %s", customDHCPOptions.IPv6.DelegatedPrefix)
+	}
+	return prefix, nil
 }
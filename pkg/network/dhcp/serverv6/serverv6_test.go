@@ -27,6 +27,8 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("DHCPv6", func() {
@@ -34,7 +36,7 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil)
 			Expect(modifiers).To(HaveLen(2))
 
 			msg := &dhcpv6.Message{
@@ -53,13 +55,43 @@ var _ = Describe("DHCPv6", func() {
 			Expect(msg.GetOneOption(dhcpv6.OptionServerID).String()).To(Equal(expectedServerId.String()))
 		})
 	})
+	Context("prepareDHCPv6Modifiers with search domains", func() {
+		It("should contain the domain search list", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, []string{"example.com", "kubevirt.io"})
+			Expect(modifiers).To(HaveLen(3))
+
+			msg := &dhcpv6.Message{
+				MessageType: dhcpv6.MessageTypeAdvertise,
+			}
+			modifiers[2](msg)
+			Expect(msg.Options.DomainSearchList().Labels).To(Equal([]string{"example.com", "kubevirt.io"}))
+		})
+	})
+	Context("delegatedPrefixFromDHCPOptions", func() {
+		It("should return no prefix when not configured", func() {
+			prefix, err := delegatedPrefixFromDHCPOptions(&v1.DHCPOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(prefix).To(BeNil())
+		})
+		It("should return the configured prefix", func() {
+			prefix, err := delegatedPrefixFromDHCPOptions(&v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DelegatedPrefix: "fd20:0:1::/48"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(prefix.String()).To(Equal("fd20:0:1::/48"))
+		})
+		It("should reject an IPv4 prefix", func() {
+			_, err := delegatedPrefixFromDHCPOptions(&v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DelegatedPrefix: "10.0.0.0/8"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil)
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
@@ -111,6 +143,29 @@ var _ = Describe("DHCPv6", func() {
 			expectedLength := len(handler.modifiers) + 1
 			Expect(replyMessage.Options.Options).To(HaveLen(expectedLength))
 		})
+		It("iapd option containing the iaid from the request and the delegated prefix", func() {
+			_, handler.delegatedPrefix, _ = net.ParseCIDR("fd20:0:1::/48")
+			clientMessage, err := newMessage(dhcpv6.MessageTypeSolicit)
+			Expect(err).ToNot(HaveOccurred())
+			clientMessage.UpdateOption(&dhcpv6.OptIAPD{IaId: [4]byte{5, 6, 7, 8}})
+
+			replyMessage, err := handler.buildResponse(clientMessage)
+			Expect(err).ToNot(HaveOccurred())
+			iapd := replyMessage.Options.OneIAPD()
+			Expect(iapd).ToNot(BeNil())
+			Expect(iapd.IaId).To(Equal([4]byte{5, 6, 7, 8}))
+			Expect(iapd.Options.Prefixes()).To(HaveLen(1))
+			Expect(iapd.Options.Prefixes()[0].Prefix.String()).To(Equal("fd20:0:1::/48"))
+		})
+		It("no iapd option when no prefix is delegated", func() {
+			clientMessage, err := newMessage(dhcpv6.MessageTypeSolicit)
+			Expect(err).ToNot(HaveOccurred())
+			clientMessage.UpdateOption(&dhcpv6.OptIAPD{IaId: [4]byte{5, 6, 7, 8}})
+
+			replyMessage, err := handler.buildResponse(clientMessage)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(replyMessage.Options.OneIAPD()).To(BeNil())
+		})
 		It("handle request without iana option", func() {
 			clientMac, _ := net.ParseMAC("34:56:78:9A:BC:DE")
 			duid := &dhcpv6.DUIDLL{HWType: iana.HWTypeEthernet, LinkLayerAddr: clientMac}
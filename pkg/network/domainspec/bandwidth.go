@@ -0,0 +1,60 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package domainspec
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const kibibyte = 1024
+
+// NewBandWidth translates the bandwidth limits of an interface into the libvirt bandwidth element,
+// which expresses rates in kibibytes per second and bursts in kibibytes.
+func NewBandWidth(bandwidth *v1.InterfaceBandwidth) *api.BandWidth {
+	if bandwidth == nil || (bandwidth.Inbound == nil && bandwidth.Outbound == nil) {
+		return nil
+	}
+	return &api.BandWidth{
+		Inbound:  newBandWidthLimit(bandwidth.Inbound),
+		Outbound: newBandWidthLimit(bandwidth.Outbound),
+	}
+}
+
+func newBandWidthLimit(limit *v1.InterfaceBandwidthLimit) *api.BandWidthLimit {
+	if limit == nil {
+		return nil
+	}
+	return &api.BandWidthLimit{
+		Average: toKibibytes(&limit.Average),
+		Peak:    toKibibytes(limit.Peak),
+		Burst:   toKibibytes(limit.Burst),
+	}
+}
+
+func toKibibytes(quantity *resource.Quantity) uint64 {
+	if quantity == nil || quantity.Sign() <= 0 {
+		return 0
+	}
+	return uint64(quantity.Value() / kibibyte)
+}
@@ -30,10 +30,13 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/vishvananda/netlink"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	dutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -108,6 +111,34 @@ var _ = Describe("Pod Network", func() {
 
 				verifyTapDomain(domain.Spec.Devices.Interfaces, tapName, mtu, fakeMac.String())
 			})
+
+			It("Should set the interface bandwidth", func() {
+				mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+					Inbound: &v1.InterfaceBandwidthLimit{
+						Average: resource.MustParse("10Mi"),
+						Peak:    pointer.P(resource.MustParse("20Mi")),
+						Burst:   pointer.P(resource.MustParse("1Mi")),
+					},
+					Outbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("1500Ki")},
+				}
+
+				Expect(specGenerator.Generate()).To(Succeed())
+
+				Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+					Inbound:  &api.BandWidthLimit{Average: 10240, Peak: 20480, Burst: 1024},
+					Outbound: &api.BandWidthLimit{Average: 1500},
+				}))
+			})
+
+			It("Should not set the interface bandwidth when no limit is requested", func() {
+				mockNetwork.EXPECT().LinkByName(tapName).Return(tapInterface, nil)
+				vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{}
+
+				Expect(specGenerator.Generate()).To(Succeed())
+
+				Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(BeNil())
+			})
 		})
 	})
 })
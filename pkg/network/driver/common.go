@@ -172,6 +172,19 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 		searchDomains = append([]string{domain}, searchDomains...)
 	}
 
+	mtu := nic.Mtu
+	if dhcpOptions != nil {
+		if len(dhcpOptions.SearchDomains) > 0 {
+			searchDomains = dhcpOptions.SearchDomains
+		}
+		if dhcpOptions.MTU != nil {
+			if *dhcpOptions.MTU > uint32(nic.Mtu) {
+				return fmt.Errorf("the DHCP MTU %d exceeds the MTU %d of the pod interface", *dhcpOptions.MTU, nic.Mtu)
+			}
+			mtu = uint16(*dhcpOptions.MTU)
+		}
+	}
+
 	if nic.IP.IPNet != nil {
 		// panic in case the DHCP server failed during the vm creation
 		// but ignore dhcp errors when the vm is destroyed or shutting down
@@ -186,7 +199,7 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 				nameservers,
 				nic.Routes,
 				searchDomains,
-				nic.Mtu,
+				mtu,
 				dhcpOptions,
 			); err != nil {
 				log.Log.Errorf("failed to run DHCP Server: %v", err)
@@ -200,11 +213,27 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 			if err = DHCPv6Server(
 				nic.IPv6.IP,
 				bridgeInterfaceName,
+				searchDomains,
+				dhcpOptions,
 			); err != nil {
 				log.Log.Reason(err).Error("failed to run DHCPv6 Server")
 				panic(err)
 			}
 		}()
+
+		if dhcpOptions != nil && dhcpOptions.IPv6 != nil && dhcpOptions.IPv6.RouterAdvertisements {
+			go func() {
+				if err := RouterAdvertiser(
+					nic.IPv6.IP,
+					nic.IPv6.Mask,
+					bridgeInterfaceName,
+					mtu,
+				); err != nil {
+					log.Log.Reason(err).Error("failed to run the IPv6 router advertiser")
+					panic(err)
+				}
+			}()
+		}
 	}
 
 	return nil
@@ -213,3 +242,4 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 // Allow mocking for tests
 var DHCPServer = dhcpserver.SingleClientDHCPServer
 var DHCPv6Server = dhcpserverv6.SingleClientDHCPv6Server
+var RouterAdvertiser = dhcpserverv6.SingleClientRouterAdvertiser
@@ -232,6 +232,21 @@ func (n *NetLink) RouteAdd(route *vishnetlink.Route) error {
 	return nil
 }
 
+func (n *NetLink) RouteReplace(route *vishnetlink.Route) error {
+	routes := &n.routes4
+	if ipFamily(route.Dst.IP) == vishnetlink.FAMILY_V6 {
+		routes = &n.routes6
+	}
+	for i := range *routes {
+		if (*routes)[i].Dst != nil && (*routes)[i].Dst.String() == route.Dst.String() && (*routes)[i].Table == route.Table {
+			(*routes)[i] = *route
+			return nil
+		}
+	}
+	*routes = append(*routes, *route)
+	return nil
+}
+
 func (n *NetLink) lookupLinkByName(name string) vishnetlink.Link {
 	for i, l := range n.links {
 		if l.Attrs().Name == name {
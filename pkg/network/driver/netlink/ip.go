@@ -31,6 +31,10 @@ func (n NetLink) RouteList(link netlink.Link, family int) ([]netlink.Route, erro
 	return netlink.RouteList(link, family)
 }
 
+func (n NetLink) RouteReplace(route *netlink.Route) error {
+	return netlink.RouteReplace(route)
+}
+
 func (n NetLink) AddrReplace(link netlink.Link, addr *netlink.Addr) error {
 	return netlink.AddrReplace(link, addr)
 }
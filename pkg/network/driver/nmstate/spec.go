@@ -72,6 +72,10 @@ func (n NMState) Apply(spec *Spec) error {
 		}
 	}
 
+	if err := n.setupRoutes(spec.Routes.Config); err != nil {
+		return err
+	}
+
 	err := n.setupLinuxStack(spec.LinuxStack)
 
 	return err
@@ -287,6 +291,29 @@ func initLink(ifaceName string, link vishnetlink.Link) (vishnetlink.Link, error)
 	return link, nil
 }
 
+func (n NMState) setupRoutes(routes []Route) error {
+	for _, route := range routes {
+		link, err := n.adapter.LinkByName(route.NextHopInterface)
+		if err != nil {
+			return fmt.Errorf("failed to setup route to %s: %v", route.Destination, err)
+		}
+		_, dst, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return fmt.Errorf("failed to setup route to %s: %v", route.Destination, err)
+		}
+		netlinkRoute := &vishnetlink.Route{
+			LinkIndex: link.Attrs().Index,
+			Dst:       dst,
+			Gw:        net.ParseIP(route.NextHopAddress),
+			Table:     route.TableID,
+		}
+		if err := n.adapter.RouteReplace(netlinkRoute); err != nil {
+			return fmt.Errorf("failed to setup route to %s: %v", route.Destination, err)
+		}
+	}
+	return nil
+}
+
 func (n NMState) setupLinuxStack(linuxStack LinuxStack) error {
 	if val := linuxStack.IPv4.Forwarding; val != nil && *val {
 		if err := n.adapter.IPv4EnableForwarding(); err != nil {
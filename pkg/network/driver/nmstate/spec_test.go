@@ -556,3 +556,37 @@ var _ = Describe("NMState Spec Linux Stack", func() {
 		),
 	)
 })
+
+var _ = Describe("NMState Spec Routes", func() {
+	var nmState nmstate.NMState
+
+	BeforeEach(func() {
+		nmState = nmstate.New(nmstate.WithAdapter(newTestAdapter()))
+		Expect(nmState.Apply(&nmstate.Spec{Interfaces: []nmstate.Interface{
+			{Name: dummyName, TypeName: nmstate.TypeDummy, State: nmstate.IfaceStateUp},
+		}})).To(Succeed())
+	})
+
+	DescribeTable("setup a route", func(destination, nextHopAddress string) {
+		route := nmstate.Route{Destination: destination, NextHopInterface: dummyName, NextHopAddress: nextHopAddress}
+		Expect(nmState.Apply(&nmstate.Spec{Routes: nmstate.Routes{Config: []nmstate.Route{route}}})).To(Succeed())
+
+		By("applying the route again")
+		Expect(nmState.Apply(&nmstate.Spec{Routes: nmstate.Routes{Config: []nmstate.Route{route}}})).To(Succeed())
+
+		status, err := nmState.Read()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Routes).To(Equal(nmstate.Routes{Running: []nmstate.Route{route}}))
+	},
+		Entry("with ipv4", "10.10.10.0/24", "1.1.1.1"),
+		Entry("with ipv6", "2001:db8::/56", "fd10:0:2::2"),
+	)
+
+	It("fails when the next hop interface is missing", func() {
+		Expect(nmState.Apply(&nmstate.Spec{Routes: nmstate.Routes{Config: []nmstate.Route{{
+			Destination:      "2001:db8::/56",
+			NextHopInterface: "missing",
+			NextHopAddress:   "fd10:0:2::2",
+		}}}})).NotTo(Succeed())
+	})
+})
@@ -32,6 +32,7 @@ import (
 
 type Spec struct {
 	Interfaces []Interface `json:"interfaces,omitempty"`
+	Routes     Routes      `json:"routes,omitempty"`
 	LinuxStack LinuxStack  `json:"linux-stack,omitempty"`
 }
 
@@ -73,6 +74,7 @@ type IPAddress struct {
 }
 
 type Routes struct {
+	Config  []Route `json:"config,omitempty"`
 	Running []Route `json:"running,omitempty"`
 }
 
@@ -148,6 +150,7 @@ type adapter interface {
 	AddrDel(vishnetlink.Link, *vishnetlink.Addr) error
 	ParseAddr(string) (*vishnetlink.Addr, error)
 	RouteList(vishnetlink.Link, int) ([]vishnetlink.Route, error)
+	RouteReplace(*vishnetlink.Route) error
 
 	IPv4GetForwarding() (bool, error)
 	IPv4EnableForwarding() error
@@ -221,6 +224,19 @@ func LookupInterface(ifaces []Interface, predicate func(Interface) bool) *Interf
 	return nil
 }
 
+// FirstIPGlobalUnicast returns the first global unicast address of an enabled IP stack, or nil if there is none.
+func FirstIPGlobalUnicast(ip IP) *IPAddress {
+	if ip.Enabled != nil && *ip.Enabled {
+		for _, addr := range ip.Address {
+			if net.ParseIP(addr.IP).IsGlobalUnicast() {
+				address := addr
+				return &address
+			}
+		}
+	}
+	return nil
+}
+
 func normalizeLinkTypeName(link vishnetlink.Link) string {
 	typeName := link.Type()
 	if typeName == "tuntap" {
@@ -0,0 +1,355 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package netbinding
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
+)
+
+const (
+	pluginCallTimeout = time.Minute
+	// statusCallTimeout is short, the status is polled periodically and a slow plugin only delays its own report
+	statusCallTimeout     = 5 * time.Second
+	statusRefreshInterval = 10 * time.Second
+)
+
+// PluginManager calls the network binding plugin sidecars serving the BindingPlugin service.
+// It keeps the last VMI it has been called with, so that calls which are not issued
+// in the context of a VMI sync, like Teardown and Status, receive up to date interfaces.
+type PluginManager struct {
+	sockets func() map[string]string
+
+	lock sync.Mutex
+	vmi  *v1.VirtualMachineInstance
+
+	statuses          []*netbindingv2.InterfaceStatus
+	statusRefreshedAt time.Time
+	statusRefreshing  bool
+}
+
+var pluginManager *PluginManager
+var pluginManagerOnce sync.Once
+
+// GetPluginManager returns the plugin manager using the binding plugin sockets collected by the hooks manager.
+func GetPluginManager() *PluginManager {
+	pluginManagerOnce.Do(func() {
+		pluginManager = NewPluginManager(hooks.GetManager().NetBindingPluginSockets)
+	})
+	return pluginManager
+}
+
+// NewPluginManager creates a plugin manager, the sockets func returns the plugin sockets keyed by the binding name.
+func NewPluginManager(sockets func() map[string]string) *PluginManager {
+	return &PluginManager{sockets: sockets}
+}
+
+// Handles reports whether the interface binding is served by a plugin sidecar through the BindingPlugin service.
+func (p *PluginManager) Handles(iface v1.Interface) bool {
+	if iface.Binding == nil {
+		return false
+	}
+	_, exists := p.sockets()[iface.Binding.Name]
+	return exists
+}
+
+// Setup prepares the interfaces of the VMI handled by the plugins and returns the devices to attach to the domain.
+func (p *PluginManager) Setup(vmi *v1.VirtualMachineInstance) ([]*netbindingv2.Device, error) {
+	p.setVMI(vmi)
+
+	var devices []*netbindingv2.Device
+	err := p.callPerPlugin(vmi, pluginCallTimeout, func(ctx context.Context, client netbindingv2.BindingPluginClient, params *pluginParams) error {
+		result, err := client.Setup(ctx, &netbindingv2.SetupParams{Vmi: params.vmi, Interfaces: params.interfaces})
+		if err != nil {
+			return err
+		}
+		devices = append(devices, result.GetDevices()...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up the network binding plugin interfaces: %v", err)
+	}
+	return devices, nil
+}
+
+// Teardown releases the resources allocated by the plugins for the interfaces of the last known VMI.
+func (p *PluginManager) Teardown() error {
+	vmi := p.getVMI()
+	if vmi == nil {
+		return nil
+	}
+	err := p.callPerPlugin(vmi, pluginCallTimeout, func(ctx context.Context, client netbindingv2.BindingPluginClient, params *pluginParams) error {
+		_, err := client.Teardown(ctx, &netbindingv2.TeardownParams{Vmi: params.vmi, Interfaces: params.interfaces})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tear down the network binding plugin interfaces: %v", err)
+	}
+	return nil
+}
+
+// PreMigrate informs the plugins on the migration source that the VMI is about to be migrated.
+func (p *PluginManager) PreMigrate(vmi *v1.VirtualMachineInstance) error {
+	p.setVMI(vmi)
+
+	err := p.callPerPlugin(vmi, pluginCallTimeout, func(ctx context.Context, client netbindingv2.BindingPluginClient, params *pluginParams) error {
+		_, err := client.PreMigrate(ctx, &netbindingv2.PreMigrateParams{Vmi: params.vmi, Interfaces: params.interfaces})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("network binding plugin pre-migration failed: %v", err)
+	}
+	return nil
+}
+
+// PostMigrate informs the plugins on the migration target that the VMI has been migrated.
+func (p *PluginManager) PostMigrate(vmi *v1.VirtualMachineInstance) error {
+	p.setVMI(vmi)
+
+	err := p.callPerPlugin(vmi, pluginCallTimeout, func(ctx context.Context, client netbindingv2.BindingPluginClient, params *pluginParams) error {
+		_, err := client.PostMigrate(ctx, &netbindingv2.PostMigrateParams{Vmi: params.vmi, Interfaces: params.interfaces})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("network binding plugin post-migration failed: %v", err)
+	}
+	return nil
+}
+
+// Hotplug prepares the named interface plugged into the running VMI and returns the device to attach to the domain.
+func (p *PluginManager) Hotplug(vmi *v1.VirtualMachineInstance, ifaceName string) (*netbindingv2.Device, error) {
+	p.setVMI(vmi)
+
+	var device *netbindingv2.Device
+	err := p.callPlugin(vmi, ifaceName, func(ctx context.Context, client netbindingv2.BindingPluginClient, vmiParams *netbindingv2.VMI, iface *netbindingv2.Interface) error {
+		result, err := client.Hotplug(ctx, &netbindingv2.HotplugParams{Vmi: vmiParams, Interface: iface})
+		if err != nil {
+			return err
+		}
+		device = result.GetDevice()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hotplug network binding plugin interface %s: %v", ifaceName, err)
+	}
+	if device == nil {
+		return nil, fmt.Errorf("network binding plugin returned no device for interface %s", ifaceName)
+	}
+	return device, nil
+}
+
+// Unplug informs the plugin that the named interface has been detached from the running VMI.
+func (p *PluginManager) Unplug(vmi *v1.VirtualMachineInstance, ifaceName string) error {
+	p.setVMI(vmi)
+
+	err := p.callPlugin(vmi, ifaceName, func(ctx context.Context, client netbindingv2.BindingPluginClient, vmiParams *netbindingv2.VMI, iface *netbindingv2.Interface) error {
+		_, err := client.Unplug(ctx, &netbindingv2.UnplugParams{Vmi: vmiParams, Interface: iface})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unplug network binding plugin interface %s: %v", ifaceName, err)
+	}
+	return nil
+}
+
+// Status returns the last status of the interfaces of the last known VMI reported by the plugins.
+// It does not wait for the plugins: when the reported status is stale, it is refreshed in the background
+// and the refreshed status is returned by the following calls.
+func (p *PluginManager) Status() []*netbindingv2.InterfaceStatus {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.vmi != nil && !p.statusRefreshing && time.Since(p.statusRefreshedAt) >= statusRefreshInterval {
+		p.statusRefreshing = true
+		go p.refreshStatus(p.vmi)
+	}
+	return p.statuses
+}
+
+func (p *PluginManager) refreshStatus(vmi *v1.VirtualMachineInstance) {
+	statuses := p.pollStatus(vmi)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.statuses = statuses
+	p.statusRefreshedAt = time.Now()
+	p.statusRefreshing = false
+}
+
+// pollStatus calls the plugins for the status of the VMI interfaces.
+// A failing plugin is logged and skipped, as the status is reported periodically.
+func (p *PluginManager) pollStatus(vmi *v1.VirtualMachineInstance) []*netbindingv2.InterfaceStatus {
+	var statuses []*netbindingv2.InterfaceStatus
+	err := p.callPerPlugin(vmi, statusCallTimeout, func(ctx context.Context, client netbindingv2.BindingPluginClient, params *pluginParams) error {
+		result, err := client.Status(ctx, &netbindingv2.StatusParams{Vmi: params.vmi, Interfaces: params.interfaces})
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to get the interfaces status from network binding plugin %s", params.bindingName)
+			return nil
+		}
+		statuses = append(statuses, result.GetInterfaces()...)
+		return nil
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to get the network binding plugin interfaces status")
+	}
+	return statuses
+}
+
+func (p *PluginManager) setVMI(vmi *v1.VirtualMachineInstance) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.vmi = vmi.DeepCopy()
+	// The interfaces may have changed, the status is refreshed on the next call
+	p.statusRefreshedAt = time.Time{}
+}
+
+func (p *PluginManager) getVMI() *v1.VirtualMachineInstance {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.vmi
+}
+
+type pluginParams struct {
+	bindingName string
+	vmi         *netbindingv2.VMI
+	interfaces  []*netbindingv2.Interface
+}
+
+// callPerPlugin calls every plugin handling at least one of the non-absent interfaces of the VMI.
+func (p *PluginManager) callPerPlugin(vmi *v1.VirtualMachineInstance, timeout time.Duration, call func(context.Context, netbindingv2.BindingPluginClient, *pluginParams) error) error {
+	sockets := p.sockets()
+	if len(sockets) == 0 {
+		return nil
+	}
+
+	var bindingNames []string
+	interfacesByBinding := map[string][]*netbindingv2.Interface{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Binding == nil || iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		if _, exists := sockets[iface.Binding.Name]; !exists {
+			continue
+		}
+		if _, seen := interfacesByBinding[iface.Binding.Name]; !seen {
+			bindingNames = append(bindingNames, iface.Binding.Name)
+		}
+		interfacesByBinding[iface.Binding.Name] = append(interfacesByBinding[iface.Binding.Name], newPluginInterface(vmi, iface))
+	}
+
+	for _, bindingName := range bindingNames {
+		params := &pluginParams{
+			bindingName: bindingName,
+			vmi:         newPluginVMI(vmi),
+			interfaces:  interfacesByBinding[bindingName],
+		}
+		if err := callSocket(sockets[bindingName], timeout, func(ctx context.Context, client netbindingv2.BindingPluginClient) error {
+			return call(ctx, client, params)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// callPlugin calls the plugin handling the named interface of the VMI.
+func (p *PluginManager) callPlugin(vmi *v1.VirtualMachineInstance, ifaceName string, call func(context.Context, netbindingv2.BindingPluginClient, *netbindingv2.VMI, *netbindingv2.Interface) error) error {
+	iface := netvmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, ifaceName)
+	if iface == nil {
+		return fmt.Errorf("interface not found")
+	}
+	if iface.Binding == nil {
+		return fmt.Errorf("interface has no binding")
+	}
+	socketPath, exists := p.sockets()[iface.Binding.Name]
+	if !exists {
+		return fmt.Errorf("no plugin serves the %s binding", iface.Binding.Name)
+	}
+
+	return callSocket(socketPath, pluginCallTimeout, func(ctx context.Context, client netbindingv2.BindingPluginClient) error {
+		return call(ctx, client, newPluginVMI(vmi), newPluginInterface(vmi, *iface))
+	})
+}
+
+func callSocket(socketPath string, timeout time.Duration, call func(context.Context, netbindingv2.BindingPluginClient) error) error {
+	conn, err := grpcutil.DialSocketWithTimeout(socketPath, 1)
+	if err != nil {
+		return fmt.Errorf("failed to dial network binding plugin socket %s: %v", socketPath, err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return call(ctx, netbindingv2.NewBindingPluginClient(conn))
+}
+
+func newPluginVMI(vmi *v1.VirtualMachineInstance) *netbindingv2.VMI {
+	return &netbindingv2.VMI{
+		Name:      vmi.Name,
+		Namespace: vmi.Namespace,
+		Uid:       string(vmi.UID),
+	}
+}
+
+func newPluginInterface(vmi *v1.VirtualMachineInstance, iface v1.Interface) *netbindingv2.Interface {
+	state := v1.InterfaceStateUp
+	if iface.State == v1.InterfaceStateDown {
+		state = v1.InterfaceStateDown
+	}
+
+	pluginIface := &netbindingv2.Interface{
+		Name:       iface.Name,
+		MacAddress: iface.MacAddress,
+		Model:      iface.Model,
+		State:      string(state),
+	}
+	if pluginIface.Model == "" {
+		pluginIface.Model = v1.VirtIO
+	}
+
+	if network := netvmispec.LookupNetworkByName(vmi.Spec.Networks, iface.Name); network != nil {
+		pluginIface.PodInterfaceName = namescheme.HashedPodInterfaceName(*network)
+		if netvmispec.IsSecondaryMultusNetwork(*network) {
+			pluginIface.NetworkName = network.Multus.NetworkName
+		}
+	}
+
+	for _, port := range iface.Ports {
+		pluginIface.Ports = append(pluginIface.Ports, &netbindingv2.Port{
+			Name:     port.Name,
+			Protocol: port.Protocol,
+			Port:     port.Port,
+			EndPort:  port.EndPort,
+		})
+	}
+	return pluginIface
+}
@@ -0,0 +1,260 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package netbinding_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
+)
+
+type fakeBindingPlugin struct {
+	err        error
+	calls      []string
+	interfaces [][]*netbindingv2.Interface
+}
+
+func (p *fakeBindingPlugin) record(call string, vmi *netbindingv2.VMI, interfaces ...*netbindingv2.Interface) error {
+	p.calls = append(p.calls, fmt.Sprintf("%s %s", call, vmi.GetName()))
+	p.interfaces = append(p.interfaces, interfaces)
+	return p.err
+}
+
+func (p *fakeBindingPlugin) Setup(_ context.Context, params *netbindingv2.SetupParams) (*netbindingv2.SetupResult, error) {
+	result := &netbindingv2.SetupResult{}
+	for _, iface := range params.GetInterfaces() {
+		result.Devices = append(result.Devices, &netbindingv2.Device{
+			InterfaceName: iface.GetName(),
+			Type:          netbindingv2.DeviceTypeEthernet,
+			TapDevice:     "tap-" + iface.GetName(),
+		})
+	}
+	return result, p.record("Setup", params.GetVmi(), params.GetInterfaces()...)
+}
+
+func (p *fakeBindingPlugin) Teardown(_ context.Context, params *netbindingv2.TeardownParams) (*netbindingv2.TeardownResult, error) {
+	return &netbindingv2.TeardownResult{}, p.record("Teardown", params.GetVmi(), params.GetInterfaces()...)
+}
+
+func (p *fakeBindingPlugin) PreMigrate(_ context.Context, params *netbindingv2.PreMigrateParams) (*netbindingv2.PreMigrateResult, error) {
+	return &netbindingv2.PreMigrateResult{}, p.record("PreMigrate", params.GetVmi(), params.GetInterfaces()...)
+}
+
+func (p *fakeBindingPlugin) PostMigrate(_ context.Context, params *netbindingv2.PostMigrateParams) (*netbindingv2.PostMigrateResult, error) {
+	return &netbindingv2.PostMigrateResult{}, p.record("PostMigrate", params.GetVmi(), params.GetInterfaces()...)
+}
+
+func (p *fakeBindingPlugin) Hotplug(_ context.Context, params *netbindingv2.HotplugParams) (*netbindingv2.HotplugResult, error) {
+	device := &netbindingv2.Device{
+		InterfaceName: params.GetInterface().GetName(),
+		Type:          netbindingv2.DeviceTypeVhostUser,
+		SocketPath:    "/var/run/plugin/" + params.GetInterface().GetName(),
+	}
+	return &netbindingv2.HotplugResult{Device: device}, p.record("Hotplug", params.GetVmi(), params.GetInterface())
+}
+
+func (p *fakeBindingPlugin) Unplug(_ context.Context, params *netbindingv2.UnplugParams) (*netbindingv2.UnplugResult, error) {
+	return &netbindingv2.UnplugResult{}, p.record("Unplug", params.GetVmi(), params.GetInterface())
+}
+
+func (p *fakeBindingPlugin) Status(_ context.Context, params *netbindingv2.StatusParams) (*netbindingv2.StatusResult, error) {
+	result := &netbindingv2.StatusResult{}
+	for _, iface := range params.GetInterfaces() {
+		result.Interfaces = append(result.Interfaces, &netbindingv2.InterfaceStatus{
+			InterfaceName: iface.GetName(),
+			MacAddress:    iface.GetMacAddress(),
+			IpAddresses:   []string{"10.0.0.2"},
+		})
+	}
+	return result, p.record("Status", params.GetVmi(), params.GetInterfaces()...)
+}
+
+func bindingPluginListenAndServe(socketPath string, plugin netbindingv2.BindingPluginServer) (net.Listener, error) {
+	socket, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer([]grpc.ServerOption{}...)
+	netbindingv2.RegisterBindingPluginServer(server, plugin)
+	go func() {
+		server.Serve(socket)
+	}()
+	return socket, nil
+}
+
+var _ = Describe("Network binding plugin manager", func() {
+	const (
+		pluginBindingName = "vendor-binding"
+		pluginNetworkName = "net1"
+		otherNetworkName  = "net2"
+		testMAC           = "02:00:00:00:00:01"
+	)
+
+	var (
+		plugin        *fakeBindingPlugin
+		pluginManager *netbinding.PluginManager
+		vmi           *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		socketDir, err := os.MkdirTemp("", "netbindingplugin")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, socketDir)
+
+		plugin = &fakeBindingPlugin{}
+		socketPath := filepath.Join(socketDir, "plugin.sock")
+		socket, err := bindingPluginListenAndServe(socketPath, plugin)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(socket.Close)
+
+		pluginManager = netbinding.NewPluginManager(func() map[string]string {
+			return map[string]string{pluginBindingName: socketPath}
+		})
+
+		vmi = libvmi.New(
+			libvmi.WithNamespace("default"),
+			libvmi.WithInterface(v1.Interface{
+				Name:       pluginNetworkName,
+				MacAddress: testMAC,
+				Binding:    &v1.PluginBinding{Name: pluginBindingName},
+				Ports:      []v1.Port{{Name: "http", Protocol: "TCP", Port: 80}},
+			}),
+			libvmi.WithNetwork(&v1.Network{
+				Name:          pluginNetworkName,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad1"}},
+			}),
+			libvmi.WithInterface(v1.Interface{Name: otherNetworkName, Binding: &v1.PluginBinding{Name: "xml-binding"}}),
+			libvmi.WithNetwork(&v1.Network{
+				Name:          otherNetworkName,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad2"}},
+			}),
+		)
+		vmi.Name = "testvmi"
+	})
+
+	It("should handle only the interfaces whose binding is served by a plugin", func() {
+		Expect(pluginManager.Handles(vmi.Spec.Domain.Devices.Interfaces[0])).To(BeTrue())
+		Expect(pluginManager.Handles(vmi.Spec.Domain.Devices.Interfaces[1])).To(BeFalse())
+		Expect(pluginManager.Handles(v1.Interface{Name: "default"})).To(BeFalse())
+	})
+
+	It("should pass the structured interface data to Setup and return the devices", func() {
+		devices, err := pluginManager.Setup(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(devices).To(HaveLen(1))
+		Expect(devices[0].GetInterfaceName()).To(Equal(pluginNetworkName))
+		Expect(devices[0].GetTapDevice()).To(Equal("tap-" + pluginNetworkName))
+
+		Expect(plugin.calls).To(Equal([]string{"Setup testvmi"}))
+		Expect(plugin.interfaces[0]).To(HaveLen(1))
+		iface := plugin.interfaces[0][0]
+		Expect(iface.GetName()).To(Equal(pluginNetworkName))
+		Expect(iface.GetMacAddress()).To(Equal(testMAC))
+		Expect(iface.GetModel()).To(Equal(v1.VirtIO))
+		Expect(iface.GetState()).To(Equal(string(v1.InterfaceStateUp)))
+		Expect(iface.GetNetworkName()).To(Equal("nad1"))
+		Expect(iface.GetPodInterfaceName()).To(Equal(namescheme.GenerateHashedInterfaceName(pluginNetworkName)))
+		Expect(iface.GetPorts()).To(HaveLen(1))
+		Expect(iface.GetPorts()[0].GetPort()).To(Equal(int32(80)))
+	})
+
+	It("should skip absent interfaces", func() {
+		vmi.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+
+		devices, err := pluginManager.Setup(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(devices).To(BeEmpty())
+		Expect(plugin.calls).To(BeEmpty())
+	})
+
+	It("should call the migration calls and tear down the last known VMI", func() {
+		Expect(pluginManager.PreMigrate(vmi)).To(Succeed())
+		Expect(pluginManager.PostMigrate(vmi)).To(Succeed())
+		Expect(pluginManager.Teardown()).To(Succeed())
+		Expect(plugin.calls).To(Equal([]string{"PreMigrate testvmi", "PostMigrate testvmi", "Teardown testvmi"}))
+	})
+
+	It("should not tear down before being called with a VMI", func() {
+		Expect(pluginManager.Teardown()).To(Succeed())
+		Expect(plugin.calls).To(BeEmpty())
+	})
+
+	It("should return the error of a failing plugin", func() {
+		plugin.err = fmt.Errorf("migration not supported")
+		Expect(pluginManager.PreMigrate(vmi)).To(MatchError(ContainSubstring("migration not supported")))
+	})
+
+	It("should hotplug and unplug an interface", func() {
+		device, err := pluginManager.Hotplug(vmi, pluginNetworkName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(device.GetType()).To(Equal(netbindingv2.DeviceTypeVhostUser))
+		Expect(device.GetSocketPath()).To(Equal("/var/run/plugin/" + pluginNetworkName))
+
+		Expect(pluginManager.Unplug(vmi, pluginNetworkName)).To(Succeed())
+		Expect(plugin.calls).To(Equal([]string{"Hotplug testvmi", "Unplug testvmi"}))
+		Expect(plugin.interfaces[1][0].GetName()).To(Equal(pluginNetworkName))
+	})
+
+	It("should fail to hotplug an interface not served by a plugin", func() {
+		_, err := pluginManager.Hotplug(vmi, otherNetworkName)
+		Expect(err).To(MatchError(ContainSubstring("no plugin serves the xml-binding binding")))
+		Expect(plugin.calls).To(BeEmpty())
+	})
+
+	It("should report the interfaces status of the last known VMI", func() {
+		Expect(pluginManager.Status()).To(BeEmpty())
+
+		_, err := pluginManager.Setup(vmi)
+		Expect(err).ToNot(HaveOccurred())
+
+		By("returning without waiting for the plugins")
+		Expect(pluginManager.Status()).To(BeEmpty())
+
+		By("returning the status polled in the background")
+		Eventually(pluginManager.Status).Should(ConsistOf(
+			WithTransform(func(status *netbindingv2.InterfaceStatus) []string {
+				return append([]string{status.GetMacAddress()}, status.GetIpAddresses()...)
+			}, Equal([]string{testMAC, "10.0.0.2"})),
+		))
+	})
+
+	It("should not fail the status on a failing plugin", func() {
+		_, err := pluginManager.Setup(vmi)
+		Expect(err).ToNot(HaveOccurred())
+
+		plugin.err = fmt.Errorf("status unavailable")
+		Consistently(pluginManager.Status, "200ms").Should(BeEmpty())
+	})
+})
@@ -0,0 +1,31 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package v2
+
+// Version is reported by the hooks Info service of network binding plugin sidecars serving the BindingPlugin service.
+// Such a sidecar reports as its name the name of the binding in the KubeVirt network configuration.
+const Version = "netbinding.v2"
+
+// Device types returned by the Setup and Hotplug calls
+const (
+	DeviceTypeEthernet  = "ethernet"
+	DeviceTypeVhostUser = "vhostuser"
+	DeviceTypeUser      = "user"
+)
@@ -128,8 +128,8 @@ func (n NetPod) storePodInterfaceData(vmiSpecIface v1.Interface, ifaceState nmst
 
 	ifCache.Iface = &vmiSpecIface
 
-	ipv4 := firstIPGlobalUnicast(ifaceState.IPv4)
-	ipv6 := firstIPGlobalUnicast(ifaceState.IPv6)
+	ipv4 := nmstate.FirstIPGlobalUnicast(ifaceState.IPv4)
+	ipv6 := nmstate.FirstIPGlobalUnicast(ifaceState.IPv6)
 	switch {
 	case ipv4 != nil && ipv6 != nil:
 		ifCache.PodIPs, err = sortIPsBasedOnPrimaryIP(ipv4.IP, ipv6.IP)
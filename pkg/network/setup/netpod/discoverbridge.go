@@ -38,7 +38,7 @@ import (
 func (n NetPod) storeBridgeBindingDHCPInterfaceData(currentStatus *nmstate.Status, podIfaceStatus nmstate.Interface, vmiSpecIface v1.Interface, podIfaceName string) error {
 	var dhcpConfig cache.DHCPConfig
 	dhcpConfig.IPAMDisabled = true
-	if ipAddress := firstIPGlobalUnicast(podIfaceStatus.IPv4); ipAddress != nil {
+	if ipAddress := nmstate.FirstIPGlobalUnicast(podIfaceStatus.IPv4); ipAddress != nil {
 		dhcpConfig.IPAMDisabled = false
 
 		addr, iperr := vishnetlink.ParseAddr(fmt.Sprintf("%s/%d", ipAddress.IP, ipAddress.PrefixLen))
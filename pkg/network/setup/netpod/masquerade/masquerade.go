@@ -89,18 +89,22 @@ func WithLegacyMigrationPorts() option {
 	}
 }
 
-func (m MasqPod) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error {
+// Setup programs the NAT rules of the masquerade binding and returns them, formatted as nft rule statements.
+func (m MasqPod) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) ([]string, error) {
+	recorder := &rulesRecorder{nftable: m.nftable}
+	m.nftable = recorder
+
 	if bridgeIfaceSpec.IPv4.Enabled != nil && *bridgeIfaceSpec.IPv4.Enabled {
 		if err := m.setupNATByFamily(nft.IPv4, podIfaceSpec, bridgeIfaceSpec, vmiIface); err != nil {
-			return err
+			return nil, err
 		}
 	}
 	if bridgeIfaceSpec.IPv6.Enabled != nil && *bridgeIfaceSpec.IPv6.Enabled {
 		if err := m.setupNATByFamily(nft.IPv6, podIfaceSpec, bridgeIfaceSpec, vmiIface); err != nil {
-			return err
+			return nil, err
 		}
 	}
-	return nil
+	return recorder.rules, nil
 }
 
 func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error {
@@ -131,6 +135,18 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 	if err := m.nftable.AddRule(family, natTable, postroutingChain, string(family), "saddr", guestIP, "counter", "masquerade"); err != nil {
 		return err
 	}
+	// The prefix delegated to the guest over DHCPv6 is routed through the guest and leaves the pod through the NAT as well
+	if prefix := delegatedPrefix(vmiIface); family == nft.IPv6 && prefix != "" {
+		if err := m.nftable.AddRule(family, natTable, postroutingChain, string(family), "saddr", prefix, "counter", "masquerade"); err != nil {
+			return err
+		}
+	}
+	// The addresses the guest configures with SLAAC from the router advertisements leave the pod through the NAT as well
+	if prefix := slaacPrefix(vmiIface, *bridgeIfaceSpec); family == nft.IPv6 && prefix != "" {
+		if err := m.nftable.AddRule(family, natTable, postroutingChain, string(family), "saddr", prefix, "counter", "masquerade"); err != nil {
+			return err
+		}
+	}
 	if err := m.nftable.AddRule(family, natTable, preroutingChain, "iifname", podIfaceSpec.Name, "counter", "jump", kubevirtPreInboundChain); err != nil {
 		return err
 	}
@@ -138,6 +154,17 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 		return err
 	}
 
+	// NAT hairpin: traffic from the guest to the pod IP is forwarded back to the guest like inbound traffic.
+	// It is masqueraded on its way back to the bridge, so the guest replies through the NAT.
+	// Traffic from the guest to a Service IP backed by the pod needs no rule of its own: it is masqueraded
+	// to the pod IP when leaving the pod, and once the node network DNATs and masquerades it back to the pod
+	// it enters through the pod interface like any inbound traffic.
+	if podIP := podIPByFamily(family, *podIfaceSpec); podIP != "" {
+		if err := m.nftable.AddRule(family, natTable, preroutingChain, "iifname", bridgeIfaceSpec.Name, string(family), "daddr", podIP, "counter", "jump", kubevirtPreInboundChain); err != nil {
+			return err
+		}
+	}
+
 	if len(m.migrationPorts) > 0 {
 		if err := m.skipForwardPorts(family, m.migrationPorts...); err != nil {
 			return err
@@ -145,22 +172,21 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 	}
 
 	addressesToDnat := []string{ipLoopback(family)}
-	if m.istioEnabled && family == nft.IPv4 {
-		addressesToDnat = append(addressesToDnat, podIfaceSpec.IPv4.Address[0].IP)
+	if m.istioEnabled {
+		if podIP := podIPByFamily(family, *podIfaceSpec); podIP != "" {
+			addressesToDnat = append(addressesToDnat, podIP)
+		}
 	}
 	addressesToDnatSpec := fmt.Sprintf("{ %s }", strings.Join(addressesToDnat, ", "))
 
 	for _, port := range vmiIface.Ports {
-		if port.Protocol == "" {
-			port.Protocol = "tcp"
-		}
-		protocol := strings.ToLower(port.Protocol)
+		portMatch := portMatchSpec(port)
 		addressesToSnat := []string{ipLoopback(family)}
 
 		if m.istioEnabled {
 			var portsToForward []int
 			for _, nonProxiedPort := range istio.NonProxiedPorts() {
-				if int(port.Port) == nonProxiedPort {
+				if portInRange(port, nonProxiedPort) {
 					portsToForward = append(portsToForward, nonProxiedPort)
 				}
 			}
@@ -168,22 +194,23 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 				return err
 			}
 
-			if family == nft.IPv4 {
-				addressesToSnat = append(addressesToSnat, istio.GetLoopbackAddress())
-			}
+			addressesToSnat = append(addressesToSnat, istioLoopback(family))
 		} else {
-			if err := m.forwardPorts(family, guestIP, protocol, int(port.Port)); err != nil {
+			rulespec := append(append([]string{}, portMatch...), "counter", "dnat", "to", guestIP)
+			if err := m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, rulespec...); err != nil {
 				return err
 			}
 		}
 
 		addressesToSnatSpec := fmt.Sprintf("{ %s }", strings.Join(addressesToSnat, ", "))
 		gw := guestIPGateway(family, *bridgeIfaceSpec).String()
-		if err := m.nftable.AddRule(family, natTable, kubevirtPostInboundChain, protocol, "dport", strconv.Itoa(int(port.Port)), string(family), "saddr", addressesToSnatSpec, "counter", "snat", "to", gw); err != nil {
+		rulespec := append(append([]string{}, portMatch...), string(family), "saddr", addressesToSnatSpec, "counter", "snat", "to", gw)
+		if err := m.nftable.AddRule(family, natTable, kubevirtPostInboundChain, rulespec...); err != nil {
 			return err
 		}
 
-		if err := m.nftable.AddRule(family, natTable, outputChain, string(family), "daddr", addressesToDnatSpec, protocol, "dport", strconv.Itoa(int(port.Port)), "counter", "dnat", "to", guestIP); err != nil {
+		rulespec = append(append([]string{string(family), "daddr", addressesToDnatSpec}, portMatch...), "counter", "dnat", "to", guestIP)
+		if err := m.nftable.AddRule(family, natTable, outputChain, rulespec...); err != nil {
 			return err
 		}
 	}
@@ -198,9 +225,7 @@ func (m MasqPod) setupNATByFamily(family nft.IPFamily, podIfaceSpec, bridgeIface
 			if err := m.forwardPorts(family, guestIP, "tcp", istio.NonProxiedPorts()...); err != nil {
 				return err
 			}
-			if family == nft.IPv4 {
-				addressesToSnat = append(addressesToSnat, istio.GetLoopbackAddress())
-			}
+			addressesToSnat = append(addressesToSnat, istioLoopback(family))
 		} else {
 			if err := m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, "counter", "dnat", "to", guestIP); err != nil {
 				return err
@@ -249,6 +274,79 @@ func (m MasqPod) forwardPorts(family nft.IPFamily, toIP string, protocol string,
 	return m.nftable.AddRule(family, natTable, kubevirtPreInboundChain, protocol, "dport", portsSpec, "counter", "dnat", "to", toIP)
 }
 
+// allProtocols are the transport protocols matched by a port with the ALL protocol.
+const allProtocols = "{ tcp, udp, sctp }"
+
+// portMatchSpec returns the nft expression matching the destination port (or ports range) and protocol of a port.
+func portMatchSpec(port v1.Port) []string {
+	dport := strconv.Itoa(int(port.Port))
+	if port.EndPort > port.Port {
+		dport = fmt.Sprintf("%d-%d", port.Port, port.EndPort)
+	}
+	protocol := strings.ToLower(port.Protocol)
+	switch protocol {
+	case "":
+		return []string{"tcp", "dport", dport}
+	case "all":
+		return []string{"meta", "l4proto", allProtocols, "th", "dport", dport}
+	default:
+		return []string{protocol, "dport", dport}
+	}
+}
+
+func portInRange(port v1.Port, p int) bool {
+	endPort := port.EndPort
+	if endPort < port.Port {
+		endPort = port.Port
+	}
+	return int(port.Port) <= p && p <= int(endPort)
+}
+
+func istioLoopback(family nft.IPFamily) string {
+	if family == nft.IPv4 {
+		return istio.GetLoopbackAddress()
+	}
+	return istio.GetLoopbackAddressIPv6()
+}
+
+func delegatedPrefix(vmiIface v1.Interface) string {
+	if vmiIface.DHCPOptions == nil || vmiIface.DHCPOptions.IPv6 == nil {
+		return ""
+	}
+	return vmiIface.DHCPOptions.IPv6.DelegatedPrefix
+}
+
+// slaacPrefix returns the IPv6 network of the bridge when the router advertisements offer it for SLAAC,
+// which they do for /64 networks only, or an empty string otherwise.
+func slaacPrefix(vmiIface v1.Interface, bridgeIface nmstate.Interface) string {
+	const slaacPrefixLength = 64
+	if vmiIface.DHCPOptions == nil || vmiIface.DHCPOptions.IPv6 == nil || !vmiIface.DHCPOptions.IPv6.RouterAdvertisements {
+		return ""
+	}
+	if len(bridgeIface.IPv6.Address) == 0 || bridgeIface.IPv6.Address[0].PrefixLen != slaacPrefixLength {
+		return ""
+	}
+	address := bridgeIface.IPv6.Address[0]
+	_, prefix, err := net.ParseCIDR(fmt.Sprintf("%s/%d", address.IP, address.PrefixLen))
+	if err != nil {
+		return ""
+	}
+	return prefix.String()
+}
+
+// podIPByFamily returns the first global unicast address of the pod interface of the given family,
+// or an empty string if there is none.
+func podIPByFamily(family nft.IPFamily, podIface nmstate.Interface) string {
+	ip := podIface.IPv4
+	if family == nft.IPv6 {
+		ip = podIface.IPv6
+	}
+	if address := nmstate.FirstIPGlobalUnicast(ip); address != nil {
+		return address.IP
+	}
+	return ""
+}
+
 func ipLoopback(family nft.IPFamily) string {
 	if family == nft.IPv4 {
 		return ip.IPv4Loopback
@@ -274,3 +372,18 @@ func guestIPGateway(family nft.IPFamily, bridgeIface nmstate.Interface) net.IP {
 	}
 	return ipAddr
 }
+
+// rulesRecorder keeps track of the rules added through the nftable adapter, formatted as nft rule statements.
+type rulesRecorder struct {
+	nftable
+	rules []string
+}
+
+func (r *rulesRecorder) AddRule(family nft.IPFamily, table, chain string, rulespec ...string) error {
+	if err := r.nftable.AddRule(family, table, chain, rulespec...); err != nil {
+		return err
+	}
+	rule := strings.Join(append([]string{string(family), table, chain}, rulespec...), " ")
+	r.rules = append(r.rules, rule)
+	return nil
+}
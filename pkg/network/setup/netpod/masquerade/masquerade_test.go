@@ -42,14 +42,15 @@ var _ = Describe("masquerade (NAT)", func() {
 		}))
 
 		ifaceSpec := nmstate.Interface{IPv4: nmstate.IP{Enabled: pointer.P(true)}}
-		Expect(masqPod.Setup(&ifaceSpec, &ifaceSpec, v1.Interface{})).To(MatchError(testErr))
+		_, err := masqPod.Setup(&ifaceSpec, &ifaceSpec, v1.Interface{})
+		Expect(err).To(MatchError(testErr))
 	})
 
 	It("setup with IPv4, no ports", func() {
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
 
-		err := masqPod.Setup(
+		_, err := masqPod.Setup(
 			&nmstate.Interface{
 				Name:       "k6t-eth0",
 				Index:      1,
@@ -97,6 +98,7 @@ rules:
 family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
 family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 10.222.222.1 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to 10.0.2.2]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
 family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat to 10.0.2.2]
@@ -108,7 +110,7 @@ family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat t
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
 
-		err := masqPod.Setup(
+		_, err := masqPod.Setup(
 			&nmstate.Interface{
 				Name:       "k6t-eth0",
 				Index:      1,
@@ -156,6 +158,7 @@ rules:
 family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
 family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain prerouting rulespec [iifname k6t-eth0 ip6 daddr 2001::1 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to fd10:0:2::2]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1 } counter snat to fd10:0:2::1]
 family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd10:0:2::2]
@@ -167,7 +170,7 @@ family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
 
-		err := masqPod.Setup(
+		_, err := masqPod.Setup(
 			&nmstate.Interface{
 				Name:       "k6t-eth0",
 				Index:      1,
@@ -233,12 +236,14 @@ rules:
 family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
 family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 10.222.222.1 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to 10.0.2.2]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
 family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } counter dnat to 10.0.2.2]
 family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
 family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain prerouting rulespec [iifname k6t-eth0 ip6 daddr 2001::1 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [counter dnat to fd10:0:2::2]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1 } counter snat to fd10:0:2::1]
 family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd10:0:2::2]
@@ -250,7 +255,7 @@ family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd
 		nftStub := &nftableStub{}
 		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
 
-		err := masqPod.Setup(
+		_, err := masqPod.Setup(
 			&nmstate.Interface{
 				Name:       "k6t-eth0",
 				Index:      1,
@@ -320,31 +325,254 @@ rules:
 family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
 family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
-family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 80 } counter dnat to 10.0.2.2]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 10.222.222.1 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport 80 counter dnat to 10.0.2.2]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
 family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport 80 counter dnat to 10.0.2.2]
-family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 8080 } counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport 8080 counter dnat to 10.0.2.2]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
 family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } tcp dport 8080 counter dnat to 10.0.2.2]
 family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
 family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
-family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 80 } counter dnat to fd10:0:2::2]
+family ip6 table nat chain prerouting rulespec [iifname k6t-eth0 ip6 daddr 2001::1 counter jump KUBEVIRT_PREINBOUND]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport 80 counter dnat to fd10:0:2::2]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
 family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 80 counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 8080 } counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport 8080 counter dnat to fd10:0:2::2]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
 family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 8080 counter dnat to fd10:0:2::2]
 `
 		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 	})
 
+	It("setup with IPv4 and IPv6, including a port range and a port for all protocols", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		rules, err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:       "k6t-eth0",
+				Index:      1,
+				TypeName:   nmstate.TypeBridge,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "bb:bb:bb:bb:bb:bb",
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "fd10:0:2::1", PrefixLen: 120}},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			&nmstate.Interface{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "aa:aa:aa:aa:aa:aa",
+				MTU:        1500,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{
+						IP:        "10.222.222.1",
+						PrefixLen: 30,
+					}},
+				},
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{
+						{IP: "fe80::1", PrefixLen: 64},
+						{IP: "2001::1", PrefixLen: 64},
+					},
+				},
+				Metadata: &nmstate.IfaceMetadata{Pid: 0, NetworkName: "default"},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				Ports: []v1.Port{
+					{Name: "range", Protocol: "UDP", Port: 5000, EndPort: 5010},
+					{Name: "dns", Protocol: "ALL", Port: 53},
+				},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		expectedConfig := `tables:
+family ip name nat
+family ip6 name nat
+chains:
+family ip table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip table nat name KUBEVIRT_POSTINBOUND chainspec []
+family ip6 table nat name prerouting chainspec [{ type nat hook prerouting priority -100; }]
+family ip6 table nat name input chainspec [{ type nat hook input priority 100; }]
+family ip6 table nat name output chainspec [{ type nat hook output priority -100; }]
+family ip6 table nat name postrouting chainspec [{ type nat hook postrouting priority 100; }]
+family ip6 table nat name KUBEVIRT_PREINBOUND chainspec []
+family ip6 table nat name KUBEVIRT_POSTINBOUND chainspec []
+rules:
+family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
+family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 10.222.222.1 counter jump KUBEVIRT_PREINBOUND]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [udp dport 5000-5010 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [udp dport 5000-5010 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } udp dport 5000-5010 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_PREINBOUND rulespec [meta l4proto { tcp, udp, sctp } th dport 53 counter dnat to 10.0.2.2]
+family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [meta l4proto { tcp, udp, sctp } th dport 53 ip saddr { 127.0.0.1 } counter snat to 10.0.2.1]
+family ip table nat chain output rulespec [ip daddr { 127.0.0.1 } meta l4proto { tcp, udp, sctp } th dport 53 counter dnat to 10.0.2.2]
+family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
+family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
+family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain prerouting rulespec [iifname k6t-eth0 ip6 daddr 2001::1 counter jump KUBEVIRT_PREINBOUND]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [udp dport 5000-5010 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [udp dport 5000-5010 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } udp dport 5000-5010 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [meta l4proto { tcp, udp, sctp } th dport 53 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [meta l4proto { tcp, udp, sctp } th dport 53 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1 } meta l4proto { tcp, udp, sctp } th dport 53 counter dnat to fd10:0:2::2]
+`
+		Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
+
+		Expect(rules).To(HaveLen(len(nftStub.Rules)))
+		Expect(rules[0]).To(Equal("ip nat postrouting ip saddr 10.0.2.2 counter masquerade"))
+		Expect(rules[len(rules)-1]).To(Equal(
+			"ip6 nat output ip6 daddr { ::1 } meta l4proto { tcp, udp, sctp } th dport 53 counter dnat to fd10:0:2::2",
+		))
+	})
+
+	It("setup with a delegated IPv6 prefix", func() {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		rules, err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:     "k6t-eth0",
+				TypeName: nmstate.TypeBridge,
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "fd10:0:2::1", PrefixLen: 120}},
+				},
+			},
+			&nmstate.Interface{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "2001::1", PrefixLen: 64}},
+				},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				DHCPOptions:            &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DelegatedPrefix: "2001:db8::/56"}},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(ContainElements(
+			"ip6 nat postrouting ip6 saddr fd10:0:2::2 counter masquerade",
+			"ip6 nat postrouting ip6 saddr 2001:db8::/56 counter masquerade",
+		))
+	})
+
+	DescribeTable("setup with router advertisements", func(prefixLength int, expectMasquerade bool) {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		rules, err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:     "k6t-eth0",
+				TypeName: nmstate.TypeBridge,
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "fd10:0:2::1", PrefixLen: prefixLength}},
+				},
+			},
+			&nmstate.Interface{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "2001::1", PrefixLen: 64}},
+				},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				DHCPOptions:            &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{RouterAdvertisements: true}},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(ContainElement("ip6 nat postrouting ip6 saddr fd10:0:2::2 counter masquerade"))
+		slaacRule := fmt.Sprintf("ip6 nat postrouting ip6 saddr fd10:0:2::/%d counter masquerade", prefixLength)
+		if expectMasquerade {
+			Expect(rules).To(ContainElement(slaacRule))
+		} else {
+			Expect(rules).NotTo(ContainElement(slaacRule))
+		}
+	},
+		Entry("should masquerade the SLAAC addresses of a /64 VM network", 64, true),
+		Entry("should only masquerade the guest address of the default VM network", 120, false),
+	)
+
+	DescribeTable("NAT hairpin", func(podAddress string, expectHairpin bool) {
+		nftStub := &nftableStub{}
+		masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub))
+
+		rules, err := masqPod.Setup(
+			&nmstate.Interface{
+				Name:     "k6t-eth0",
+				TypeName: nmstate.TypeBridge,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: "10.0.2.1", PrefixLen: 24}},
+				},
+			},
+			&nmstate.Interface{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				IPv4: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: podAddress, PrefixLen: 30}},
+				},
+			},
+			v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		hairpinRule := fmt.Sprintf("ip nat prerouting iifname k6t-eth0 ip daddr %s counter jump KUBEVIRT_PREINBOUND", podAddress)
+		if expectHairpin {
+			Expect(rules).To(ContainElement(hairpinRule))
+		} else {
+			Expect(rules).NotTo(ContainElement(hairpinRule))
+		}
+		// the traffic sent to a Service IP backed by the pod leaves the pod masqueraded
+		// and comes back through the pod interface once the node network DNATed it
+		Expect(rules).To(ContainElements(
+			"ip nat postrouting ip saddr 10.0.2.2 counter masquerade",
+			"ip nat prerouting iifname eth0 counter jump KUBEVIRT_PREINBOUND",
+			"ip nat KUBEVIRT_PREINBOUND counter dnat to 10.0.2.2",
+		))
+	},
+		Entry("should forward the traffic the guest sends to the pod or Service IP back to the guest", "10.222.222.1", true),
+		Entry("should only forward the Service IP traffic when the pod has no global unicast address", "169.254.1.1", false),
+	)
+
 	Context("with ISTIO", func() {
 		It("setup with IPv4 and IPv6, no ports", func() {
 			nftStub := &nftableStub{}
 			masqPod := masquerade.New(masquerade.WithNftableAdapter(nftStub), masquerade.WithIstio(true))
 
-			err := masqPod.Setup(
+			_, err := masqPod.Setup(
 				&nmstate.Interface{
 					Name:       "k6t-eth0",
 					Index:      1,
@@ -410,6 +638,7 @@ rules:
 family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
 family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 10.222.222.1 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain output rulespec [tcp dport { 15000, 15001, 15004, 15006, 15008, 15009, 15020, 15021, 15053, 15090 } ip saddr 127.0.0.1 counter return]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 15000, 15001, 15004, 15006, 15008, 15009, 15020, 15021, 15053, 15090 } ip saddr 127.0.0.1 counter return]
 family ip table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 22 } counter dnat to 10.0.2.2]
@@ -418,11 +647,12 @@ family ip table nat chain output rulespec [ip daddr { 127.0.0.1, 10.222.222.1 }
 family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
 family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain prerouting rulespec [iifname k6t-eth0 ip6 daddr 2001::1 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain output rulespec [tcp dport { 15000, 15001, 15004, 15006, 15008, 15009, 15020, 15021, 15053, 15090 } ip6 saddr ::1 counter return]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 15000, 15001, 15004, 15006, 15008, 15009, 15020, 15021, 15053, 15090 } ip6 saddr ::1 counter return]
 family ip6 table nat chain KUBEVIRT_PREINBOUND rulespec [tcp dport { 22 } counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [ip6 saddr { ::1, ::6 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1, 2001::1 } counter dnat to fd10:0:2::2]
 `
 			Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 		})
@@ -435,7 +665,7 @@ family ip6 table nat chain output rulespec [ip6 daddr { ::1 } counter dnat to fd
 				masquerade.WithLegacyMigrationPorts(),
 			)
 
-			err := masqPod.Setup(
+			_, err := masqPod.Setup(
 				&nmstate.Interface{
 					Name:       "k6t-eth0",
 					Index:      1,
@@ -505,6 +735,7 @@ rules:
 family ip table nat chain postrouting rulespec [ip saddr 10.0.2.2 counter masquerade]
 family ip table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip table nat chain prerouting rulespec [iifname k6t-eth0 ip daddr 10.222.222.1 counter jump KUBEVIRT_PREINBOUND]
 family ip table nat chain output rulespec [tcp dport { 49152, 49153 } ip saddr 127.0.0.1 counter return]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 49152, 49153 } ip saddr 127.0.0.1 counter return]
 family ip table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip saddr { 127.0.0.1, 127.0.0.6 } counter snat to 10.0.2.1]
@@ -514,12 +745,13 @@ family ip table nat chain output rulespec [ip daddr { 127.0.0.1, 10.222.222.1 }
 family ip6 table nat chain postrouting rulespec [ip6 saddr fd10:0:2::2 counter masquerade]
 family ip6 table nat chain prerouting rulespec [iifname eth0 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain postrouting rulespec [oifname k6t-eth0 counter jump KUBEVIRT_POSTINBOUND]
+family ip6 table nat chain prerouting rulespec [iifname k6t-eth0 ip6 daddr 2001::1 counter jump KUBEVIRT_PREINBOUND]
 family ip6 table nat chain output rulespec [tcp dport { 49152, 49153 } ip6 saddr ::1 counter return]
 family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport { 49152, 49153 } ip6 saddr ::1 counter return]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 80 counter dnat to fd10:0:2::2]
-family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip6 saddr { ::1 } counter snat to fd10:0:2::1]
-family ip6 table nat chain output rulespec [ip6 daddr { ::1 } tcp dport 8080 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 80 ip6 saddr { ::1, ::6 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1, 2001::1 } tcp dport 80 counter dnat to fd10:0:2::2]
+family ip6 table nat chain KUBEVIRT_POSTINBOUND rulespec [tcp dport 8080 ip6 saddr { ::1, ::6 } counter snat to fd10:0:2::1]
+family ip6 table nat chain output rulespec [ip6 daddr { ::1, 2001::1 } tcp dport 8080 counter dnat to fd10:0:2::2]
 `
 			Expect(nftStub.String()).To(Equal(expectedConfig), fmt.Sprintf("actual:\n%s\n\nexpected:\n%s", nftStub.String(), expectedConfig))
 		})
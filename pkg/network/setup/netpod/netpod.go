@@ -21,8 +21,10 @@ package netpod
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
@@ -52,7 +54,7 @@ type nmstateAdapter interface {
 }
 
 type masqueradeAdapter interface {
-	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
+	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) ([]string, error)
 }
 
 type cacheCreator interface {
@@ -284,6 +286,9 @@ func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec
 			if nmstate.AnyInterface(ifacesSpec, hasIP6GlobalUnicast) {
 				spec.LinuxStack.IPv6.Forwarding = pointer.P(true)
 			}
+			if route := delegatedPrefixRoute(iface, ifacesSpec); route != nil {
+				spec.Routes.Config = append(spec.Routes.Config, *route)
+			}
 		case iface.SRIOV != nil:
 		case iface.Binding != nil:
 		// Passt is removed in v1.3. This scenario is tracking old VMIs that are still processed in the reconcile loop.
@@ -306,6 +311,32 @@ func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec
 	return &spec, nil
 }
 
+// delegatedPrefixRoute returns the route, through the guest IPv6 address, of the prefix delegated to the guest.
+// It returns nil when no prefix is delegated or the masquerade bridge has no IPv6 address.
+func delegatedPrefixRoute(iface v1.Interface, ifacesSpec []nmstate.Interface) *nmstate.Route {
+	if iface.DHCPOptions == nil || iface.DHCPOptions.IPv6 == nil || iface.DHCPOptions.IPv6.DelegatedPrefix == "" {
+		return nil
+	}
+	bridgeIface := nmstate.LookupInterface(ifacesSpec, func(i nmstate.Interface) bool {
+		return i.TypeName == nmstate.TypeBridge
+	})
+	if bridgeIface == nil {
+		return nil
+	}
+	gatewayAddress := nmstate.FirstIPGlobalUnicast(bridgeIface.IPv6)
+	if gatewayAddress == nil {
+		return nil
+	}
+	// The guest address is the one following the gateway address
+	guestIP := net.ParseIP(gatewayAddress.IP)
+	netmachinery.NextIP(guestIP)
+	return &nmstate.Route{
+		Destination:      iface.DHCPOptions.IPv6.DelegatedPrefix,
+		NextHopInterface: bridgeIface.Name,
+		NextHopAddress:   guestIP.String(),
+	}
+}
+
 func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceStatusByName map[string]nmstate.Interface) ([]nmstate.Interface, error) {
 	const (
 		bridgeFakeIPBase = "169.254.75.1"
@@ -465,7 +496,24 @@ func (n NetPod) setupNAT(desiredSpec *nmstate.Spec, currentStatus *nmstate.Statu
 	vmiIface := vmispec.FilterInterfacesSpec(n.vmiSpecIfaces, func(i v1.Interface) bool {
 		return i.Name == bridgeIfaceSpec.Metadata.NetworkName
 	})
-	return n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
+	natRules, err := n.masqueradeAdapter.Setup(bridgeIfaceSpec, podIfaceSpec, vmiIface[0])
+	if err != nil {
+		return err
+	}
+	return n.storeNATRules(vmiIface[0].Name, natRules)
+}
+
+// storeNATRules records the NAT rules of an interface in its pod interface cache, to be reported on the VMI status.
+func (n NetPod) storeNATRules(ifaceName string, natRules []string) error {
+	ifCache, err := cache.ReadPodInterfaceCache(n.cacheCreator, n.vmiUID, ifaceName)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to read pod interface cache for %s: %v", ifaceName, err)
+		}
+		ifCache = &cache.PodIfaceCacheData{}
+	}
+	ifCache.NATRules = natRules
+	return cache.WritePodInterfaceCache(n.cacheCreator, n.vmiUID, ifaceName, ifCache)
 }
 
 func (n NetPod) lookupMasquradeBridge(desiredIfacesSpec []nmstate.Interface) *nmstate.Interface {
@@ -523,19 +571,7 @@ func hasIP6GlobalUnicast(iface nmstate.Interface) bool {
 }
 
 func hasIPGlobalUnicast(ip nmstate.IP) bool {
-	return firstIPGlobalUnicast(ip) != nil
-}
-
-func firstIPGlobalUnicast(ip nmstate.IP) *nmstate.IPAddress {
-	if ip.Enabled != nil && *ip.Enabled {
-		for _, addr := range ip.Address {
-			if net.ParseIP(addr.IP).IsGlobalUnicast() {
-				address := addr
-				return &address
-			}
-		}
-	}
-	return nil
+	return nmstate.FirstIPGlobalUnicast(ip) != nil
 }
 
 func createNetworkNameScheme(networks []v1.Network, currentIfaces []nmstate.Interface) map[string]string {
@@ -218,7 +218,8 @@ var _ = Describe("netpod", func() {
 				},
 			}},
 		}}
-		masqstub := masqueradeStub{}
+		const natRule = "ip nat prerouting iifname eth0 counter jump KUBEVIRT_PREINBOUND"
+		masqstub := masqueradeStub{rules: []string{natRule}}
 
 		vmiIface := v1.Interface{
 			Name:                   defaultPodNetworkName,
@@ -274,12 +275,48 @@ var _ = Describe("netpod", func() {
 		Expect(masqstub.podIfaceSpec.Name).To(Equal("eth0"))
 		Expect(masqstub.vmiIfaceSpec.Name).To(Equal(defaultPodNetworkName))
 		Expect(cache.ReadPodInterfaceCache(&baseCacheCreator, vmiUID, defaultPodNetworkName)).To(Equal(&cache.PodIfaceCacheData{
-			Iface:  &vmiIface,
-			PodIP:  primaryIPv4Address,
-			PodIPs: []string{primaryIPv4Address, primaryIPv6Address},
+			Iface:    &vmiIface,
+			PodIP:    primaryIPv4Address,
+			PodIPs:   []string{primaryIPv4Address, primaryIPv6Address},
+			NATRules: []string{natRule},
 		}))
 	})
 
+	It("setup masquerade binding with a delegated IPv6 prefix", func() {
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:     "eth0",
+				TypeName: nmstate.TypeVETH,
+				State:    nmstate.IfaceStateUp,
+				MTU:      1500,
+				IPv6: nmstate.IP{
+					Enabled: pointer.P(true),
+					Address: []nmstate.IPAddress{{IP: primaryIPv6Address, PrefixLen: 64}},
+				},
+			}},
+		}}
+
+		vmiIface := v1.Interface{
+			Name:                   defaultPodNetworkName,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+			DHCPOptions:            &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DelegatedPrefix: "2001:db8::/56"}},
+		}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{vmiIface},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithMasqueradeAdapter(&masqueradeStub{}),
+			netpod.WithCacheCreator(&baseCacheCreator),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec.Routes).To(Equal(nmstate.Routes{Config: []nmstate.Route{{
+			Destination:      "2001:db8::/56",
+			NextHopInterface: "k6t-eth0",
+			NextHopAddress:   "fd10:0:2::2",
+		}}}))
+	})
+
 	It("setup bridge binding with IP and a static route", func() {
 		const (
 			defaultGatewayIP4Address = "10.222.222.254"
@@ -1642,6 +1679,7 @@ func (n *nmstateStub) Read() (*nmstate.Status, error) {
 
 type masqueradeStub struct {
 	setupErr        error
+	rules           []string
 	bridgeIfaceSpec *nmstate.Interface
 	podIfaceSpec    *nmstate.Interface
 	vmiIfaceSpec    v1.Interface
@@ -1649,14 +1687,14 @@ type masqueradeStub struct {
 
 var errMasqueradeSetup = errors.New("masquerade Setup Test Error")
 
-func (m *masqueradeStub) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIfaceSpec v1.Interface) error {
+func (m *masqueradeStub) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIfaceSpec v1.Interface) ([]string, error) {
 	if m.setupErr != nil {
-		return m.setupErr
+		return nil, m.setupErr
 	}
 	m.bridgeIfaceSpec = bridgeIfaceSpec
 	m.podIfaceSpec = podIfaceSpec
 	m.vmiIfaceSpec = vmiIfaceSpec
-	return nil
+	return m.rules, nil
 }
 
 type tempCacheCreator struct {
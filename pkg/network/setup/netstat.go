@@ -146,7 +146,7 @@ func ifacesStatusFromMultus(
 	return interfacesStatus
 }
 
-// updateIfacesStatusFromPodCache updates the provided interfaces statuses with data (IP/s) from the pod-cache.
+// updateIfacesStatusFromPodCache updates the provided interfaces statuses with data (IP/s, NAT rules) from the pod-cache.
 func (c *NetStat) updateIfacesStatusFromPodCache(ifacesStatus []v1.VirtualMachineInstanceNetworkInterface, ifacesSpec []v1.Interface, vmi *v1.VirtualMachineInstance) ([]v1.VirtualMachineInstanceNetworkInterface, error) {
 	for _, iface := range ifacesSpec {
 		ifaceStatus := netvmispec.LookupInterfaceStatusByName(ifacesStatus, iface.Name)
@@ -161,6 +161,7 @@ func (c *NetStat) updateIfacesStatusFromPodCache(ifacesStatus []v1.VirtualMachin
 
 		ifaceStatus.IP = podIface.PodIP
 		ifaceStatus.IPs = podIface.PodIPs
+		ifaceStatus.NATRules = podIface.NATRules
 	}
 	return ifacesStatus, nil
 }
@@ -218,11 +219,19 @@ func ifacesStatusFromDomainInterfaces(domainSpecIfaces []api.Interface) []v1.Vir
 			MAC:        domainSpecIface.MAC.MAC,
 			InfoSource: netvmispec.InfoSourceDomain,
 			QueueCount: domainInterfaceQueues(domainSpecIface.Driver),
+			LinkState:  domainInterfaceLinkState(domainSpecIface.LinkState),
 		})
 	}
 	return vmiStatusIfaces
 }
 
+func domainInterfaceLinkState(linkState *api.LinkState) v1.InterfaceState {
+	if linkState != nil && linkState.State == string(v1.InterfaceStateDown) {
+		return v1.InterfaceStateDown
+	}
+	return v1.InterfaceStateUp
+}
+
 func domainInterfaceQueues(driver *api.InterfaceDriver) int32 {
 	if driver != nil && driver.Queues != nil {
 		return int32(*driver.Queues)
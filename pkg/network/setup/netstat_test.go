@@ -274,6 +274,28 @@ var _ = Describe("netstat", func() {
 			Expect(setup.NetStat.PodInterfaceVolatileDataIsCached(setup.Vmi, primaryNetworkName)).To(BeTrue())
 		})
 
+		It("run status and expect the NAT rules of an interface (with masquerade) to be reported based on pod data", func() {
+			const natRule = "ip nat prerouting iifname eth0 counter jump KUBEVIRT_PREINBOUND"
+
+			Expect(
+				setup.addNetworkInterface(
+					newVMISpecIfaceWithMasqueradeBinding(primaryNetworkName),
+					newVMISpecPodNetwork(primaryNetworkName),
+					newDomainSpecIface(primaryNetworkName, ""),
+					primaryPodIPv4, primaryPodIPv6,
+				),
+			).To(Succeed())
+			podCacheData := makePodCacheInterface(primaryNetworkName, primaryPodIPv4, primaryPodIPv6)
+			podCacheData.NATRules = []string{natRule}
+			setup.NetStat.CachePodInterfaceVolatileData(setup.Vmi, primaryNetworkName, podCacheData)
+
+			Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+			expectedIfaceStatus := newVMIStatusIface(primaryNetworkName, []string{primaryPodIPv4, primaryPodIPv6}, "", "", netvmispec.InfoSourceDomain, netsetup.DefaultInterfaceQueueCount)
+			expectedIfaceStatus.NATRules = []string{natRule}
+			Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{expectedIfaceStatus}))
+		})
+
 		It("should update existing interface status with MAC from the domain", func() {
 			const (
 				origMAC      = "C0:01:BE:E7:15:G0:0D"
@@ -385,7 +407,7 @@ var _ = Describe("netstat", func() {
 		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
 
 		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
-			newVMIStatusIface(networkName, nil, ifaceMAC, "", netvmispec.InfoSourceDomain, netsetup.UnknownInterfaceQueueCount),
+			newSRIOVVMIStatusIface(networkName, nil, ifaceMAC, "", netvmispec.InfoSourceDomain, netsetup.UnknownInterfaceQueueCount),
 		}), "the SR-IOV interface should be reported in the status.")
 	})
 
@@ -415,7 +437,7 @@ var _ = Describe("netstat", func() {
 
 		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
 			newVMIStatusIface(primaryNetworkName, []string{primaryPodIPv4}, "", "", netvmispec.InfoSourceDomain, netsetup.DefaultInterfaceQueueCount),
-			newVMIStatusIface(networkName, nil, "", "", netvmispec.InfoSourceDomain, netsetup.UnknownInterfaceQueueCount),
+			newSRIOVVMIStatusIface(networkName, nil, "", "", netvmispec.InfoSourceDomain, netsetup.UnknownInterfaceQueueCount),
 		}), "the SR-IOV interface should be reported in the status.")
 	})
 
@@ -439,7 +461,7 @@ var _ = Describe("netstat", func() {
 		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
 
 		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{
-			newVMIStatusIface(networkName, nil, ifaceMAC, guestIfaceName, netvmispec.InfoSourceDomainAndGA, netsetup.UnknownInterfaceQueueCount),
+			newSRIOVVMIStatusIface(networkName, nil, ifaceMAC, guestIfaceName, netvmispec.InfoSourceDomainAndGA, netsetup.UnknownInterfaceQueueCount),
 		}), "the SR-IOV interface should be reported in the status, associated to the network")
 	})
 
@@ -918,6 +940,10 @@ func newVMIStatusIface(name string, IPs []string, mac, ifaceName string, infoSou
 	if len(IPs) > 0 {
 		ip = IPs[0]
 	}
+	var linkState v1.InterfaceState
+	if netvmispec.ContainsInfoSource(infoSource, netvmispec.InfoSourceDomain) {
+		linkState = v1.InterfaceStateUp
+	}
 	return v1.VirtualMachineInstanceNetworkInterface{
 		Name:          name,
 		InterfaceName: ifaceName,
@@ -926,9 +952,17 @@ func newVMIStatusIface(name string, IPs []string, mac, ifaceName string, infoSou
 		MAC:           mac,
 		InfoSource:    infoSource,
 		QueueCount:    queueCount,
+		LinkState:     linkState,
 	}
 }
 
+// newSRIOVVMIStatusIface returns the status of an SR-IOV interface, which has no link state since it is a host device
+func newSRIOVVMIStatusIface(name string, IPs []string, mac, ifaceName string, infoSource string, queueCount int32) v1.VirtualMachineInstanceNetworkInterface {
+	iface := newVMIStatusIface(name, IPs, mac, ifaceName, infoSource, queueCount)
+	iface.LinkState = ""
+	return iface
+}
+
 func newVMISpecIfaceWithMasqueradeBinding(name string) v1.Interface {
 	return v1.Interface{
 		Name: name,
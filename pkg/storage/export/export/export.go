@@ -88,6 +88,7 @@ const (
 	blockVolumeMountPath = "/dev/export-volumes"
 	fileSystemMountPath  = "/export-volumes"
 	urlBasePath          = "/volumes"
+	bundleBasePath       = "/bundles"
 
 	// annContentType is an annotation on a PVC indicating the content type. This is populated by CDI.
 	annContentType = "cdi.kubevirt.io/storage.contentType"
@@ -157,6 +158,22 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func rawZstdURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.img.zst", urlBasePath, pvc.Name))
+}
+
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
+func ovaURI(vm *virtv1.VirtualMachine) string {
+	return path.Join(fmt.Sprintf("%s/%s.ova", bundleBasePath, vm.Name))
+}
+
+func extentsURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/extents", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -166,7 +183,9 @@ func dirURI(pvc *corev1.PersistentVolumeClaim) string {
 }
 
 type sourceVolumes struct {
-	volumes          []*corev1.PersistentVolumeClaim
+	volumes []*corev1.PersistentVolumeClaim
+	// baseVolumes are the volumes of the base snapshot, keyed by the name of the exported volume
+	baseVolumes      map[string]*corev1.PersistentVolumeClaim
 	inUse            bool
 	isPopulated      bool
 	availableMessage string
@@ -546,7 +565,7 @@ func (ctrl *VMExportController) manageExporterPod(vmExport *exportv1.VirtualMach
 	if !podExists {
 		if sourceVolumes.isSourceAvailable() {
 			if len(sourceVolumes.volumes) > 0 {
-				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes.volumes)
+				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes.volumes, sourceVolumes.baseVolumes)
 				if err != nil {
 					return nil, err
 				}
@@ -766,6 +785,12 @@ func (ctrl *VMExportController) getExportPodName(vmExport *exportv1.VirtualMachi
 	return naming.GetName(exportPrefix, vmExport.Name, validation.DNS1035LabelMaxLength)
 }
 
+// baseRestorePVCName returns the name of the PVC restored from a volume snapshot of the base snapshot. Unlike the
+// restored PVCs of the exported snapshot, it is derived from the name of the volume snapshot.
+func baseRestorePVCName(vmExport *exportv1.VirtualMachineExport, volumeSnapshotName string) string {
+	return naming.GetName(vmExport.Name, "base-"+volumeSnapshotName, validation.DNS1123SubdomainMaxLength)
+}
+
 func (ctrl *VMExportController) getOrCreateExportService(vmExport *exportv1.VirtualMachineExport) (*corev1.Service, error) {
 	key := controller.NamespacedKey(vmExport.Namespace, ctrl.getExportServiceName(vmExport))
 	if service, exists, err := ctrl.ServiceInformer.GetStore().GetByKey(key); err != nil {
@@ -831,14 +856,14 @@ func (ctrl *VMExportController) getExporterPod(vmExport *exportv1.VirtualMachine
 	}
 }
 
-func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim, basePVCs map[string]*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
 	log.Log.V(3).Infof("Checking if pod exists: %s/%s", vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	key := controller.NamespacedKey(vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	if obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(key); err != nil {
 		log.Log.Errorf("error %v", err)
 		return nil, err
 	} else if !exists {
-		manifest, err := ctrl.createExporterPodManifest(vmExport, service, pvcs)
+		manifest, err := ctrl.createExporterPodManifest(vmExport, service, pvcs, basePVCs)
 		if err != nil {
 			return nil, err
 		}
@@ -856,7 +881,7 @@ func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMach
 	}
 }
 
-func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim, basePVCs map[string]*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
 	certParams, err := ctrl.getCertParams()
 	if err != nil {
 		return nil, err
@@ -877,30 +902,11 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
 	}
 	for i, pvc := range pvcs {
-		var mountPoint string
-		if types.IsPVCBlock(pvc.Spec.VolumeMode) {
-			mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, pvc.Name)
-			podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
-				Name:       pvc.Name,
-				DevicePath: mountPoint,
-			})
-		} else {
-			mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, pvc.Name)
-			podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
-				Name:      pvc.Name,
-				ReadOnly:  true,
-				MountPath: mountPoint,
-			})
-		}
-		podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
-			Name: pvc.Name,
-			VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: pvc.Name,
-				},
-			},
-		})
+		mountPoint := addVolumeToExporterPod(podManifest, pvc)
 		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
+		if vmExport.Spec.BaseSnapshot != nil && ctrl.isKubevirtContentType(pvc) {
+			addBaseVolumeEnvironmentVariables(podManifest, pvc, basePVCs[pvc.Name], i)
+		}
 	}
 
 	// Add token and certs ENV variables
@@ -981,6 +987,49 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 	return podManifest, nil
 }
 
+func addVolumeToExporterPod(podManifest *corev1.Pod, pvc *corev1.PersistentVolumeClaim) string {
+	var mountPoint string
+	if types.IsPVCBlock(pvc.Spec.VolumeMode) {
+		mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, pvc.Name)
+		podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
+			Name:       pvc.Name,
+			DevicePath: mountPoint,
+		})
+	} else {
+		mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, pvc.Name)
+		podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      pvc.Name,
+			ReadOnly:  true,
+			MountPath: mountPoint,
+		})
+	}
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: pvc.Name,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvc.Name,
+			},
+		},
+	})
+	return mountPoint
+}
+
+// addBaseVolumeEnvironmentVariables mounts the base volume of an incremental export, if any, and exposes the
+// extents of the volume which changed since then
+func addBaseVolumeEnvironmentVariables(podManifest *corev1.Pod, pvc, basePVC *corev1.PersistentVolumeClaim, index int) {
+	exportContainer := &podManifest.Spec.Containers[0]
+	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d_EXPORT_EXTENTS_URI", index),
+		Value: extentsURI(pvc),
+	})
+	if basePVC != nil {
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_BASE_PATH", index),
+			Value: addVolumeToExporterPod(podManifest, basePVC),
+		})
+	}
+}
+
 func (ctrl *VMExportController) createDataManifestAndAddToPod(vmExport *exportv1.VirtualMachineExport, vm *virtv1.VirtualMachine, podManifest *corev1.Pod, service *corev1.Service) error {
 	vmManifestConfigMap, err := ctrl.createDataManifestConfigMap(vmExport, vm, service)
 	if err != nil {
@@ -999,6 +1048,10 @@ func (ctrl *VMExportController) createDataManifestAndAddToPod(vmExport *exportv1
 		Name:      manifestData,
 		MountPath: "/manifest_data",
 	})
+	podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+		Name:  "EXPORT_OVA_URI",
+		Value: ovaURI(vm),
+	})
 	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
 		Name: manifestData,
 		VolumeSource: corev1.VolumeSource{
@@ -1116,6 +1169,12 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_ZSTD_URI", index),
+			Value: rawZstdURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
 		})
 	} else {
 		if ctrl.isKubevirtContentType(pvc) {
@@ -1125,6 +1184,12 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 			}, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_ZSTD_URI", index),
+				Value: rawZstdURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+				Value: qcow2URI(pvc),
 			})
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
@@ -862,7 +862,7 @@ var _ = Describe("Export controller", func() {
 		})
 		service, err = controller.getOrCreateExportService(testVMExport)
 		Expect(err).ToNot(HaveOccurred())
-		pod, err := controller.createExporterPod(testVMExport, service, []*k8sv1.PersistentVolumeClaim{testPVC})
+		pod, err := controller.createExporterPod(testVMExport, service, []*k8sv1.PersistentVolumeClaim{testPVC}, nil)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(pod).ToNot(BeNil())
 		Expect(pod.Name).To(Equal(fmt.Sprintf("%s-%s", exportPrefix, testVMExport.Name)))
@@ -1360,59 +1360,68 @@ func verifyLinksInternal(vmExport *exportv1.VirtualMachineExport, expectedVolume
 	Expect(vmExport.Status.Links).ToNot(BeNil())
 	Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 	Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-	Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+	var formats []exportv1.VirtualMachineExportVolumeFormat
 	for _, volume := range vmExport.Status.Links.Internal.Volumes {
-		Expect(volume.Formats).To(HaveLen(2))
-		Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
+		formats = append(formats, volume.Formats...)
 	}
+	Expect(formats).To(ConsistOf(expectedVolumeFormats))
 }
 
-func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, link1Format exportv1.ExportVolumeFormat, link1Url string, link2Format exportv1.ExportVolumeFormat, link2Url string) {
+func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, expectedVolumeFormats ...exportv1.VirtualMachineExportVolumeFormat) {
 	Expect(vmExport.Status.Links.External).ToNot(BeNil())
 	Expect(vmExport.Status.Links.External.Cert).To(BeEmpty())
 	Expect(vmExport.Status.Links.External.Volumes).To(HaveLen(1))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(HaveLen(2))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ContainElements(exportv1.VirtualMachineExportVolumeFormat{
-		Format: link1Format,
-		Url:    link1Url,
-	}, exportv1.VirtualMachineExportVolumeFormat{
-		Format: link2Format,
-		Url:    link2Url,
-	}))
+	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ConsistOf(expectedVolumeFormats))
+}
+
+func kubevirtVolumeFormats(baseURL string) []exportv1.VirtualMachineExportVolumeFormat {
+	return []exportv1.VirtualMachineExportVolumeFormat{{
+		Format: exportv1.KubeVirtRaw,
+		Url:    baseURL + "/disk.img",
+	}, {
+		Format: exportv1.KubeVirtGz,
+		Url:    baseURL + "/disk.img.gz",
+	}, {
+		Format: exportv1.KubeVirtZstd,
+		Url:    baseURL + "/disk.img.zst",
+	}, {
+		Format: exportv1.KubeVirtQcow2,
+		Url:    baseURL + "/disk.qcow2",
+	}}
+}
+
+func archiveVolumeFormats(baseURL string) []exportv1.VirtualMachineExportVolumeFormat {
+	return []exportv1.VirtualMachineExportVolumeFormat{{
+		Format: exportv1.Dir,
+		Url:    baseURL + "/dir",
+	}, {
+		Format: exportv1.ArchiveGz,
+		Url:    baseURL + "/disk.tar.gz",
+	}}
+}
+
+func internalVolumeURL(exportName, namespace, volumeName string) string {
+	return fmt.Sprintf("https://%s.%s.svc/volumes/%s", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName)
+}
+
+func externalVolumeURL(exportName, namespace, volumeName string) string {
+	return fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s", currentVersion, namespace, exportName, volumeName)
 }
 
 func verifyKubevirtInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
 	exportVolumeFormats := make([]exportv1.VirtualMachineExportVolumeFormat, 0)
 	for _, volumeName := range volumeNames {
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtRaw,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		})
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtGz,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		})
+		exportVolumeFormats = append(exportVolumeFormats, kubevirtVolumeFormats(internalVolumeURL(exportName, namespace, volumeName))...)
 	}
 	verifyLinksInternal(vmExport, exportVolumeFormats...)
 }
 
 func verifyKubevirtExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
-	verifyLinksExternal(vmExport,
-		exportv1.KubeVirtRaw,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img", currentVersion, namespace, exportName, volumeName),
-		exportv1.KubeVirtGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img.gz", currentVersion, namespace, exportName, volumeName))
+	verifyLinksExternal(vmExport, kubevirtVolumeFormats(externalVolumeURL(exportName, namespace, volumeName))...)
 }
 
 func verifyArchiveInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
-	verifyLinksInternal(vmExport,
-		exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.Dir,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/dir", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		}, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.ArchiveGz,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.tar.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		})
+	verifyLinksInternal(vmExport, archiveVolumeFormats(internalVolumeURL(exportName, namespace, volumeName))...)
 }
 
 func routeToHostAndService(serviceName string) *routev1.Route {
@@ -1476,11 +1485,7 @@ func ingressToHost() *networkingv1.Ingress {
 }
 
 func verifyArchiveExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
-	verifyLinksExternal(vmExport,
-		exportv1.Dir,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/dir", currentVersion, namespace, exportName, volumeName),
-		exportv1.ArchiveGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.tar.gz", currentVersion, namespace, exportName, volumeName))
+	verifyLinksExternal(vmExport, archiveVolumeFormats(externalVolumeURL(exportName, namespace, volumeName))...)
 }
 
 func writeCertsToDir(dir string) {
@@ -125,6 +125,24 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawGzURI),
 			})
 		}
+		if volumeInfo.RawZstdURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtZstd,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawZstdURI),
+			})
+		}
+		if volumeInfo.Qcow2URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2URI),
+			})
+		}
+		if volumeInfo.ExtentsURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.Extents,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.ExtentsURI),
+			})
+		}
 		if volumeInfo.DirURI != "" {
 			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.Dir,
@@ -146,6 +164,13 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 		exportLink.Volumes = append(exportLink.Volumes, ev)
 	}
 
+	if paths.OVAURI != "" && len(exportLink.Volumes) > 0 {
+		exportLink.Bundles = append(exportLink.Bundles, exportv1.VirtualMachineExportBundle{
+			Format: exportv1.OVA,
+			Url:    scheme + path.Join(hostAndBase, paths.OVAURI),
+		})
+	}
+
 	return exportLink, nil
 }
 
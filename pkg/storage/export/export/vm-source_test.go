@@ -359,23 +359,8 @@ var _ = Describe("PVC source", func() {
 	}
 
 	verifyMixedInternal := func(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
-		exportVolumeFormats := make([]exportv1.VirtualMachineExportVolumeFormat, 0)
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtRaw,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
-		})
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtGz,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[0]),
-		})
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.Dir,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/dir", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[1]),
-		})
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.ArchiveGz,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.tar.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeNames[1]),
-		})
+		exportVolumeFormats := kubevirtVolumeFormats(internalVolumeURL(exportName, namespace, volumeNames[0]))
+		exportVolumeFormats = append(exportVolumeFormats, archiveVolumeFormats(internalVolumeURL(exportName, namespace, volumeNames[1]))...)
 		verifyLinksInternal(vmExport, exportVolumeFormats...)
 	}
 
@@ -391,6 +376,10 @@ var _ = Describe("PVC source", func() {
 			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
 			Expect(ok).To(BeTrue())
 			verifyFunc(vmExport, vmExport.Name, testNamespace, "volume1", "volume2")
+			Expect(vmExport.Status.Links.Internal.Bundles).To(ConsistOf(exportv1.VirtualMachineExportBundle{
+				Format: exportv1.OVA,
+				Url:    fmt.Sprintf("https://%s-%s.%s.svc/bundles/%s.ova", exportPrefix, vmExport.Name, testNamespace, testVmName),
+			}))
 			for _, condition := range vmExport.Status.Conditions {
 				if condition.Type == exportv1.ConditionReady {
 					Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
@@ -86,11 +86,16 @@ func (ctrl *VMExportController) getPVCFromSourceVMSnapshot(vmExport *exportv1.Vi
 			return &sourceVolumes{}, err
 		}
 		if len(pvcs) == restoreableSnapshots && restoreableSnapshots > 0 {
+			baseVolumes, availableMessage, err := ctrl.handleBasePVCsForVirtualMachineSnapshot(vmExport, vmSnapshot)
+			if err != nil {
+				return &sourceVolumes{}, err
+			}
 			return &sourceVolumes{
 				volumes:          pvcs,
+				baseVolumes:      baseVolumes,
 				inUse:            false,
-				isPopulated:      true,
-				availableMessage: ""}, nil
+				isPopulated:      availableMessage == "",
+				availableMessage: availableMessage}, nil
 		}
 		if restoreableSnapshots == 0 {
 			return &sourceVolumes{
@@ -128,7 +133,8 @@ func (ctrl *VMExportController) handlePVCsForVirtualMachineSnapshot(vmExport *ex
 			sourceVm := content.Spec.Source.VirtualMachine
 			totalVolumes = len(content.Status.VolumeSnapshotStatus)
 			for _, volumeBackup := range content.Spec.VolumeBackups {
-				if pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, &volumeBackup, sourceVm); err != nil {
+				restorePVCName := fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
+				if pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, restorePVCName, &volumeBackup, sourceVm); err != nil {
 					return nil, 0, err
 				} else {
 					pvcs = append(pvcs, pvc)
@@ -139,16 +145,70 @@ func (ctrl *VMExportController) handlePVCsForVirtualMachineSnapshot(vmExport *ex
 	return pvcs, totalVolumes, err
 }
 
-func (ctrl *VMExportController) getOrCreatePVCFromSnapshot(vmExport *exportv1.VirtualMachineExport, volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) (*corev1.PersistentVolumeClaim, error) {
+// handleBasePVCsForVirtualMachineSnapshot restores the volumes of the base snapshot of an incremental export. The
+// restored PVCs are keyed by the name of the exported PVC of the same volume. A message is returned when the base
+// snapshot cannot be used (yet).
+func (ctrl *VMExportController) handleBasePVCsForVirtualMachineSnapshot(vmExport *exportv1.VirtualMachineExport, vmSnapshot *snapshotv1.VirtualMachineSnapshot) (map[string]*corev1.PersistentVolumeClaim, string, error) {
+	if vmExport.Spec.BaseSnapshot == nil {
+		return nil, "", nil
+	}
+	baseSnapshot, exists, err := ctrl.getVmSnapshot(vmExport.Namespace, *vmExport.Spec.BaseSnapshot)
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s does not exist", vmExport.Namespace, *vmExport.Spec.BaseSnapshot), nil
+	}
+	if baseSnapshot.Spec.Source.Name != vmSnapshot.Spec.Source.Name {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not a snapshot of %s", vmExport.Namespace, baseSnapshot.Name, vmSnapshot.Spec.Source.Name), nil
+	}
+	if baseSnapshot.Status == nil || baseSnapshot.Status.ReadyToUse == nil || !*baseSnapshot.Status.ReadyToUse ||
+		baseSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not ready to use", vmExport.Namespace, baseSnapshot.Name), nil
+	}
+
+	content, exists, err := ctrl.getVmSnapshotContent(vmSnapshot.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName)
+	if err != nil || !exists {
+		return nil, "", err
+	}
+	baseContent, exists, err := ctrl.getVmSnapshotContent(baseSnapshot.Namespace, *baseSnapshot.Status.VirtualMachineSnapshotContentName)
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not ready to use", vmExport.Namespace, baseSnapshot.Name), nil
+	}
+
+	// Volumes missing from the base snapshot have no base, all of their extents are exposed
+	baseVolumes := make(map[string]*corev1.PersistentVolumeClaim)
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		for _, baseVolumeBackup := range baseContent.Spec.VolumeBackups {
+			if baseVolumeBackup.VolumeName != volumeBackup.VolumeName || baseVolumeBackup.VolumeSnapshotName == nil {
+				continue
+			}
+			restorePVCName := baseRestorePVCName(vmExport, *baseVolumeBackup.VolumeSnapshotName)
+			pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, restorePVCName, &baseVolumeBackup, baseContent.Spec.Source.VirtualMachine)
+			if err != nil {
+				return nil, "", err
+			}
+			baseVolumes[fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)] = pvc
+		}
+	}
+	return baseVolumes, "", nil
+}
+
+func (ctrl *VMExportController) getOrCreatePVCFromSnapshot(vmExport *exportv1.VirtualMachineExport, restorePVCName string, volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) (*corev1.PersistentVolumeClaim, error) {
 	if volumeBackup.VolumeSnapshotName == nil {
 		log.Log.Errorf("VolumeSnapshot name missing %+v", volumeBackup)
 		return nil, fmt.Errorf("missing VolumeSnapshot name")
 	}
-	restorePVCName := fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
 
 	if pvc, exists, err := ctrl.getPvc(vmExport.Namespace, restorePVCName); err != nil {
 		return nil, err
 	} else if exists {
+		if pvc.Spec.DataSource == nil || pvc.Spec.DataSource.Name != *volumeBackup.VolumeSnapshotName {
+			return nil, fmt.Errorf("PVC %s/%s is not restored from VolumeSnapshot %s", pvc.Namespace, pvc.Name, *volumeBackup.VolumeSnapshotName)
+		}
 		return pvc, nil
 	}
 
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -39,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -689,4 +691,156 @@ var _ = Describe("VMSnapshot source", func() {
 		Expect(retry).To(BeEquivalentTo(0))
 	})
 
+	Context("with a base snapshot", func() {
+		const (
+			baseSnapshotName        = "base-snapshot"
+			baseSnapshotContentName = "base-snapshot-content"
+		)
+
+		createBaseVMSnapshot := func(vmName string, ready bool) *snapshotv1.VirtualMachineSnapshot {
+			vmSnapshot := createTestVMSnapshot(ready)
+			vmSnapshot.Name = baseSnapshotName
+			vmSnapshot.Spec.Source.Name = vmName
+			vmSnapshot.Status.VirtualMachineSnapshotContentName = pointer.StringPtr(baseSnapshotContentName)
+			return vmSnapshot
+		}
+
+		createIncrementalVMExport := func() *exportv1.VirtualMachineExport {
+			vmExport := createSnapshotVMExport()
+			vmExport.Spec.BaseSnapshot = pointer.StringPtr(baseSnapshotName)
+			return vmExport
+		}
+
+		BeforeEach(func() {
+			vmSnapshot := createTestVMSnapshot(true)
+			vmSnapshot.Spec.Source.Name = testVmName
+			Expect(vmSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+			Expect(vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent("snapshot-content"))).To(Succeed())
+			Expect(vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent(baseSnapshotContentName))).To(Succeed())
+			fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		})
+
+		It("Should restore the PVCs of the base snapshot and expose the changed extents", func() {
+			testVMExport := createIncrementalVMExport()
+			var createdPVCs []string
+			k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				pvc := action.(testing.CreateAction).GetObject().(*k8sv1.PersistentVolumeClaim)
+				createdPVCs = append(createdPVCs, pvc.Name)
+				Expect(pvc.OwnerReferences).To(HaveLen(1))
+				Expect(pvc.OwnerReferences[0].Name).To(Equal(testVMExport.Name))
+				return true, pvc, nil
+			})
+			podCreated := false
+			k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				pod := action.(testing.CreateAction).GetObject().(*k8sv1.Pod)
+				podCreated = true
+				Expect(pod.Spec.Volumes).To(ContainElements(
+					HaveField("PersistentVolumeClaim.ClaimName", "test-test-snapshot"),
+					HaveField("PersistentVolumeClaim.ClaimName", "test-base-test-snapshot"),
+				))
+				Expect(pod.Spec.Containers[0].Env).To(ContainElements(
+					k8sv1.EnvVar{Name: "VOLUME0_EXPORT_EXTENTS_URI", Value: "/volumes/test-test-snapshot/extents"},
+					k8sv1.EnvVar{Name: "VOLUME0_EXPORT_BASE_PATH", Value: "/export-volumes/test-base-test-snapshot"},
+				))
+				pod.Status.Phase = k8sv1.PodPending
+				return true, pod, nil
+			})
+			vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, action.(testing.UpdateAction).GetObject(), nil
+			})
+
+			Expect(vmSnapshotInformer.GetStore().Add(createBaseVMSnapshot(testVmName, true))).To(Succeed())
+			fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+			_, err := controller.updateVMExport(testVMExport)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createdPVCs).To(ConsistOf("test-test-snapshot", "test-base-test-snapshot"))
+			Expect(podCreated).To(BeTrue())
+		})
+
+		It("Should update status with the extents links", func() {
+			testVMExport := createIncrementalVMExport()
+			restoreName := fmt.Sprintf("%s-%s", testVMExport.Name, testVolumesnapshotName)
+			k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, action.(testing.CreateAction).GetObject(), nil
+			})
+			expectExporterCreate(k8sClient, k8sv1.PodRunning)
+			updated := false
+			vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				updated = true
+				vmExport := action.(testing.UpdateAction).GetObject().(*exportv1.VirtualMachineExport)
+				baseURL := internalVolumeURL(vmExport.Name, testNamespace, restoreName)
+				verifyLinksInternal(vmExport, append(kubevirtVolumeFormats(baseURL), exportv1.VirtualMachineExportVolumeFormat{
+					Format: exportv1.Extents,
+					Url:    baseURL + "/extents",
+				})...)
+				return true, vmExport, nil
+			})
+
+			controller.RouteCache.Add(routeToHostAndService(components.VirtExportProxyServiceName))
+			Expect(vmSnapshotInformer.GetStore().Add(createBaseVMSnapshot(testVmName, true))).To(Succeed())
+			fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+			_, err := controller.updateVMExport(testVMExport)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+		})
+
+		It("Should not use a PVC which is not restored from the base volume snapshot", func() {
+			testVMExport := createIncrementalVMExport()
+			k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, action.(testing.CreateAction).GetObject(), nil
+			})
+			k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Fail("Should not create the exporter pod")
+				return true, nil, nil
+			})
+			pvc := createRestoredPVC(baseRestorePVCName(testVMExport, testVolumesnapshotName))
+			pvc.Spec.DataSource.Name = "other-snapshot"
+			Expect(pvcInformer.GetStore().Add(pvc)).To(Succeed())
+
+			Expect(vmSnapshotInformer.GetStore().Add(createBaseVMSnapshot(testVmName, true))).To(Succeed())
+			_, err := controller.updateVMExport(testVMExport)
+			Expect(err).To(MatchError(ContainSubstring("is not restored from VolumeSnapshot " + testVolumesnapshotName)))
+		})
+
+		It("Should name the base PVCs within the length limit", func() {
+			testVMExport := createIncrementalVMExport()
+			testVMExport.Name = strings.Repeat("e", validation.DNS1123SubdomainMaxLength)
+			name := baseRestorePVCName(testVMExport, strings.Repeat("s", validation.DNS1123SubdomainMaxLength))
+			Expect(len(name)).To(BeNumerically("<=", validation.DNS1123SubdomainMaxLength))
+			Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+		})
+
+		DescribeTable("Should not create the exporter pod", func(baseSnapshot *snapshotv1.VirtualMachineSnapshot, expectedMessage string) {
+			testVMExport := createIncrementalVMExport()
+			k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				pvc := action.(testing.CreateAction).GetObject().(*k8sv1.PersistentVolumeClaim)
+				Expect(pvc.Name).To(Equal("test-test-snapshot"))
+				return true, pvc, nil
+			})
+			k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Fail("Should not create the exporter pod")
+				return true, nil, nil
+			})
+			vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				vmExport := action.(testing.UpdateAction).GetObject().(*exportv1.VirtualMachineExport)
+				verifyLinksEmpty(vmExport)
+				Expect(vmExport.Status.Conditions).To(ContainElement(And(
+					HaveField("Type", exportv1.ConditionReady),
+					HaveField("Status", k8sv1.ConditionFalse),
+					HaveField("Message", fmt.Sprintf(expectedMessage, testNamespace, baseSnapshotName)),
+				)))
+				return true, vmExport, nil
+			})
+
+			if baseSnapshot != nil {
+				Expect(vmSnapshotInformer.GetStore().Add(baseSnapshot)).To(Succeed())
+			}
+			_, err := controller.updateVMExport(testVMExport)
+			Expect(err).ToNot(HaveOccurred())
+		},
+			Entry("if the base snapshot does not exist", nil, "Base VirtualMachineSnapshot %s/%s does not exist"),
+			Entry("if the base snapshot is not ready", createBaseVMSnapshot(testVmName, false), "Base VirtualMachineSnapshot %s/%s is not ready to use"),
+			Entry("if the base snapshot is of another VM", createBaseVMSnapshot("other-vm", true), "Base VirtualMachineSnapshot %s/%s is not a snapshot of "+testVmName),
+		)
+	})
 })
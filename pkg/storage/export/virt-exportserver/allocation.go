@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+// allocatedBlocks tells which blocks of an image contain data, based on the holes of the file. It reads no data,
+// so that sparse formats can be laid out ahead of streaming the image once. Every block is considered allocated
+// when the file system or the device does not report holes.
+func allocatedBlocks(f *os.File, size, blockSize int64) ([]bool, error) {
+	allocated := make([]bool, (size+blockSize-1)/blockSize)
+	for offset := int64(0); offset < size; {
+		dataStart, err := f.Seek(offset, unix.SEEK_DATA)
+		if errors.Is(err, unix.ENXIO) {
+			// no more data after offset
+			break
+		} else if errors.Is(err, unix.EINVAL) {
+			for i := range allocated {
+				allocated[i] = true
+			}
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		dataEnd, err := f.Seek(dataStart, unix.SEEK_HOLE)
+		if err != nil {
+			return nil, err
+		}
+		dataEnd = min(dataEnd, size)
+		for i := dataStart / blockSize; i*blockSize < dataEnd; i++ {
+			allocated[i] = true
+		}
+		offset = dataEnd
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return allocated, nil
+}
+
+// readBlock reads a block of an image, padding it with zeros past the end of the image
+func readBlock(source io.ReaderAt, size int64, index int64, block []byte) error {
+	offset := index * int64(len(block))
+	n, err := source.ReadAt(block, offset)
+	if err != nil && !(errors.Is(err, io.EOF) && offset+int64(n) == size) {
+		return err
+	}
+	clear(block[n:])
+	return nil
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -35,7 +35,9 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	flag "github.com/spf13/pflag"
@@ -85,6 +87,10 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	ZstdHandler        func(string) http.Handler
+	Qcow2Handler       func(string) http.Handler
+	OvaHandler         func([]export.VolumeInfo) http.Handler
+	ExtentsHandler     func(string, string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
@@ -126,6 +132,9 @@ func (s *exportServer) initHandler() {
 		if hasPermissions := s.PermissionChecker(vi.Path); !hasPermissions {
 			golog.Fatalf("unable to manipulate %s's contents, exiting", vi.Path)
 		}
+		if vi.BasePath != "" && !s.PermissionChecker(vi.BasePath) {
+			golog.Fatalf("unable to manipulate %s's contents, exiting", vi.BasePath)
+		}
 		for path, handler := range s.getHandlerMap(vi) {
 			log.Log.Infof("Handling path %s\n", path)
 			mux.Handle(path, tokenChecker(s.TokenGetter, handler))
@@ -135,6 +144,9 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getInternalBasePath, getInternalCAConfigMap)))
 		mux.Handle(filepath.Join(external, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getExternalBasePath, getExternalCAConfigMap)))
 	}
+	if s.Paths.OVAURI != "" {
+		mux.Handle(s.Paths.OVAURI, tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+	}
 	if s.Paths.SecretURI != "" {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
@@ -183,6 +195,27 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.RawZstdURI != "" {
+		result[vi.RawZstdURI] = s.ZstdHandler(p)
+	}
+
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p)
+	}
+
+	if vi.ExtentsURI != "" {
+		basePath := vi.BasePath
+		if basePath != "" {
+			if fi, err := os.Stat(basePath); err != nil {
+				log.Log.Reason(err).Errorf("error statting %s", basePath)
+				return nil
+			} else if fi.IsDir() {
+				basePath = path.Join(basePath, "disk.img")
+			}
+		}
+		result[vi.ExtentsURI] = s.ExtentsHandler(p, basePath)
+	}
+
 	return result
 }
 
@@ -242,6 +275,22 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.ZstdHandler == nil {
+		es.ZstdHandler = zstdHandler
+	}
+
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
+	if es.OvaHandler == nil {
+		es.OvaHandler = ovaHandler
+	}
+
+	if es.ExtentsHandler == nil {
+		es.ExtentsHandler = extentsHandler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
@@ -412,6 +461,24 @@ func newTarReader(mountPoint string) (io.ReadCloser, error) {
 	return &execReader{cmd: cmd, stdout: stdout, stderr: io.NopCloser(&stderr)}, nil
 }
 
+func newZstdReader(filePath string) (io.ReadCloser, error) {
+	cmd := exec.Command("/usr/bin/zstd", "--stdout", "--quiet", "-T0", filePath)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	return &execReader{cmd: cmd, stdout: stdout, stderr: io.NopCloser(&stderr)}, nil
+}
+
 func pipeToGzip(reader io.ReadCloser) io.ReadCloser {
 	pr, pw := io.Pipe()
 	zw := gzip.NewWriter(pw)
@@ -565,6 +632,155 @@ func gzipHandler(filePath string) http.Handler {
 	})
 }
 
+func zstdHandler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		zstdReader, err := newZstdReader(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error compressing %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer zstdReader.Close()
+		n, err := io.Copy(w, zstdReader)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func qcow2Handler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, size, err := openImage(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		image, err := newQcow2Image(f, size)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error converting %s to qcow2", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.FormatInt(image.Size(), 10))
+		n, err := image.WriteTo(w)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func openImage(filePath string) (*os.File, int64, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, 0, err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, size, nil
+}
+
+// extentsHandler serves the extents which changed since the base image. Both images are restored from snapshots
+// and never change, so the extents are computed once, by the first request, and cached.
+func extentsHandler(filePath, basePath string) http.Handler {
+	var mutex sync.Mutex
+	var cached []byte
+	getExtents := func() ([]byte, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if cached != nil {
+			return cached, nil
+		}
+		extents, err := computeExtents(filePath, basePath)
+		if err != nil {
+			return nil, err
+		}
+		cached, err = json.Marshal(extents)
+		return cached, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, err := getExtents()
+		if err != nil {
+			log.Log.Reason(err).Errorf("error comparing %s with %s", filePath, basePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		n, err := w.Write(data)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing extents")
+			return
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func computeExtents(filePath, basePath string) (*imageExtents, error) {
+	image, size, err := openImage(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer image.Close()
+	var base *os.File
+	var baseSize int64
+	if basePath != "" {
+		if base, baseSize, err = openImage(basePath); err != nil {
+			return nil, err
+		}
+		defer base.Close()
+	}
+	extents, err := changedExtents(image, size, base, baseSize)
+	if err != nil {
+		return nil, err
+	}
+	return &imageExtents{Size: size, BlockSize: extentsBlockSize, Extents: extents}, nil
+}
+
+func ovaHandler(vi []export.VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		expandedVm := getExpandedVM()
+		if expandedVm == nil {
+			log.Log.Error("error getting VM definition")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		disks, err := openOvaDisks(vi)
+		if err != nil {
+			log.Log.Reason(err).Error("error opening disks")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer closeOvaDisks(disks)
+		w.Header().Set("Content-Type", "application/x-tar")
+		if err := writeOva(w, expandedVm, disks); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+	})
+}
+
 func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCmFunc func() (*corev1.ConfigMap, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
@@ -20,11 +20,16 @@
 package virtexportserver
 
 import (
+	"archive/tar"
+	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -63,6 +68,18 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		ZstdHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		Qcow2Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		OvaHandler: func([]export.VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ExtentsHandler: func(string, string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -125,6 +142,21 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BasePath: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -180,6 +212,21 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BasePath: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -232,6 +279,21 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BasePath: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -283,6 +345,21 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("raw zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", RawZstdURI: "/volume/v1/disk.img.zst"},
+			"/volume/v1/disk.img.zst",
+		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BasePath: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -295,6 +372,29 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	It("should handle the OVA URI", func() {
+		token := "foo"
+		es := newTestServer(token)
+		es.Paths = &export.ServerPaths{OVAURI: "/bundles/vm.ova"}
+		es.initHandler()
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		client := http.Client{}
+		req, err := http.NewRequest("GET", httpServer.URL+"/bundles/vm.ova", nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("x-kubevirt-export-token", token)
+		res, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		req.Header.Set("x-kubevirt-export-token", "bar")
+		res, err = client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(http.StatusUnauthorized))
+	})
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
@@ -677,6 +777,183 @@ var _ = Describe("exportserver", func() {
 		})
 	})
 
+	Context("Ova handler", func() {
+		var (
+			orgGetExpandedVM = getExpandedVM
+			volumes          []export.VolumeInfo
+		)
+
+		createDisk := func(name string, data []byte, offset int64) export.VolumeInfo {
+			dir := filepath.Join(GinkgoT().TempDir(), name)
+			ExpectWithOffset(1, os.Mkdir(dir, 0755)).To(Succeed())
+			f, err := os.Create(filepath.Join(dir, "disk.img"))
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			defer f.Close()
+			_, err = f.WriteAt(data, offset)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return export.VolumeInfo{Path: dir, Qcow2URI: fmt.Sprintf("/volumes/%s/disk.qcow2", name)}
+		}
+
+		BeforeEach(func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				vm := &virtv1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "testvm"}}
+				vm.Spec.Template = &virtv1.VirtualMachineInstanceTemplateSpec{}
+				vm.Spec.Template.Spec.Domain.CPU = &virtv1.CPU{Sockets: 2, Cores: 2, Threads: 1}
+				vm.Spec.Template.Spec.Domain.Resources.Requests = v1.ResourceList{
+					v1.ResourceMemory: resource.MustParse("2Gi"),
+				}
+				return vm
+			}
+			volumes = []export.VolumeInfo{
+				createDisk("rootdisk", []byte("root"), 0),
+				createDisk("datadisk", []byte("data"), 3*vmdkGrainSize),
+				{Path: "/tmp", ArchiveURI: "/volumes/archive/disk.tar.gz"},
+			}
+		})
+
+		AfterEach(func() {
+			getExpandedVM = orgGetExpandedVM
+		})
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/bundles/testvm.ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		It("should return 500 if getExpandedVM returns nil", func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return nil
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/bundles/testvm.ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return the OVF descriptor followed by the VMDK disks", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/bundles/testvm.ova", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			ovaHandler(volumes).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+
+			files := map[string][]byte{}
+			var names []string
+			tr := tar.NewReader(resp.Body)
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+				data, err := io.ReadAll(tr)
+				Expect(err).ToNot(HaveOccurred())
+				names = append(names, header.Name)
+				files[header.Name] = data
+			}
+			Expect(names).To(Equal([]string{"testvm.ovf", "rootdisk.vmdk", "datadisk.vmdk"}))
+
+			descriptor := string(files["testvm.ovf"])
+			Expect(descriptor).To(ContainSubstring(`<File ovf:id="file1" ovf:href="rootdisk.vmdk" ovf:size="%d">`, len(files["rootdisk.vmdk"])))
+			Expect(descriptor).To(ContainSubstring(`<File ovf:id="file2" ovf:href="datadisk.vmdk" ovf:size="%d">`, len(files["datadisk.vmdk"])))
+			Expect(descriptor).To(ContainSubstring(`<Disk ovf:diskId="disk1" ovf:fileRef="file1" ovf:capacity="4" ovf:capacityAllocationUnits="byte" ovf:format="%s">`, ovfVmdkFormat))
+			Expect(descriptor).To(ContainSubstring(`<Disk ovf:diskId="disk2" ovf:fileRef="file2" ovf:capacity="%d" ovf:capacityAllocationUnits="byte" ovf:format="%s">`, 3*vmdkGrainSize+4, ovfVmdkFormat))
+			Expect(descriptor).To(ContainSubstring(`<VirtualSystem ovf:id="testvm">`))
+			Expect(descriptor).To(ContainSubstring(`<rasd:VirtualQuantity>4</rasd:VirtualQuantity>`))
+			Expect(descriptor).To(ContainSubstring(`<rasd:VirtualQuantity>2048</rasd:VirtualQuantity>`))
+			Expect(descriptor).To(ContainSubstring(`<rasd:HostResource>ovf:/disk/disk2</rasd:HostResource>`))
+			Expect(xml.Unmarshal(files["testvm.ovf"], &struct{}{})).To(Succeed())
+
+			Expect(readVmdkGrain(files["rootdisk.vmdk"], 0)[:5]).To(Equal([]byte("root\x00")))
+			Expect(readVmdkGrain(files["datadisk.vmdk"], 3)[:5]).To(Equal([]byte("data\x00")))
+		})
+	})
+
+	Context("Extents handler", func() {
+		var imagePath, basePath string
+
+		writeImage := func(name string, data []byte, offset int64) string {
+			imagePath := filepath.Join(GinkgoT().TempDir(), name)
+			ExpectWithOffset(1, os.WriteFile(imagePath, make([]byte, 4*extentsBlockSize), 0644)).To(Succeed())
+			f, err := os.OpenFile(imagePath, os.O_WRONLY, 0)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			defer f.Close()
+			_, err = f.WriteAt(data, offset)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return imagePath
+		}
+
+		getExtents := func(handler http.Handler) *imageExtents {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/extents", nil)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			ExpectWithOffset(1, resp.Code).To(Equal(http.StatusOK))
+			extents := &imageExtents{}
+			ExpectWithOffset(1, json.Unmarshal(resp.Body.Bytes(), extents)).To(Succeed())
+			return extents
+		}
+
+		BeforeEach(func() {
+			imagePath = writeImage("disk.img", []byte("changed"), 2*extentsBlockSize)
+			basePath = writeImage("base.img", []byte("base"), 0)
+		})
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/volumes/v1/extents", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			extentsHandler(imagePath, basePath).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		It("should return 500 if the base cannot be opened", func() {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/extents", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			extentsHandler(imagePath, filepath.Join(GinkgoT().TempDir(), "missing.img")).ServeHTTP(resp, req)
+			Expect(resp.Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should return the extents which changed since the base", func() {
+			extents := getExtents(extentsHandler(imagePath, basePath))
+			Expect(extents.Size).To(BeEquivalentTo(4 * extentsBlockSize))
+			Expect(extents.BlockSize).To(BeEquivalentTo(extentsBlockSize))
+			Expect(extents.Extents).To(Equal([]imageExtent{
+				{Offset: 0, Length: extentsBlockSize, Zero: true},
+				{Offset: 2 * extentsBlockSize, Length: extentsBlockSize},
+			}))
+		})
+
+		It("should compute the extents once", func() {
+			handler := extentsHandler(imagePath, basePath)
+			expected := getExtents(handler)
+			Expect(os.WriteFile(imagePath, bytes.Repeat([]byte("changed again"), extentsBlockSize), 0644)).To(Succeed())
+			Expect(getExtents(handler)).To(Equal(expected))
+		})
+
+		It("should return the extents containing data without a base", func() {
+			extents := getExtents(extentsHandler(imagePath, ""))
+			Expect(extents.Extents).To(Equal([]imageExtent{
+				{Offset: 2 * extentsBlockSize, Length: extentsBlockSize},
+			}))
+		})
+	})
+
 	Context("Secret handler", func() {
 		verifySecret := func(yamlString string) {
 			resSecret := &v1.Secret{}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+const (
+	// extentsBlockSize is the granularity at which changes are detected
+	extentsBlockSize = 64 * 1024
+	// extentsReadBlocks is the number of blocks read at once
+	extentsReadBlocks = 16
+)
+
+// imageExtent is a range of the raw image which changed since the base image
+type imageExtent struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
+	// Zero is set when the range only contains zeros, so it does not have to be retrieved
+	Zero bool `json:"zero"`
+}
+
+// imageExtents is the description of the extents returned by the extents endpoint
+type imageExtents struct {
+	// Size is the size of the raw image
+	Size int64 `json:"size"`
+	// BlockSize is the granularity of the extents
+	BlockSize int64         `json:"blockSize"`
+	Extents   []imageExtent `json:"extents"`
+}
+
+// readImageAt fills the buffer with the image content at the given offset, padding it with zeros past the end
+func readImageAt(image io.ReaderAt, buffer []byte, offset, size int64) error {
+	n := 0
+	if image != nil && offset < size {
+		var err error
+		n, err = image.ReadAt(buffer[:min(int64(len(buffer)), size-offset)], offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+	}
+	clear(buffer[n:])
+	return nil
+}
+
+// changedExtents compares an image with its base, block by block, and returns the extents of the blocks which
+// differ. Without a base image, the extents of the blocks which contain data are returned.
+func changedExtents(image io.ReaderAt, size int64, base io.ReaderAt, baseSize int64) ([]imageExtent, error) {
+	var extents []imageExtent
+	imageBuffer := make([]byte, extentsBlockSize*extentsReadBlocks)
+	baseBuffer := make([]byte, extentsBlockSize*extentsReadBlocks)
+
+	for offset := int64(0); offset < size; offset += int64(len(imageBuffer)) {
+		if err := readImageAt(image, imageBuffer, offset, size); err != nil {
+			return nil, err
+		}
+		if err := readImageAt(base, baseBuffer, offset, baseSize); err != nil {
+			return nil, err
+		}
+
+		for blockOffset := 0; blockOffset < len(imageBuffer) && offset+int64(blockOffset) < size; blockOffset += extentsBlockSize {
+			block := imageBuffer[blockOffset : blockOffset+extentsBlockSize]
+			if bytes.Equal(block, baseBuffer[blockOffset:blockOffset+extentsBlockSize]) {
+				continue
+			}
+
+			extent := imageExtent{
+				Offset: offset + int64(blockOffset),
+				Length: min(extentsBlockSize, size-offset-int64(blockOffset)),
+				Zero:   isZero(block),
+			}
+			if last := len(extents) - 1; last >= 0 && extents[last].Offset+extents[last].Length == extent.Offset && extents[last].Zero == extent.Zero {
+				extents[last].Length += extent.Length
+			} else {
+				extents = append(extents, extent)
+			}
+		}
+	}
+	return extents, nil
+}
@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("extents", func() {
+	const blocks = 2*extentsReadBlocks + 3
+
+	newImage := func(size int64, writes map[int64]byte) []byte {
+		image := make([]byte, size)
+		for offset, value := range writes {
+			image[offset] = value
+		}
+		return image
+	}
+
+	block := func(index int64) int64 {
+		return index * extentsBlockSize
+	}
+
+	DescribeTable("should return the changed extents", func(image, base []byte, expected []imageExtent) {
+		var baseReader io.ReaderAt
+		if base != nil {
+			baseReader = bytes.NewReader(base)
+		}
+		extents, err := changedExtents(bytes.NewReader(image), int64(len(image)), baseReader, int64(len(base)))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(Equal(expected))
+	},
+		Entry("without changes",
+			newImage(block(blocks), map[int64]byte{block(3): 1}),
+			newImage(block(blocks), map[int64]byte{block(3): 1}),
+			nil,
+		),
+		Entry("with changed blocks",
+			newImage(block(blocks), map[int64]byte{block(1): 1, block(5) + 10: 2}),
+			newImage(block(blocks), map[int64]byte{block(1): 1}),
+			[]imageExtent{{Offset: block(5), Length: extentsBlockSize}},
+		),
+		Entry("with adjacent changed blocks coalesced, across reads",
+			newImage(block(blocks), map[int64]byte{block(extentsReadBlocks - 1): 1, block(extentsReadBlocks): 1, block(extentsReadBlocks + 2): 1}),
+			newImage(block(blocks), nil),
+			[]imageExtent{
+				{Offset: block(extentsReadBlocks - 1), Length: 2 * extentsBlockSize},
+				{Offset: block(extentsReadBlocks + 2), Length: extentsBlockSize},
+			},
+		),
+		Entry("with zeroed blocks",
+			newImage(block(blocks), map[int64]byte{block(3): 1}),
+			newImage(block(blocks), map[int64]byte{block(2): 1, block(3): 1, block(4): 1}),
+			[]imageExtent{{Offset: block(2), Length: extentsBlockSize, Zero: true}, {Offset: block(4), Length: extentsBlockSize, Zero: true}},
+		),
+		Entry("with data written after the end of the base",
+			newImage(block(blocks)+100, map[int64]byte{block(blocks) + 10: 1}),
+			newImage(block(blocks-1), nil),
+			[]imageExtent{{Offset: block(blocks), Length: 100}},
+		),
+		Entry("with the data blocks when there is no base",
+			newImage(block(blocks), map[int64]byte{0: 1, block(1): 1, block(7): 1}),
+			nil,
+			[]imageExtent{{Offset: 0, Length: 2 * extentsBlockSize}, {Offset: block(7), Length: extentsBlockSize}},
+		),
+	)
+})
@@ -0,0 +1,257 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+)
+
+const (
+	ovfEnvelopeNamespace = "http://schemas.dmtf.org/ovf/envelope/1"
+	ovfRasdNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	ovfVmdkFormat        = "http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"
+
+	// resource types of the CIM_ResourceAllocationSettingData
+	ovfResourceTypeProcessor = 3
+	ovfResourceTypeMemory    = 4
+	ovfResourceTypeDisk      = 17
+)
+
+type ovfEnvelope struct {
+	XMLName       xml.Name         `xml:"Envelope"`
+	Namespace     string           `xml:"xmlns,attr"`
+	OvfNamespace  string           `xml:"xmlns:ovf,attr"`
+	RasdNamespace string           `xml:"xmlns:rasd,attr"`
+	Files         []ovfFile        `xml:"References>File"`
+	DiskSection   ovfDiskSection   `xml:"DiskSection"`
+	VirtualSystem ovfVirtualSystem `xml:"VirtualSystem"`
+}
+
+type ovfFile struct {
+	ID   string `xml:"ovf:id,attr"`
+	Href string `xml:"ovf:href,attr"`
+	Size int64  `xml:"ovf:size,attr"`
+}
+
+type ovfDiskSection struct {
+	Info  string    `xml:"Info"`
+	Disks []ovfDisk `xml:"Disk"`
+}
+
+type ovfDisk struct {
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr"`
+	Capacity                int64  `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr"`
+	Format                  string `xml:"ovf:format,attr"`
+}
+
+type ovfVirtualSystem struct {
+	ID              string                    `xml:"ovf:id,attr"`
+	Info            string                    `xml:"Info"`
+	Name            string                    `xml:"Name"`
+	VirtualHardware ovfVirtualHardwareSection `xml:"VirtualHardwareSection"`
+}
+
+type ovfVirtualHardwareSection struct {
+	Info  string    `xml:"Info"`
+	Items []ovfItem `xml:"Item"`
+}
+
+// ovfItem is a CIM_ResourceAllocationSettingData, whose elements have to be sorted alphabetically
+type ovfItem struct {
+	AllocationUnits string `xml:"rasd:AllocationUnits,omitempty"`
+	ElementName     string `xml:"rasd:ElementName"`
+	HostResource    string `xml:"rasd:HostResource,omitempty"`
+	InstanceID      int    `xml:"rasd:InstanceID"`
+	ResourceType    int    `xml:"rasd:ResourceType"`
+	VirtualQuantity int64  `xml:"rasd:VirtualQuantity,omitempty"`
+}
+
+type ovaDisk struct {
+	name  string
+	file  *os.File
+	image *vmdkImage
+}
+
+func (d *ovaDisk) fileName() string {
+	return d.name + ".vmdk"
+}
+
+// openOvaDisks opens the raw images of the volumes, and computes their layout in the streamOptimized VMDK format
+func openOvaDisks(volumes []export.VolumeInfo) ([]*ovaDisk, error) {
+	var disks []*ovaDisk
+	for _, vi := range volumes {
+		if vi.Qcow2URI == "" {
+			continue
+		}
+		p := vi.Path
+		if fi, err := os.Stat(p); err != nil {
+			closeOvaDisks(disks)
+			return nil, err
+		} else if fi.IsDir() {
+			p = path.Join(p, "disk.img")
+		}
+
+		f, size, err := openImage(p)
+		if err != nil {
+			closeOvaDisks(disks)
+			return nil, err
+		}
+		disk := &ovaDisk{name: filepath.Base(filepath.Clean(vi.Path)), file: f}
+		disks = append(disks, disk)
+
+		if disk.image, err = newVmdkImage(f, size, disk.fileName()); err != nil {
+			closeOvaDisks(disks)
+			return nil, err
+		}
+	}
+	return disks, nil
+}
+
+func closeOvaDisks(disks []*ovaDisk) {
+	for _, disk := range disks {
+		disk.file.Close()
+	}
+}
+
+func vmCPUs(vm *virtv1.VirtualMachine) int64 {
+	cpu := vm.Spec.Template.Spec.Domain.CPU
+	if cpu == nil {
+		return 1
+	}
+	cpus := int64(1)
+	for _, count := range []uint32{cpu.Sockets, cpu.Cores, cpu.Threads} {
+		if count > 0 {
+			cpus *= int64(count)
+		}
+	}
+	return cpus
+}
+
+func vmMemoryMiB(vm *virtv1.VirtualMachine) int64 {
+	domain := vm.Spec.Template.Spec.Domain
+	if domain.Memory != nil && domain.Memory.Guest != nil {
+		return domain.Memory.Guest.Value() / (1024 * 1024)
+	}
+	if memory, ok := domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return memory.Value() / (1024 * 1024)
+	}
+	return 0
+}
+
+// newOvfDescriptor describes the virtual machine and its disks, along with the files of the OVA containing them
+func newOvfDescriptor(vm *virtv1.VirtualMachine, disks []*ovaDisk) ([]byte, error) {
+	envelope := ovfEnvelope{
+		Namespace:     ovfEnvelopeNamespace,
+		OvfNamespace:  ovfEnvelopeNamespace,
+		RasdNamespace: ovfRasdNamespace,
+		DiskSection:   ovfDiskSection{Info: "Virtual disk information"},
+		VirtualSystem: ovfVirtualSystem{
+			ID:   vm.Name,
+			Info: "A virtual machine exported from KubeVirt",
+			Name: vm.Name,
+			VirtualHardware: ovfVirtualHardwareSection{
+				Info: "Virtual hardware requirements",
+				Items: []ovfItem{{
+					ElementName:     fmt.Sprintf("%d virtual CPU(s)", vmCPUs(vm)),
+					InstanceID:      1,
+					ResourceType:    ovfResourceTypeProcessor,
+					VirtualQuantity: vmCPUs(vm),
+				}, {
+					AllocationUnits: "byte * 2^20",
+					ElementName:     fmt.Sprintf("%dMB of memory", vmMemoryMiB(vm)),
+					InstanceID:      2,
+					ResourceType:    ovfResourceTypeMemory,
+					VirtualQuantity: vmMemoryMiB(vm),
+				}},
+			},
+		},
+	}
+
+	for i, disk := range disks {
+		fileID := fmt.Sprintf("file%d", i+1)
+		diskID := fmt.Sprintf("disk%d", i+1)
+		envelope.Files = append(envelope.Files, ovfFile{
+			ID:   fileID,
+			Href: disk.fileName(),
+			Size: disk.image.Size(),
+		})
+		envelope.DiskSection.Disks = append(envelope.DiskSection.Disks, ovfDisk{
+			DiskID:                  diskID,
+			FileRef:                 fileID,
+			Capacity:                disk.image.virtualSize,
+			CapacityAllocationUnits: "byte",
+			Format:                  ovfVmdkFormat,
+		})
+		envelope.VirtualSystem.VirtualHardware.Items = append(envelope.VirtualSystem.VirtualHardware.Items, ovfItem{
+			ElementName:  disk.name,
+			HostResource: "ovf:/disk/" + diskID,
+			InstanceID:   3 + i,
+			ResourceType: ovfResourceTypeDisk,
+		})
+	}
+
+	descriptor, err := xml.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), descriptor...), nil
+}
+
+// writeOva writes a tar archive of the OVF descriptor followed by the disks in the streamOptimized VMDK format
+func writeOva(w io.Writer, vm *virtv1.VirtualMachine, disks []*ovaDisk) error {
+	descriptor, err := newOvfDescriptor(vm, disks)
+	if err != nil {
+		return err
+	}
+
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(&tar.Header{Name: vm.Name + ".ovf", Mode: 0644, Size: int64(len(descriptor))}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(descriptor); err != nil {
+		return err
+	}
+	for _, disk := range disks {
+		if err := tw.WriteHeader(&tar.Header{Name: disk.fileName(), Mode: 0644, Size: disk.image.Size()}); err != nil {
+			return err
+		}
+		n, err := disk.image.WriteTo(tw)
+		if err != nil {
+			return err
+		}
+		log.Log.Infof("Wrote %d bytes of disk %s", n, disk.name)
+	}
+	return tw.Close()
+}
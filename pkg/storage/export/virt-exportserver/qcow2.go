@@ -0,0 +1,276 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+)
+
+// The qcow2 image is streamed without scratch space: its layout is computed ahead from the clusters of the raw image
+// holding data, and the metadata is written ahead of the clusters, which are stored uncompressed in the order of the
+// raw image. Compressed clusters would need the whole image to be compressed before writing the metadata, so the
+// clusters are left uncompressed to keep the size of the image known before streaming it.
+const (
+	qcow2Magic        = 0x514649fb
+	qcow2Version      = 3
+	qcow2HeaderLength = 104
+	// refcount entries are 16 bits wide
+	qcow2RefcountOrder = 4
+
+	qcow2ClusterBits = 16
+	qcow2ClusterSize = 1 << qcow2ClusterBits
+	// number of 8 bytes entries in a L2 table, or in any other table filling a cluster
+	qcow2TableEntries = qcow2ClusterSize / 8
+	// number of 16 bits entries in a refcount block
+	qcow2RefcountBlockEntries = qcow2ClusterSize / 2
+
+	qcow2OflagCopied = uint64(1) << 63
+)
+
+// qcow2Image converts a raw image into a sparse qcow2 image. Clusters of the raw image which are not allocated or
+// only hold zeros are left unallocated.
+type qcow2Image struct {
+	source      io.ReaderAt
+	virtualSize int64
+	allocated   []bool
+
+	l1Size                int
+	l1Clusters            int64
+	refcountTableClusters int64
+	refcountBlocks        int64
+	// l2Tables maps the index of a L1 entry to the index of its L2 table, or -1 if it has none
+	l2Tables     []int64
+	l2Count      int64
+	dataClusters int64
+	dataStart    int64
+	size         int64
+}
+
+// newQcow2Image computes the layout of the qcow2 image from the clusters of the raw image holding data
+func newQcow2Image(source *os.File, virtualSize int64) (*qcow2Image, error) {
+	allocated, err := allocatedBlocks(source, virtualSize, qcow2ClusterSize)
+	if err != nil {
+		return nil, err
+	}
+	if err := skipZeroClusters(source, virtualSize, allocated); err != nil {
+		return nil, err
+	}
+	image := &qcow2Image{
+		source:      source,
+		virtualSize: virtualSize,
+		allocated:   allocated,
+	}
+	image.computeLayout()
+	return image, nil
+}
+
+// skipZeroClusters marks the allocated clusters only holding zeros as unallocated. The allocated clusters are read
+// before streaming the image and once more while streaming it, holes are never read.
+func skipZeroClusters(source io.ReaderAt, virtualSize int64, allocated []bool) error {
+	cluster := make([]byte, qcow2ClusterSize)
+	for i := range allocated {
+		if !allocated[i] {
+			continue
+		}
+		if err := readBlock(source, virtualSize, int64(i), cluster); err != nil {
+			return err
+		}
+		allocated[i] = !isZero(cluster)
+	}
+	return nil
+}
+
+func clustersFor(size int64) int64 {
+	return (size + qcow2ClusterSize - 1) / qcow2ClusterSize
+}
+
+func (q *qcow2Image) computeLayout() {
+	q.l1Size = int((int64(len(q.allocated)) + qcow2TableEntries - 1) / qcow2TableEntries)
+	q.l1Clusters = clustersFor(int64(q.l1Size) * 8)
+
+	q.l2Tables = make([]int64, q.l1Size)
+	for i := range q.l2Tables {
+		q.l2Tables[i] = -1
+	}
+	for i, allocated := range q.allocated {
+		if !allocated {
+			continue
+		}
+		if l1Index := i / qcow2TableEntries; q.l2Tables[l1Index] < 0 {
+			q.l2Tables[l1Index] = q.l2Count
+			q.l2Count++
+		}
+		q.dataClusters++
+	}
+
+	// the refcount blocks have to cover every cluster of the image, including themselves
+	for {
+		clusters := 1 + q.l1Clusters + q.refcountTableClusters + q.refcountBlocks + q.l2Count + q.dataClusters
+		refcountBlocks := (clusters + qcow2RefcountBlockEntries - 1) / qcow2RefcountBlockEntries
+		refcountTableClusters := clustersFor(refcountBlocks * 8)
+		if refcountBlocks == q.refcountBlocks && refcountTableClusters == q.refcountTableClusters {
+			q.size = clusters * qcow2ClusterSize
+			break
+		}
+		q.refcountBlocks, q.refcountTableClusters = refcountBlocks, refcountTableClusters
+	}
+	q.dataStart = (1 + q.l1Clusters + q.refcountTableClusters + q.refcountBlocks + q.l2Count) * qcow2ClusterSize
+}
+
+func (q *qcow2Image) l1TableOffset() int64 {
+	return qcow2ClusterSize
+}
+
+func (q *qcow2Image) refcountTableOffset() int64 {
+	return q.l1TableOffset() + q.l1Clusters*qcow2ClusterSize
+}
+
+func (q *qcow2Image) refcountBlocksOffset() int64 {
+	return q.refcountTableOffset() + q.refcountTableClusters*qcow2ClusterSize
+}
+
+func (q *qcow2Image) l2TablesOffset() int64 {
+	return q.refcountBlocksOffset() + q.refcountBlocks*qcow2ClusterSize
+}
+
+// Size returns the size of the qcow2 image
+func (q *qcow2Image) Size() int64 {
+	return q.size
+}
+
+// WriteTo writes the qcow2 image, reading the clusters of the raw image holding data
+func (q *qcow2Image) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{writer: w}
+	for _, write := range []func(io.Writer) error{q.writeHeader, q.writeL1Table, q.writeRefcountTable, q.writeRefcountBlocks, q.writeL2Tables, q.writeData} {
+		if err := write(cw); err != nil {
+			return cw.written, err
+		}
+	}
+	return cw.written, nil
+}
+
+type countingWriter struct {
+	writer  io.Writer
+	written int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.writer.Write(p)
+	c.written += int64(n)
+	return n, err
+}
+
+// writeTable writes big endian entries, padded to full clusters
+func writeTable[T uint16 | uint64](w io.Writer, entries []T, clusters int64) error {
+	table := make([]byte, clusters*qcow2ClusterSize)
+	for i, entry := range entries {
+		switch e := any(entry).(type) {
+		case uint16:
+			binary.BigEndian.PutUint16(table[i*2:], e)
+		case uint64:
+			binary.BigEndian.PutUint64(table[i*8:], e)
+		}
+	}
+	_, err := w.Write(table)
+	return err
+}
+
+func (q *qcow2Image) writeHeader(w io.Writer) error {
+	header := make([]byte, qcow2ClusterSize)
+	binary.BigEndian.PutUint32(header[0:], qcow2Magic)
+	binary.BigEndian.PutUint32(header[4:], qcow2Version)
+	binary.BigEndian.PutUint32(header[20:], qcow2ClusterBits)
+	binary.BigEndian.PutUint64(header[24:], uint64(q.virtualSize))
+	binary.BigEndian.PutUint32(header[36:], uint32(q.l1Size))
+	binary.BigEndian.PutUint64(header[40:], uint64(q.l1TableOffset()))
+	binary.BigEndian.PutUint64(header[48:], uint64(q.refcountTableOffset()))
+	binary.BigEndian.PutUint32(header[56:], uint32(q.refcountTableClusters))
+	binary.BigEndian.PutUint32(header[96:], qcow2RefcountOrder)
+	binary.BigEndian.PutUint32(header[100:], qcow2HeaderLength)
+	// the header is followed by the end of the header extensions, which is all zeros
+	_, err := w.Write(header)
+	return err
+}
+
+func (q *qcow2Image) writeL1Table(w io.Writer) error {
+	entries := make([]uint64, q.l1Size)
+	for i, l2Index := range q.l2Tables {
+		if l2Index >= 0 {
+			entries[i] = uint64(q.l2TablesOffset()+l2Index*qcow2ClusterSize) | qcow2OflagCopied
+		}
+	}
+	return writeTable(w, entries, q.l1Clusters)
+}
+
+func (q *qcow2Image) writeRefcountTable(w io.Writer) error {
+	entries := make([]uint64, q.refcountBlocks)
+	for i := range entries {
+		entries[i] = uint64(q.refcountBlocksOffset() + int64(i)*qcow2ClusterSize)
+	}
+	return writeTable(w, entries, q.refcountTableClusters)
+}
+
+// writeRefcountBlocks writes the refcount blocks, every cluster of the image being used once
+func (q *qcow2Image) writeRefcountBlocks(w io.Writer) error {
+	refcounts := make([]uint16, q.size/qcow2ClusterSize)
+	for i := range refcounts {
+		refcounts[i] = 1
+	}
+	return writeTable(w, refcounts, q.refcountBlocks)
+}
+
+// writeL2Tables writes the L2 tables, the allocated clusters being stored one after the other
+func (q *qcow2Image) writeL2Tables(w io.Writer) error {
+	offset := q.dataStart
+	for l1Index, l2Index := range q.l2Tables {
+		if l2Index < 0 {
+			continue
+		}
+		entries := make([]uint64, qcow2TableEntries)
+		for i := range entries {
+			if index := l1Index*qcow2TableEntries + i; index < len(q.allocated) && q.allocated[index] {
+				entries[i] = uint64(offset) | qcow2OflagCopied
+				offset += qcow2ClusterSize
+			}
+		}
+		if err := writeTable(w, entries, 1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (q *qcow2Image) writeData(w io.Writer) error {
+	cluster := make([]byte, qcow2ClusterSize)
+	for i, allocated := range q.allocated {
+		if !allocated {
+			continue
+		}
+		if err := readBlock(q.source, q.virtualSize, int64(i), cluster); err != nil {
+			return err
+		}
+		if _, err := w.Write(cluster); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readQcow2L2Entry returns the L2 entry of a cluster of a qcow2 image written by qcow2Image
+func readQcow2L2Entry(image []byte, index int64) uint64 {
+	l1Offset := binary.BigEndian.Uint64(image[40:])
+	l1Entry := binary.BigEndian.Uint64(image[l1Offset+uint64(index/qcow2TableEntries)*8:])
+	if l1Entry == 0 {
+		return 0
+	}
+	ExpectWithOffset(2, l1Entry&qcow2OflagCopied).ToNot(BeZero())
+	l2Offset := l1Entry &^ qcow2OflagCopied
+	return binary.BigEndian.Uint64(image[l2Offset+uint64(index%qcow2TableEntries)*8:])
+}
+
+// readQcow2Cluster returns the guest data of a cluster of a qcow2 image written by qcow2Image
+func readQcow2Cluster(image []byte, index int64) []byte {
+	l2Entry := readQcow2L2Entry(image, index)
+	if l2Entry == 0 {
+		return make([]byte, qcow2ClusterSize)
+	}
+	ExpectWithOffset(1, l2Entry&qcow2OflagCopied).ToNot(BeZero())
+	offset := l2Entry &^ qcow2OflagCopied
+	return image[offset : offset+qcow2ClusterSize]
+}
+
+// readQcow2Refcount returns the refcount of a host cluster of a qcow2 image
+func readQcow2Refcount(image []byte, cluster int64) uint16 {
+	tableOffset := binary.BigEndian.Uint64(image[48:])
+	blockOffset := binary.BigEndian.Uint64(image[tableOffset+uint64(cluster/qcow2RefcountBlockEntries)*8:])
+	return binary.BigEndian.Uint16(image[blockOffset+uint64(cluster%qcow2RefcountBlockEntries)*2:])
+}
+
+var _ = Describe("qcow2 image", func() {
+	var raw *os.File
+
+	BeforeEach(func() {
+		var err error
+		raw, err = os.Create(filepath.Join(GinkgoT().TempDir(), "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(raw.Close)
+	})
+
+	writeAt := func(data []byte, offset int64) {
+		_, err := raw.WriteAt(data, offset)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	}
+
+	convert := func() []byte {
+		size, err := raw.Seek(0, io.SeekEnd)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		image, err := newQcow2Image(raw, size)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		out := &bytes.Buffer{}
+		n, err := image.WriteTo(out)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, n).To(Equal(image.Size()))
+		ExpectWithOffset(1, out.Len()%qcow2ClusterSize).To(BeZero())
+		return out.Bytes()
+	}
+
+	expectGuestData := func(image []byte, clusters ...int64) {
+		expected := make([]byte, qcow2ClusterSize)
+		for _, cluster := range clusters {
+			n, err := raw.ReadAt(expected, cluster*qcow2ClusterSize)
+			if err != nil {
+				ExpectWithOffset(1, err).To(MatchError(io.EOF))
+			}
+			clear(expected[n:])
+			ExpectWithOffset(1, readQcow2Cluster(image, cluster)).To(Equal(expected), "cluster %d", cluster)
+		}
+	}
+
+	It("should write the header", func() {
+		writeAt([]byte("data"), 3*qcow2ClusterSize)
+		image := convert()
+
+		Expect(binary.BigEndian.Uint32(image[0:])).To(BeEquivalentTo(qcow2Magic))
+		Expect(binary.BigEndian.Uint32(image[4:])).To(BeEquivalentTo(qcow2Version))
+		Expect(binary.BigEndian.Uint32(image[20:])).To(BeEquivalentTo(qcow2ClusterBits))
+		Expect(binary.BigEndian.Uint64(image[24:])).To(BeEquivalentTo(3*qcow2ClusterSize + 4))
+		Expect(binary.BigEndian.Uint32(image[36:])).To(BeEquivalentTo(1))
+		Expect(binary.BigEndian.Uint32(image[96:])).To(BeEquivalentTo(qcow2RefcountOrder))
+		Expect(binary.BigEndian.Uint32(image[100:])).To(BeEquivalentTo(qcow2HeaderLength))
+	})
+
+	It("should store the allocated clusters and leave the holes unallocated", func() {
+		random := make([]byte, 2*qcow2ClusterSize)
+		rand.New(rand.NewSource(0)).Read(random)
+		writeAt(bytes.Repeat([]byte("data"), qcow2ClusterSize/4), qcow2ClusterSize)
+		writeAt(random, 5*qcow2ClusterSize+100)
+		writeAt([]byte("partial cluster"), 9*qcow2ClusterSize)
+
+		image := convert()
+		expectGuestData(image, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+		for _, cluster := range []int64{0, 2, 3, 4, 8} {
+			Expect(readQcow2L2Entry(image, cluster)).To(BeZero(), "cluster %d", cluster)
+		}
+		Expect(image).To(HaveLen(int(qcow2ClusterSize * (1 + 1 + 1 + 1 + 1 + 5))))
+	})
+
+	It("should leave the clusters only holding zeros unallocated", func() {
+		writeAt(make([]byte, 3*qcow2ClusterSize), 0)
+		writeAt([]byte("data"), qcow2ClusterSize+10)
+
+		image := convert()
+		expectGuestData(image, 0, 1, 2)
+		Expect(readQcow2L2Entry(image, 0)).To(BeZero())
+		Expect(readQcow2L2Entry(image, 1)).ToNot(BeZero())
+		Expect(readQcow2L2Entry(image, 2)).To(BeZero())
+		Expect(image).To(HaveLen(int(qcow2ClusterSize * (1 + 1 + 1 + 1 + 1 + 1))))
+	})
+
+	It("should convert images needing several L2 tables", func() {
+		Expect(raw.Truncate(3 * qcow2TableEntries * qcow2ClusterSize)).To(Succeed())
+		writeAt([]byte("first"), 0)
+		writeAt([]byte("middle"), (2*qcow2TableEntries+7)*qcow2ClusterSize+10)
+
+		image := convert()
+		Expect(binary.BigEndian.Uint32(image[36:])).To(BeEquivalentTo(3))
+		Expect(binary.BigEndian.Uint64(image[binary.BigEndian.Uint64(image[40:])+8:])).To(BeZero())
+		expectGuestData(image, 0, qcow2TableEntries, 2*qcow2TableEntries+7)
+	})
+
+	It("should reference count every used host cluster", func() {
+		for i := int64(0); i < 20; i++ {
+			writeAt(bytes.Repeat([]byte{byte(i + 1)}, qcow2ClusterSize/2), i*qcow2ClusterSize)
+		}
+
+		image := convert()
+		for cluster := int64(0); cluster < int64(len(image))/qcow2ClusterSize; cluster++ {
+			Expect(readQcow2Refcount(image, cluster)).To(BeEquivalentTo(1), "cluster %d", cluster)
+		}
+	})
+})
@@ -0,0 +1,288 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/adler32"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// The streamOptimized VMDK image is streamed reading the raw image once. Its grains are stored in zlib streams made
+// of uncompressed deflate blocks, so that the size of the image is known ahead from the allocated grains of the raw
+// image, as required by the archives embedding it. Every grain table follows its grains, and the grain directory
+// is written at the end of the image.
+const (
+	vmdkMagic              = 0x564d444b
+	vmdkVersion            = 3
+	vmdkFlagNewlineTest    = 1 << 0
+	vmdkFlagCompressed     = 1 << 16
+	vmdkFlagMarkers        = 1 << 17
+	vmdkCompressionDeflate = 1
+	// the grain directory is found through the footer
+	vmdkGDAtEnd = ^uint64(0)
+
+	vmdkSectorSize        = 512
+	vmdkGrainSectors      = 128
+	vmdkGrainSize         = vmdkGrainSectors * vmdkSectorSize
+	vmdkGrainTableEntries = 512
+	vmdkGrainTableSectors = vmdkGrainTableEntries * 4 / vmdkSectorSize
+
+	vmdkMarkerEndOfStream    = 0
+	vmdkMarkerGrainTable     = 1
+	vmdkMarkerGrainDirectory = 2
+	vmdkMarkerFooter         = 3
+	// grain markers hold the first sector of the grain and the length of its data
+	vmdkGrainMarkerSize = 12
+
+	// zlib streams start with a header declaring deflate with a 32KiB window and end with an adler32 checksum,
+	// uncompressed deflate blocks start with a 5 bytes header
+	zlibHeaderSize        = 2
+	zlibChecksumSize      = 4
+	deflateStoredHeader   = 5
+	deflateStoredMaxBlock = 65535
+
+	vmdkGrainDataSize = zlibHeaderSize + (vmdkGrainSize+deflateStoredMaxBlock-1)/deflateStoredMaxBlock*deflateStoredHeader + vmdkGrainSize + zlibChecksumSize
+	// sectors used by a grain marker followed by the data of the grain
+	vmdkGrainRecordSectors = (vmdkGrainMarkerSize + vmdkGrainDataSize + vmdkSectorSize - 1) / vmdkSectorSize
+)
+
+// vmdkImage converts a raw image into a streamOptimized VMDK image. Grains of the raw image which are not allocated
+// are left unallocated.
+type vmdkImage struct {
+	source      io.ReaderAt
+	virtualSize int64
+	allocated   []bool
+
+	descriptor []byte
+	// sectors before the first grain, holding the header and the descriptor
+	overhead    int64
+	grainTables int64
+	size        int64
+}
+
+// newVmdkImage computes the layout of the VMDK image from the allocated grains of the raw image
+func newVmdkImage(source *os.File, virtualSize int64, fileName string) (*vmdkImage, error) {
+	allocated, err := allocatedBlocks(source, virtualSize, vmdkGrainSize)
+	if err != nil {
+		return nil, err
+	}
+	image := &vmdkImage{
+		source:      source,
+		virtualSize: virtualSize,
+		allocated:   allocated,
+		grainTables: (int64(len(allocated)) + vmdkGrainTableEntries - 1) / vmdkGrainTableEntries,
+	}
+	image.descriptor = image.newDescriptor(fileName)
+	image.computeLayout()
+	return image, nil
+}
+
+func sectorsFor(size int64) int64 {
+	return (size + vmdkSectorSize - 1) / vmdkSectorSize
+}
+
+// capacity returns the virtual size in sectors
+func (v *vmdkImage) capacity() int64 {
+	return sectorsFor(v.virtualSize)
+}
+
+func (v *vmdkImage) newDescriptor(fileName string) []byte {
+	cylinders := min(v.capacity()/(16*63), 16383)
+	return []byte(fmt.Sprintf(`# Disk DescriptorFile
+version=1
+CID=%08x
+parentCID=ffffffff
+createType="streamOptimized"
+
+# Extent description
+RW %d SPARSE "%s"
+
+# The Disk Data Base
+#DDB
+
+ddb.virtualHWVersion = "4"
+ddb.geometry.cylinders = "%d"
+ddb.geometry.heads = "16"
+ddb.geometry.sectors = "63"
+ddb.adapterType = "ide"
+`, crc32.ChecksumIEEE([]byte(fileName)), v.capacity(), fileName, cylinders))
+}
+
+func (v *vmdkImage) grainDirectorySectors() int64 {
+	return sectorsFor(v.grainTables * 4)
+}
+
+func (v *vmdkImage) computeLayout() {
+	// grains are aligned, as done by other implementations
+	v.overhead = (1 + sectorsFor(int64(len(v.descriptor))) + vmdkGrainSectors - 1) / vmdkGrainSectors * vmdkGrainSectors
+
+	sectors := v.overhead
+	for table := int64(0); table < v.grainTables; table++ {
+		if grains := v.allocatedGrains(table); grains > 0 {
+			sectors += grains*vmdkGrainRecordSectors + 1 + vmdkGrainTableSectors
+		}
+	}
+	// grain directory, footer and end of stream
+	sectors += 1 + v.grainDirectorySectors() + 2 + 1
+	v.size = sectors * vmdkSectorSize
+}
+
+// allocatedGrains returns the number of allocated grains in the given grain table
+func (v *vmdkImage) allocatedGrains(table int64) int64 {
+	var grains int64
+	for i := table * vmdkGrainTableEntries; i < min((table+1)*vmdkGrainTableEntries, int64(len(v.allocated))); i++ {
+		if v.allocated[i] {
+			grains++
+		}
+	}
+	return grains
+}
+
+// Size returns the size of the VMDK image
+func (v *vmdkImage) Size() int64 {
+	return v.size
+}
+
+func (v *vmdkImage) header(grainDirectory uint64) []byte {
+	header := make([]byte, vmdkSectorSize)
+	binary.LittleEndian.PutUint32(header[0:], vmdkMagic)
+	binary.LittleEndian.PutUint32(header[4:], vmdkVersion)
+	binary.LittleEndian.PutUint32(header[8:], vmdkFlagNewlineTest|vmdkFlagCompressed|vmdkFlagMarkers)
+	binary.LittleEndian.PutUint64(header[12:], uint64(v.capacity()))
+	binary.LittleEndian.PutUint64(header[20:], vmdkGrainSectors)
+	// the descriptor follows the header
+	binary.LittleEndian.PutUint64(header[28:], 1)
+	binary.LittleEndian.PutUint64(header[36:], uint64(v.overhead-1))
+	binary.LittleEndian.PutUint32(header[44:], vmdkGrainTableEntries)
+	binary.LittleEndian.PutUint64(header[56:], grainDirectory)
+	binary.LittleEndian.PutUint64(header[64:], uint64(v.overhead))
+	copy(header[73:], "\n \r\n")
+	binary.LittleEndian.PutUint16(header[77:], vmdkCompressionDeflate)
+	return header
+}
+
+func metadataMarker(sectors int64, markerType uint32) []byte {
+	marker := make([]byte, vmdkSectorSize)
+	binary.LittleEndian.PutUint64(marker[0:], uint64(sectors))
+	binary.LittleEndian.PutUint32(marker[12:], markerType)
+	return marker
+}
+
+// WriteTo writes the VMDK image, reading the allocated grains of the raw image
+func (v *vmdkImage) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{writer: w}
+	if err := v.write(cw); err != nil {
+		return cw.written, err
+	}
+	return cw.written, nil
+}
+
+func (v *vmdkImage) write(w *countingWriter) error {
+	sector := func() int64 {
+		return w.written / vmdkSectorSize
+	}
+	write := func(data []byte, sectors int64) error {
+		padded := make([]byte, sectors*vmdkSectorSize)
+		copy(padded, data)
+		_, err := w.Write(padded)
+		return err
+	}
+
+	if err := write(v.header(vmdkGDAtEnd), 1); err != nil {
+		return err
+	}
+	if err := write(v.descriptor, v.overhead-1); err != nil {
+		return err
+	}
+
+	grain := make([]byte, vmdkGrainSize)
+	record := make([]byte, vmdkGrainRecordSectors*vmdkSectorSize)
+	grainDirectory := make([]byte, v.grainDirectorySectors()*vmdkSectorSize)
+	for table := int64(0); table < v.grainTables; table++ {
+		if v.allocatedGrains(table) == 0 {
+			continue
+		}
+		grainTable := make([]byte, vmdkGrainTableSectors*vmdkSectorSize)
+		for entry := int64(0); entry < vmdkGrainTableEntries; entry++ {
+			index := table*vmdkGrainTableEntries + entry
+			if index >= int64(len(v.allocated)) || !v.allocated[index] {
+				continue
+			}
+			if err := readBlock(v.source, v.virtualSize, index, grain); err != nil {
+				return err
+			}
+			binary.LittleEndian.PutUint32(grainTable[entry*4:], uint32(sector()))
+			encodeGrainRecord(record, uint64(index*vmdkGrainSectors), grain)
+			if _, err := w.Write(record); err != nil {
+				return err
+			}
+		}
+		if err := write(metadataMarker(vmdkGrainTableSectors, vmdkMarkerGrainTable), 1); err != nil {
+			return err
+		}
+		binary.LittleEndian.PutUint32(grainDirectory[table*4:], uint32(sector()))
+		if err := write(grainTable, vmdkGrainTableSectors); err != nil {
+			return err
+		}
+	}
+
+	if err := write(metadataMarker(v.grainDirectorySectors(), vmdkMarkerGrainDirectory), 1); err != nil {
+		return err
+	}
+	grainDirectoryOffset := sector()
+	if err := write(grainDirectory, v.grainDirectorySectors()); err != nil {
+		return err
+	}
+	if err := write(metadataMarker(1, vmdkMarkerFooter), 1); err != nil {
+		return err
+	}
+	if err := write(v.header(uint64(grainDirectoryOffset)), 1); err != nil {
+		return err
+	}
+	return write(metadataMarker(0, vmdkMarkerEndOfStream), 1)
+}
+
+// encodeGrainRecord fills record with the marker of a grain followed by its data, as a zlib stream of
+// uncompressed deflate blocks
+func encodeGrainRecord(record []byte, lba uint64, grain []byte) {
+	clear(record)
+	binary.LittleEndian.PutUint64(record[0:], lba)
+	binary.LittleEndian.PutUint32(record[8:], vmdkGrainDataSize)
+
+	data := record[vmdkGrainMarkerSize:]
+	data[0], data[1] = 0x78, 0x01
+	offset := zlibHeaderSize
+	for start := 0; start < len(grain); start += deflateStoredMaxBlock {
+		end := min(start+deflateStoredMaxBlock, len(grain))
+		if end == len(grain) {
+			// final block
+			data[offset] = 1
+		}
+		binary.LittleEndian.PutUint16(data[offset+1:], uint16(end-start))
+		binary.LittleEndian.PutUint16(data[offset+3:], ^uint16(end-start))
+		offset += deflateStoredHeader
+		offset += copy(data[offset:], grain[start:end])
+	}
+	binary.BigEndian.PutUint32(data[offset:], adler32.Checksum(grain))
+}
@@ -0,0 +1,125 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readVmdkGrain returns the guest data of a grain of a streamOptimized VMDK image written by vmdkImage, or nil if
+// the grain is not allocated
+func readVmdkGrain(image []byte, index int64) []byte {
+	footer := image[len(image)-2*vmdkSectorSize:]
+	ExpectWithOffset(1, binary.LittleEndian.Uint32(footer[0:])).To(BeEquivalentTo(vmdkMagic))
+	grainDirectory := int64(binary.LittleEndian.Uint64(footer[56:])) * vmdkSectorSize
+	grainTable := int64(binary.LittleEndian.Uint32(image[grainDirectory+index/vmdkGrainTableEntries*4:])) * vmdkSectorSize
+	if grainTable == 0 {
+		return nil
+	}
+	grain := int64(binary.LittleEndian.Uint32(image[grainTable+index%vmdkGrainTableEntries*4:])) * vmdkSectorSize
+	if grain == 0 {
+		return nil
+	}
+	ExpectWithOffset(1, binary.LittleEndian.Uint64(image[grain:])).To(BeEquivalentTo(index * vmdkGrainSectors))
+	size := int64(binary.LittleEndian.Uint32(image[grain+8:]))
+	reader, err := zlib.NewReader(bytes.NewReader(image[grain+vmdkGrainMarkerSize : grain+vmdkGrainMarkerSize+size]))
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	data, err := io.ReadAll(reader)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return data
+}
+
+var _ = Describe("VMDK image", func() {
+	var raw *os.File
+
+	BeforeEach(func() {
+		var err error
+		raw, err = os.Create(filepath.Join(GinkgoT().TempDir(), "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(raw.Close)
+	})
+
+	writeAt := func(data []byte, offset int64) {
+		_, err := raw.WriteAt(data, offset)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	}
+
+	convert := func() []byte {
+		size, err := raw.Seek(0, io.SeekEnd)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		image, err := newVmdkImage(raw, size, "disk.vmdk")
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		out := &bytes.Buffer{}
+		n, err := image.WriteTo(out)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, n).To(Equal(image.Size()))
+		return out.Bytes()
+	}
+
+	It("should write the header, the descriptor and the footer", func() {
+		writeAt([]byte("data"), 3*vmdkGrainSize)
+		image := convert()
+
+		Expect(binary.LittleEndian.Uint32(image[0:])).To(BeEquivalentTo(vmdkMagic))
+		Expect(binary.LittleEndian.Uint32(image[4:])).To(BeEquivalentTo(vmdkVersion))
+		Expect(binary.LittleEndian.Uint32(image[8:])).To(BeEquivalentTo(vmdkFlagNewlineTest | vmdkFlagCompressed | vmdkFlagMarkers))
+		Expect(binary.LittleEndian.Uint64(image[12:])).To(BeEquivalentTo(3*vmdkGrainSectors + 1))
+		Expect(binary.LittleEndian.Uint64(image[20:])).To(BeEquivalentTo(vmdkGrainSectors))
+		Expect(binary.LittleEndian.Uint64(image[56:])).To(Equal(vmdkGDAtEnd))
+		Expect(binary.LittleEndian.Uint16(image[77:])).To(BeEquivalentTo(vmdkCompressionDeflate))
+		Expect(string(image[vmdkSectorSize:])).To(HavePrefix("# Disk DescriptorFile\n"))
+		Expect(string(image[vmdkSectorSize:])).To(ContainSubstring("createType=\"streamOptimized\"\n"))
+		Expect(string(image[vmdkSectorSize:])).To(ContainSubstring("RW %d SPARSE \"disk.vmdk\"\n", 3*vmdkGrainSectors+1))
+
+		footerMarker := image[len(image)-3*vmdkSectorSize:]
+		Expect(binary.LittleEndian.Uint32(footerMarker[12:])).To(BeEquivalentTo(vmdkMarkerFooter))
+		Expect(image[len(image)-vmdkSectorSize:]).To(Equal(make([]byte, vmdkSectorSize)))
+	})
+
+	It("should store the allocated grains and leave the holes unallocated", func() {
+		random := make([]byte, 2*vmdkGrainSize)
+		rand.New(rand.NewSource(0)).Read(random)
+		writeAt(random, vmdkGrainSize+100)
+		writeAt([]byte("partial grain"), (vmdkGrainTableEntries+2)*vmdkGrainSize)
+
+		image := convert()
+		expected := make([]byte, vmdkGrainSize)
+		for _, grain := range []int64{1, 2, 3, vmdkGrainTableEntries + 2} {
+			n, err := raw.ReadAt(expected, grain*vmdkGrainSize)
+			if err != nil {
+				Expect(err).To(MatchError(io.EOF))
+			}
+			clear(expected[n:])
+			Expect(readVmdkGrain(image, grain)).To(Equal(expected), "grain %d", grain)
+		}
+		for _, grain := range []int64{0, 4, vmdkGrainTableEntries} {
+			Expect(readVmdkGrain(image, grain)).To(BeNil(), "grain %d", grain)
+		}
+	})
+})
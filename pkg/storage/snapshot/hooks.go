@@ -0,0 +1,316 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	snapshotHookFailedEvent = "SnapshotHookFailed"
+
+	defaultSnapshotHookTimeout = 30 * time.Second
+
+	// MaxSnapshotHookTimeout is the longest time a snapshot hook is allowed to run
+	MaxSnapshotHookTimeout = 5 * time.Minute
+
+	// only the end of the hook output is kept in the status
+	maxSnapshotHookOutputLength = 1024
+
+	snapshotHookLostMessage = "the controller restarted while the hook was running"
+)
+
+// snapshotHookRun is a hook running in the background for a snapshot content,
+// only one hook of a content runs at a time
+type snapshotHookRun struct {
+	stage  snapshotv1.SnapshotHookStage
+	name   string
+	done   chan struct{}
+	result snapshotv1.SnapshotHookResult
+}
+
+func (r *snapshotHookRun) completed() bool {
+	select {
+	case <-r.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func snapshotHooks(vmSnapshot *snapshotv1.VirtualMachineSnapshot, stage snapshotv1.SnapshotHookStage) []snapshotv1.SnapshotHook {
+	if vmSnapshot == nil || vmSnapshot.Spec.Hooks == nil {
+		return nil
+	}
+
+	switch stage {
+	case snapshotv1.SnapshotHookStagePreFreeze:
+		return vmSnapshot.Spec.Hooks.PreFreeze
+	case snapshotv1.SnapshotHookStagePostThaw:
+		return vmSnapshot.Spec.Hooks.PostThaw
+	}
+	return nil
+}
+
+func snapshotHookTimeout(hook *snapshotv1.SnapshotHook) time.Duration {
+	if hook.Timeout != nil {
+		return min(hook.Timeout.Duration, MaxSnapshotHookTimeout)
+	}
+	return defaultSnapshotHookTimeout
+}
+
+func snapshotHookAborts(hook *snapshotv1.SnapshotHook) bool {
+	return hook.FailurePolicy == nil || *hook.FailurePolicy == snapshotv1.SnapshotHookFailurePolicyAbort
+}
+
+func findSnapshotHookResult(results []snapshotv1.SnapshotHookResult, stage snapshotv1.SnapshotHookStage, name string) *snapshotv1.SnapshotHookResult {
+	for i := range results {
+		if results[i].Stage == stage && results[i].Name == name {
+			return &results[i]
+		}
+	}
+	return nil
+}
+
+// snapshotHookStageError returns the error of the first failed hook of the stage which aborts the snapshot
+func snapshotHookStageError(vmSnapshot *snapshotv1.VirtualMachineSnapshot, results []snapshotv1.SnapshotHookResult, stage snapshotv1.SnapshotHookStage) error {
+	for _, hook := range snapshotHooks(vmSnapshot, stage) {
+		result := findSnapshotHookResult(results, stage, hook.Name)
+		if result == nil || result.Phase != snapshotv1.SnapshotHookFailed || !snapshotHookAborts(&hook) {
+			continue
+		}
+		message := ""
+		if result.Message != nil {
+			message = *result.Message
+		}
+		return fmt.Errorf("%s hook %s failed: %s", stage, hook.Name, message)
+	}
+	return nil
+}
+
+// snapshotHookError returns the error of the first failed hook which aborts the snapshot
+func snapshotHookError(vmSnapshot *snapshotv1.VirtualMachineSnapshot, results []snapshotv1.SnapshotHookResult) error {
+	if err := snapshotHookStageError(vmSnapshot, results, snapshotv1.SnapshotHookStagePreFreeze); err != nil {
+		return err
+	}
+	return snapshotHookStageError(vmSnapshot, results, snapshotv1.SnapshotHookStagePostThaw)
+}
+
+// snapshotHooksStarted returns true if a hook of the stage was started
+func snapshotHooksStarted(results []snapshotv1.SnapshotHookResult, stage snapshotv1.SnapshotHookStage) bool {
+	for _, result := range results {
+		if result.Stage == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// runSnapshotHooks advances the hooks of the stage by one step: it records the result of the hook running
+// in the background or starts the next hook without a result.
+// The returned bool is true as long as the stage is not finished, the results have then to be persisted
+// before calling it again so that every hook result is recorded before the next hook is started.
+// Pre freeze hooks stop at the first failed hook which aborts the snapshot, post thaw hooks are all run
+// since they usually undo what the pre freeze hooks did.
+func (ctrl *VMSnapshotController) runSnapshotHooks(content *snapshotv1.VirtualMachineSnapshotContent, vmSnapshot *snapshotv1.VirtualMachineSnapshot, source snapshotSource, stage snapshotv1.SnapshotHookStage, results []snapshotv1.SnapshotHookResult) ([]snapshotv1.SnapshotHookResult, bool, error) {
+	hooks := snapshotHooks(vmSnapshot, stage)
+	if len(hooks) == 0 {
+		return results, false, nil
+	}
+
+	for i := range hooks {
+		hook := &hooks[i]
+		result := findSnapshotHookResult(results, stage, hook.Name)
+
+		switch {
+		case result == nil:
+			online, err := source.Online()
+			if err != nil || !online {
+				return results, false, err
+			}
+
+			ctrl.startSnapshotHook(content, vmSnapshot, source, stage, hook)
+			return append(results, snapshotv1.SnapshotHookResult{
+				Name:      hook.Name,
+				Stage:     stage,
+				Phase:     snapshotv1.SnapshotHookRunning,
+				StartTime: currentTime(),
+			}), true, nil
+		case result.Phase == snapshotv1.SnapshotHookRunning:
+			*result = ctrl.collectSnapshotHook(content, vmSnapshot, *result)
+			return results, true, nil
+		case result.Phase == snapshotv1.SnapshotHookFailed && snapshotHookAborts(hook) && stage == snapshotv1.SnapshotHookStagePreFreeze:
+			return results, false, nil
+		}
+	}
+
+	return results, false, nil
+}
+
+// runPostThawHooks runs the post thaw hooks once the pre freeze hooks were started,
+// including when one of them aborted the snapshot
+func (ctrl *VMSnapshotController) runPostThawHooks(content *snapshotv1.VirtualMachineSnapshotContent, vmSnapshot *snapshotv1.VirtualMachineSnapshot, results []snapshotv1.SnapshotHookResult) ([]snapshotv1.SnapshotHookResult, bool, error) {
+	if len(snapshotHooks(vmSnapshot, snapshotv1.SnapshotHookStagePostThaw)) == 0 {
+		return results, false, nil
+	}
+
+	if len(snapshotHooks(vmSnapshot, snapshotv1.SnapshotHookStagePreFreeze)) > 0 &&
+		!snapshotHooksStarted(results, snapshotv1.SnapshotHookStagePreFreeze) {
+		return results, false, nil
+	}
+
+	source, err := ctrl.getSnapshotSource(vmSnapshot)
+	if err != nil || source == nil {
+		return results, false, err
+	}
+
+	return ctrl.runSnapshotHooks(content, vmSnapshot, source, snapshotv1.SnapshotHookStagePostThaw, results)
+}
+
+// startSnapshotHook runs the hook in the background and enqueues the content once it completes. The hook is not
+// started again when its running result could not be persisted, it is collected once the result is persisted.
+func (ctrl *VMSnapshotController) startSnapshotHook(content *snapshotv1.VirtualMachineSnapshotContent, vmSnapshot *snapshotv1.VirtualMachineSnapshot, source snapshotSource, stage snapshotv1.SnapshotHookStage, hook *snapshotv1.SnapshotHook) {
+	if obj, exists := ctrl.snapshotHookRuns.Load(content.UID); exists {
+		if run := obj.(*snapshotHookRun); run.stage == stage && run.name == hook.Name {
+			return
+		}
+	}
+
+	run := &snapshotHookRun{
+		stage: stage,
+		name:  hook.Name,
+		done:  make(chan struct{}),
+	}
+	ctrl.snapshotHookRuns.Store(content.UID, run)
+
+	log.Log.V(3).Infof("Running %s hook %s of vmsnapshot %s/%s", stage, hook.Name, vmSnapshot.Namespace, vmSnapshot.Name)
+
+	key := cacheKeyFunc(content.Namespace, content.Name)
+	command := hook.Command
+	timeout := snapshotHookTimeout(hook)
+	go func() {
+		run.result = runSnapshotHook(source, stage, hook.Name, command, timeout)
+		close(run.done)
+		ctrl.vmSnapshotContentQueue.Add(key)
+	}()
+}
+
+// collectSnapshotHook returns the result of the hook running in the background, the running result is
+// returned as long as the hook did not complete
+func (ctrl *VMSnapshotController) collectSnapshotHook(content *snapshotv1.VirtualMachineSnapshotContent, vmSnapshot *snapshotv1.VirtualMachineSnapshot, running snapshotv1.SnapshotHookResult) snapshotv1.SnapshotHookResult {
+	var result snapshotv1.SnapshotHookResult
+
+	obj, exists := ctrl.snapshotHookRuns.Load(content.UID)
+	run, _ := obj.(*snapshotHookRun)
+	switch {
+	case !exists || run.stage != running.Stage || run.name != running.Name:
+		// the hook may have run, it is not started again
+		message := snapshotHookLostMessage
+		result = running
+		result.Phase = snapshotv1.SnapshotHookFailed
+		result.CompletionTime = currentTime()
+		result.Message = &message
+	case !run.completed():
+		return running
+	default:
+		ctrl.snapshotHookRuns.Delete(content.UID)
+		result = run.result
+		result.StartTime = running.StartTime
+	}
+
+	if result.Phase == snapshotv1.SnapshotHookFailed {
+		ctrl.Recorder.Eventf(
+			vmSnapshot,
+			corev1.EventTypeWarning,
+			snapshotHookFailedEvent,
+			"%s hook %s failed: %s",
+			result.Stage,
+			result.Name,
+			*result.Message,
+		)
+	}
+
+	return result
+}
+
+// updateSnapshotHookResults persists the hook results while a stage is not finished,
+// the content is enqueued again once the running hook completes
+func (ctrl *VMSnapshotController) updateSnapshotHookResults(content *snapshotv1.VirtualMachineSnapshotContent, results []snapshotv1.SnapshotHookResult) (time.Duration, error) {
+	contentCpy := content.DeepCopy()
+	if contentCpy.Status == nil {
+		f := false
+		contentCpy.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+			ReadyToUse: &f,
+		}
+	}
+	contentCpy.Status.HookResults = results
+
+	if !equality.Semantic.DeepEqual(content, contentCpy) {
+		if _, err := ctrl.Client.VirtualMachineSnapshotContent(contentCpy.Namespace).Update(context.Background(), contentCpy, metav1.UpdateOptions{}); err != nil {
+			return 0, err
+		}
+	}
+
+	return snapshotRetryInterval, nil
+}
+
+func runSnapshotHook(source snapshotSource, stage snapshotv1.SnapshotHookStage, name string, command []string, timeout time.Duration) snapshotv1.SnapshotHookResult {
+	result := snapshotv1.SnapshotHookResult{
+		Name:  name,
+		Stage: stage,
+	}
+
+	execResult, err := source.Exec(command, timeout)
+	result.CompletionTime = currentTime()
+
+	var message string
+	switch {
+	case err != nil:
+		message = err.Error()
+	case execResult.ExitCode != 0:
+		message = fmt.Sprintf("command exited with code %d", execResult.ExitCode)
+	}
+
+	if err == nil {
+		result.ExitCode = &execResult.ExitCode
+		result.Output = execResult.StdOut
+		if len(result.Output) > maxSnapshotHookOutputLength {
+			result.Output = result.Output[len(result.Output)-maxSnapshotHookOutputLength:]
+		}
+	}
+
+	if message == "" {
+		result.Phase = snapshotv1.SnapshotHookSucceeded
+		return result
+	}
+
+	result.Phase = snapshotv1.SnapshotHookFailed
+	result.Message = &message
+	return result
+}
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -80,6 +81,12 @@ type vmRestoreTarget struct {
 	vm         *kubevirtv1.VirtualMachine
 }
 
+// volumesRestoreTarget restores the volumes as standalone PVCs and leaves the VM untouched
+type volumesRestoreTarget struct {
+	controller *VMRestoreController
+	vmRestore  *snapshotv1.VirtualMachineRestore
+}
+
 var restoreAnnotationsToDelete = []string{
 	"pv.kubernetes.io",
 	"volume.beta.kubernetes.io",
@@ -97,6 +104,21 @@ func restoreDVName(vmRestore *snapshotv1.VirtualMachineRestore, name string) str
 	return restorePVCName(vmRestore, name)
 }
 
+func restoreMode(vmRestore *snapshotv1.VirtualMachineRestore) snapshotv1.VirtualMachineRestoreMode {
+	if vmRestore.Spec.Mode == nil {
+		return snapshotv1.RestoreModeFull
+	}
+	return *vmRestore.Spec.Mode
+}
+
+// volumeSelectedForRestore returns whether the volume of the snapshot is restored
+func volumeSelectedForRestore(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) bool {
+	if restoreMode(vmRestore) == snapshotv1.RestoreModeSpecOnly {
+		return false
+	}
+	return len(vmRestore.Spec.VolumeNames) == 0 || slices.Contains(vmRestore.Spec.VolumeNames, volumeName)
+}
+
 func VmRestoreProgressing(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore.Status == nil || vmRestore.Status.Complete == nil || !*vmRestore.Status.Complete
 }
@@ -125,8 +147,11 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 
 	if len(vmRestoreOut.OwnerReferences) == 0 {
 		target.Own(vmRestoreOut)
-		updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"))
-		updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"))
+		// a restore which is not owned by its target is only initialized once
+		if len(vmRestoreOut.OwnerReferences) > 0 || len(vmRestoreOut.Status.Conditions) == 0 {
+			updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"))
+			updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"))
+		}
 	}
 
 	err = target.UpdateRestoreInProgress()
@@ -247,7 +272,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 
 	var restores []snapshotv1.VolumeRestore
 	for _, vb := range content.Spec.VolumeBackups {
-		if noRestore.Has(vb.VolumeName) {
+		if noRestore.Has(vb.VolumeName) || !volumeSelectedForRestore(vmRestore, vb.VolumeName) {
 			continue
 		}
 
@@ -430,6 +455,8 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 	var newTemplates = make([]kubevirtv1.DataVolumeTemplateSpec, len(snapshotVM.Spec.DataVolumeTemplates))
 	var newVolumes []kubevirtv1.Volume
 	var deletedDataVolumes []string
+	var keptTemplates []kubevirtv1.DataVolumeTemplateSpec
+	droppedTemplates := sets.NewString()
 	updatedStatus := false
 
 	for i, t := range snapshotVM.Spec.DataVolumeTemplates {
@@ -439,6 +466,21 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 	for _, v := range snapshotVM.Spec.Template.Spec.Volumes {
 		nv := v.DeepCopy()
 		if nv.DataVolume != nil || nv.PersistentVolumeClaim != nil {
+			if !volumeSelectedForRestore(t.vmRestore, nv.Name) {
+				if targetVolume, targetTemplate := t.targetVolume(nv.Name); targetVolume != nil {
+					// keep the current volume of the target in place of the snapshot one
+					if nv.DataVolume != nil {
+						droppedTemplates.Insert(nv.DataVolume.Name)
+					}
+					if targetTemplate != nil {
+						keptTemplates = append(keptTemplates, *targetTemplate)
+					}
+					nv = targetVolume
+				}
+				newVolumes = append(newVolumes, *nv)
+				continue
+			}
+
 			for k := range t.vmRestore.Status.Restores {
 				vr := &t.vmRestore.Status.Restores[k]
 				if vr.VolumeName != nv.Name {
@@ -514,6 +556,11 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 		newVolumes = append(newVolumes, *nv)
 	}
 
+	newTemplates = slices.DeleteFunc(newTemplates, func(dvt kubevirtv1.DataVolumeTemplateSpec) bool {
+		return droppedTemplates.Has(dvt.Name)
+	})
+	newTemplates = append(newTemplates, keptTemplates...)
+
 	if t.doesTargetVMExist() && updatedStatus {
 		// find DataVolumes that will no longer exist
 		for _, cdv := range t.vm.Spec.DataVolumeTemplates {
@@ -588,6 +635,31 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 	return true, nil
 }
 
+// targetVolume returns the volume of the target VM with the given name, along with its
+// DataVolumeTemplate when it has one
+func (t *vmRestoreTarget) targetVolume(name string) (*kubevirtv1.Volume, *kubevirtv1.DataVolumeTemplateSpec) {
+	if !t.doesTargetVMExist() {
+		return nil, nil
+	}
+
+	for _, v := range t.vm.Spec.Template.Spec.Volumes {
+		if v.Name != name {
+			continue
+		}
+
+		if v.DataVolume != nil {
+			for _, dvt := range t.vm.Spec.DataVolumeTemplates {
+				if dvt.Name == v.DataVolume.Name {
+					return v.DeepCopy(), dvt.DeepCopy()
+				}
+			}
+		}
+		return v.DeepCopy(), nil
+	}
+
+	return nil, nil
+}
+
 func (t *vmRestoreTarget) reconcileDataVolumes() (bool, error) {
 	createdDV := false
 	waitingDV := false
@@ -807,6 +879,60 @@ func (t *vmRestoreTarget) doesTargetVMExist() bool {
 	return t.vm != nil
 }
 
+// Ready returns true once the restored PVCs are bound, no VM waits for them to be populated. PVCs waiting for
+// their first consumer are not bound until a pod uses them, so they are ready as soon as they are pending.
+func (t *volumesRestoreTarget) Ready() (bool, error) {
+	for _, restore := range t.vmRestore.Status.Restores {
+		pvc, err := t.controller.getPVC(t.vmRestore.Namespace, restore.PersistentVolumeClaimName)
+		if err != nil {
+			return false, err
+		}
+
+		if pvc == nil {
+			log.Log.Object(t.vmRestore).V(3).Infof("Waiting for PVC %s to be bound", restore.PersistentVolumeClaimName)
+			return false, nil
+		}
+
+		if pvc.Status.Phase == corev1.ClaimPending {
+			bindingMode, err := t.controller.getBindingMode(pvc)
+			if err != nil {
+				return false, err
+			}
+			if bindingMode != nil && *bindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+				continue
+			}
+		}
+
+		if pvc.Status.Phase != corev1.ClaimBound {
+			log.Log.Object(t.vmRestore).V(3).Infof("Waiting for PVC %s to be bound", restore.PersistentVolumeClaimName)
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (t *volumesRestoreTarget) Reconcile() (bool, error) {
+	return false, nil
+}
+
+func (t *volumesRestoreTarget) Cleanup() error {
+	return nil
+}
+
+// Own does nothing, the restored PVCs are not owned by the VM
+func (t *volumesRestoreTarget) Own(obj metav1.Object) {}
+
+func (t *volumesRestoreTarget) UpdateDoneRestore() (bool, error) {
+	return false, nil
+}
+
+func (t *volumesRestoreTarget) UpdateRestoreInProgress() error {
+	return nil
+}
+
+func (t *volumesRestoreTarget) UpdateTarget(obj metav1.Object) {}
+
 func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.VirtualMachineRestore) (*snapshotv1.VirtualMachineSnapshotContent, error) {
 	objKey := cacheKeyFunc(vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName)
 	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(objKey)
@@ -922,16 +1048,37 @@ func (ctrl *VMRestoreController) getTarget(vmRestore *snapshotv1.VirtualMachineR
 	vmRestore.Spec.Target.DeepCopy()
 	switch vmRestore.Spec.Target.Kind {
 	case "VirtualMachine":
+		if restoreMode(vmRestore) == snapshotv1.RestoreModeVolumesOnly {
+			return &volumesRestoreTarget{
+				controller: ctrl,
+				vmRestore:  vmRestore,
+			}, nil
+		}
+
 		vm, err := ctrl.getVM(vmRestore.Namespace, vmRestore.Spec.Target.Name)
 		if err != nil {
 			return nil, err
 		}
 
-		return &vmRestoreTarget{
+		target := vmRestoreTarget{
 			controller: ctrl,
 			vmRestore:  vmRestore,
 			vm:         vm,
-		}, nil
+		}
+
+		if restoreMode(vmRestore) == snapshotv1.RestoreModeOnline {
+			vmi, err := ctrl.getVMI(vmRestore.Namespace, vmRestore.Spec.Target.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			return &vmOnlineRestoreTarget{
+				vmRestoreTarget: target,
+				vmi:             vmi,
+			}, nil
+		}
+
+		return &target, nil
 	}
 
 	return nil, fmt.Errorf("unknown source %+v", vmRestore.Spec.Target)
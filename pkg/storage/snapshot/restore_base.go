@@ -109,6 +109,17 @@ func (ctrl *VMRestoreController) Init() error {
 		return err
 	}
 
+	_, err = ctrl.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMI(newObj) },
+			DeleteFunc: ctrl.handleVMI,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
 	ctrl.vmStatusUpdater = status.NewVMStatusUpdater(ctrl.Client)
 	return nil
 }
@@ -237,3 +248,22 @@ func (ctrl *VMRestoreController) handleVM(obj interface{}) {
 		}
 	}
 }
+
+func (ctrl *VMRestoreController) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmi, ok := obj.(*kubevirtv1.VirtualMachineInstance); ok {
+		k, _ := cache.MetaNamespaceKeyFunc(vmi)
+		keys, err := ctrl.VMRestoreInformer.GetIndexer().IndexKeys("vm", k)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+
+		for _, k := range keys {
+			ctrl.vmRestoreQueue.Add(k)
+		}
+	}
+}
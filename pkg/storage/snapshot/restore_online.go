@@ -0,0 +1,278 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	launcherapi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// the guest filesystems are thawed by virt-launcher when unplugging the volumes takes longer
+const onlineRestoreFreezeTimeout = 5 * time.Minute
+
+// vmOnlineRestoreTarget restores data volumes of a running VM by hot unplugging the current
+// volumes and hot plugging the restored PVCs in their place
+type vmOnlineRestoreTarget struct {
+	vmRestoreTarget
+	vmi    *kubevirtv1.VirtualMachineInstance
+	frozen bool
+}
+
+// volumeSwapStage is the progress of the swap of a volume for its restored PVC
+type volumeSwapStage int
+
+const (
+	// the restored PVC is plugged and ready
+	volumeSwapped volumeSwapStage = iota
+	// the current volume is still in the VM spec
+	volumeUnplug
+	// waiting for the current volume to be unplugged from the guest
+	volumeUnplugging
+	// the restored PVC has to be plugged
+	volumePlug
+	// waiting for the restored PVC to be plugged in the guest
+	volumePlugging
+)
+
+func (t *vmOnlineRestoreTarget) Ready() (bool, error) {
+	if !t.doesTargetVMExist() {
+		return false, fmt.Errorf("VirtualMachine %s/%s does not exist", t.vmRestore.Namespace, t.vmRestore.Spec.Target.Name)
+	}
+
+	if t.vmi == nil || t.vmi.IsFinal() {
+		return false, fmt.Errorf("VirtualMachine %s/%s is not running", t.vm.Namespace, t.vm.Name)
+	}
+
+	return t.vmi.IsRunning(), nil
+}
+
+// Reconcile swaps the volumes one step at a time. The guest is frozen while its current volumes
+// are unplugged and thawed before the restored PVCs are plugged, their attachment pod has to be
+// scheduled first. It is thawed as well when the swap fails.
+func (t *vmOnlineRestoreTarget) Reconcile() (swapping bool, err error) {
+	log.Log.Object(t.vmRestore).V(3).Info("Reconciling online restore")
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		if unfreezeErr := t.unfreeze(); unfreezeErr != nil {
+			log.Log.Object(t.vmRestore).Reason(unfreezeErr).Errorf("Failed to unfreeze VMI %s", t.vmi.Name)
+		}
+	}()
+
+	content, err := t.controller.getSnapshotContent(t.vmRestore)
+	if err != nil {
+		return false, err
+	}
+
+	restores := t.vmRestore.Status.Restores
+	stages := make([]volumeSwapStage, len(restores))
+	unplugging := false
+	for i := range restores {
+		stages[i] = t.volumeSwapStage(&restores[i])
+		swapping = swapping || stages[i] != volumeSwapped
+		unplugging = unplugging || stages[i] == volumeUnplug || stages[i] == volumeUnplugging
+	}
+
+	if !unplugging {
+		if err := t.unfreeze(); err != nil {
+			return false, err
+		}
+	}
+
+	for i := range restores {
+		switch {
+		case stages[i] == volumeUnplug:
+			err = t.unplugVolume(&restores[i])
+		case stages[i] == volumePlug && !unplugging:
+			err = t.plugVolume(content, &restores[i])
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return swapping, nil
+}
+
+func (t *vmOnlineRestoreTarget) volumeSwapStage(vr *snapshotv1.VolumeRestore) volumeSwapStage {
+	volume := findVolume(t.vm.Spec.Template.Spec.Volumes, vr.VolumeName)
+
+	switch {
+	case volume != nil && volumeClaimName(volume) == vr.PersistentVolumeClaimName:
+		if t.restoredVolumeReady(vr) {
+			return volumeSwapped
+		}
+		return volumePlugging
+	case hasVolumeRequest(t.vm, vr.VolumeName):
+		if volume != nil {
+			return volumeUnplugging
+		}
+		return volumePlugging
+	case volume != nil:
+		return volumeUnplug
+	case findVolumeStatus(t.vmi, vr.VolumeName) != nil:
+		return volumeUnplugging
+	}
+
+	return volumePlug
+}
+
+func (t *vmOnlineRestoreTarget) unplugVolume(vr *snapshotv1.VolumeRestore) error {
+	if err := t.freeze(); err != nil {
+		return err
+	}
+
+	log.Log.Object(t.vmRestore).Infof("Unplugging volume %s from VM %s", vr.VolumeName, t.vm.Name)
+	return t.controller.Client.VirtualMachine(t.vm.Namespace).RemoveVolume(context.Background(), t.vm.Name, &kubevirtv1.RemoveVolumeOptions{
+		Name: vr.VolumeName,
+	})
+}
+
+func (t *vmOnlineRestoreTarget) plugVolume(content *snapshotv1.VirtualMachineSnapshotContent, vr *snapshotv1.VolumeRestore) error {
+	disk := findDisk(content.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Devices.Disks, vr.VolumeName)
+	if disk == nil {
+		return fmt.Errorf("disk %s not found in snapshot", vr.VolumeName)
+	}
+
+	log.Log.Object(t.vmRestore).Infof("Plugging restored PVC %s as volume %s of VM %s", vr.PersistentVolumeClaimName, vr.VolumeName, t.vm.Name)
+	return t.controller.Client.VirtualMachine(t.vm.Namespace).AddVolume(context.Background(), t.vm.Name, &kubevirtv1.AddVolumeOptions{
+		Name: vr.VolumeName,
+		Disk: disk,
+		VolumeSource: &kubevirtv1.HotplugVolumeSource{
+			PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: vr.PersistentVolumeClaimName,
+				},
+				Hotpluggable: true,
+			},
+		},
+	})
+}
+
+func (t *vmOnlineRestoreTarget) restoredVolumeReady(vr *snapshotv1.VolumeRestore) bool {
+	volume := findVolume(t.vmi.Spec.Volumes, vr.VolumeName)
+	if volume == nil || volumeClaimName(volume) != vr.PersistentVolumeClaimName {
+		return false
+	}
+
+	volumeStatus := findVolumeStatus(t.vmi, vr.VolumeName)
+	return volumeStatus != nil && volumeStatus.Phase == kubevirtv1.VolumeReady
+}
+
+func (t *vmOnlineRestoreTarget) freeze() error {
+	if t.frozen || t.vmi.Status.FSFreezeStatus == launcherapi.FSFrozen || !agentConnected(t.vmi) {
+		return nil
+	}
+
+	log.Log.Object(t.vmRestore).Infof("Freezing VMI %s before unplugging volumes", t.vmi.Name)
+	if err := t.controller.Client.VirtualMachineInstance(t.vmi.Namespace).Freeze(context.Background(), t.vmi.Name, onlineRestoreFreezeTimeout); err != nil {
+		return err
+	}
+	t.frozen = true
+
+	return nil
+}
+
+func (t *vmOnlineRestoreTarget) unfreeze() error {
+	if !t.frozen && t.vmi.Status.FSFreezeStatus != launcherapi.FSFrozen {
+		return nil
+	}
+
+	log.Log.Object(t.vmRestore).Infof("Unfreezing VMI %s after unplugging volumes", t.vmi.Name)
+	if err := t.controller.Client.VirtualMachineInstance(t.vmi.Namespace).Unfreeze(context.Background(), t.vmi.Name); err != nil {
+		return err
+	}
+	t.frozen = false
+
+	return nil
+}
+
+func (ctrl *VMRestoreController) getVMI(namespace, name string) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachineInstance).DeepCopy(), nil
+}
+
+func agentConnected(vmi *kubevirtv1.VirtualMachineInstance) bool {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	return condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected)
+}
+
+func findVolume(volumes []kubevirtv1.Volume, name string) *kubevirtv1.Volume {
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
+func findDisk(disks []kubevirtv1.Disk, name string) *kubevirtv1.Disk {
+	for i := range disks {
+		if disks[i].Name == name {
+			return disks[i].DeepCopy()
+		}
+	}
+	return nil
+}
+
+func findVolumeStatus(vmi *kubevirtv1.VirtualMachineInstance, name string) *kubevirtv1.VolumeStatus {
+	for i := range vmi.Status.VolumeStatus {
+		if vmi.Status.VolumeStatus[i].Name == name {
+			return &vmi.Status.VolumeStatus[i]
+		}
+	}
+	return nil
+}
+
+func volumeClaimName(volume *kubevirtv1.Volume) string {
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		return volume.PersistentVolumeClaim.ClaimName
+	case volume.DataVolume != nil:
+		return volume.DataVolume.Name
+	}
+	return ""
+}
+
+func hasVolumeRequest(vm *kubevirtv1.VirtualMachine, name string) bool {
+	for _, request := range vm.Status.VolumeRequests {
+		if (request.AddVolumeOptions != nil && request.AddVolumeOptions.Name == name) ||
+			(request.RemoveVolumeOptions != nil && request.RemoveVolumeOptions.Name == name) {
+			return true
+		}
+	}
+	return false
+}
@@ -34,6 +34,7 @@ import (
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype"
+	virtpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util/status"
 )
@@ -797,6 +798,124 @@ var _ = Describe("Restore controller", func() {
 				testutils.ExpectEvent(recorder, "VirtualMachineRestoreComplete")
 			})
 
+			DescribeTable("should update VM spec keeping the volumes which are not restored", func(update func(*snapshotv1.VirtualMachineRestore)) {
+				r := createRestoreWithOwner()
+				update(r)
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: &f,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					},
+				}
+				vm := createModifiedVM()
+				vm.Status.RestoreInProgress = &vmRestoreName
+				vm.Spec.DataVolumeTemplates[0].Name = "current-dv"
+				vm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "current-dv"
+				updatedVM := createSnapshotVM()
+				updatedVM.Status.RestoreInProgress = &vmRestoreName
+				updatedVM.ResourceVersion = "1"
+				updatedVM.Annotations = map[string]string{"restore.kubevirt.io/lastRestoreUID": "restore-uid"}
+				updatedVM.Spec.DataVolumeTemplates = vm.Spec.DataVolumeTemplates
+				updatedVM.Spec.Template.Spec.Volumes = vm.Spec.Template.Spec.Volumes
+				vmSource.Add(vm)
+				vmInterface.EXPECT().Update(context.Background(), updatedVM, metav1.UpdateOptions{}).Return(updatedVM, nil)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+			},
+				Entry("with spec only mode", func(r *snapshotv1.VirtualMachineRestore) {
+					r.Spec.Mode = virtpointer.P(snapshotv1.RestoreModeSpecOnly)
+				}),
+				Entry("with other volumes selected", func(r *snapshotv1.VirtualMachineRestore) {
+					r.Spec.VolumeNames = []string{"disk2"}
+				}),
+			)
+
+			It("should create standalone restore PVCs without touching the VM with volumes only mode", func() {
+				r := createRestore()
+				r.Spec.Mode = virtpointer.P(snapshotv1.RestoreModeVolumesOnly)
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: &f,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"),
+						newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"),
+					},
+				}
+				addVolumeRestores(r)
+				ur := r.DeepCopy()
+				ur.ResourceVersion = "1"
+				ur.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+					newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+				}
+
+				vm := createModifiedVM()
+				vmSource.Add(vm)
+				vmiSource.Add(createVMI(vm))
+				pvcSize := resource.MustParse("2Gi")
+				fakeVolumeSnapshotProvider.Add(createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, pvcSize))
+				expectPVCCreates(k8sClient, r, pvcSize)
+				expectVMRestoreUpdate(kubevirtClient, ur)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+
+				pvcCreates := 0
+				for _, action := range k8sClient.Actions() {
+					if create, ok := action.(testing.CreateAction); ok && action.GetResource().Resource == "persistentvolumeclaims" {
+						Expect(create.GetObject().(*corev1.PersistentVolumeClaim).OwnerReferences).To(BeEmpty())
+						pvcCreates++
+					}
+				}
+				Expect(pvcCreates).To(Equal(1))
+			})
+
+			DescribeTable("should complete once the restored PVCs are bound with volumes only mode", func(bindingMode storagev1.VolumeBindingMode, phase corev1.PersistentVolumeClaimPhase) {
+				r := createRestore()
+				r.Spec.Mode = virtpointer.P(snapshotv1.RestoreModeVolumesOnly)
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: &f,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					},
+				}
+				addVolumeRestores(r)
+				ur := r.DeepCopy()
+				ur.ResourceVersion = "1"
+				ur.Status.Complete = &t
+				ur.Status.RestoreTime = timeFunc()
+				ur.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+					newReadyCondition(corev1.ConditionTrue, "Operation complete"),
+				}
+
+				storageClass := createStorageClass()
+				storageClass.Name = "binding"
+				storageClass.VolumeBindingMode = virtpointer.P(bindingMode)
+				storageClassSource.Add(storageClass)
+				vm := createModifiedVM()
+				vmSource.Add(vm)
+				for _, pvc := range getRestorePVCs(r) {
+					pvc.Spec.StorageClassName = &storageClass.Name
+					pvc.Status.Phase = phase
+					addPVC(&pvc)
+				}
+				Eventually(func() bool {
+					_, exists, _ := storageClassInformer.GetStore().GetByKey(storageClass.Name)
+					return exists
+				}).Should(BeTrue())
+				expectVMRestoreUpdate(kubevirtClient, ur)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				Expect(kubevirtClient.Actions()).To(ContainElement(WithTransform(func(action testing.Action) bool {
+					return action.Matches("update", "virtualmachinerestores")
+				}, BeTrue())))
+				testutils.ExpectEvent(recorder, "VirtualMachineRestoreComplete")
+			},
+				Entry("without initializing it again", storagev1.VolumeBindingImmediate, corev1.ClaimBound),
+				Entry("or pending when the binding waits for the first consumer", storagev1.VolumeBindingWaitForFirstConsumer, corev1.ClaimPending),
+			)
+
 			DescribeTable("reconcileDataVolumes should", func(dvExists bool, phase cdiv1.DataVolumePhase, expectedRes bool) {
 				r := createRestoreWithOwner()
 				vm := createModifiedVM()
@@ -841,6 +960,170 @@ var _ = Describe("Restore controller", func() {
 				Entry("return true when dv doesnt exists", false, cdiv1.PhaseUnset, true),
 			)
 
+			Context("with online mode", func() {
+				var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+				createOnlineRestore := func() *snapshotv1.VirtualMachineRestore {
+					r := createRestoreWithOwner()
+					r.Spec.Mode = virtpointer.P(snapshotv1.RestoreModeOnline)
+					r.Spec.VolumeNames = []string{diskName}
+					addVolumeRestores(r)
+					return r
+				}
+
+				createRunningVMI := func(vm *kubevirtv1.VirtualMachine) *kubevirtv1.VirtualMachineInstance {
+					vmi := createVMI(vm)
+					vmi.Spec.Volumes = vm.Spec.Template.Spec.Volumes
+					vmi.Status.Phase = kubevirtv1.Running
+					vmi.Status.Conditions = []kubevirtv1.VirtualMachineInstanceCondition{
+						{
+							Type:   kubevirtv1.VirtualMachineInstanceAgentConnected,
+							Status: corev1.ConditionTrue,
+						},
+					}
+					return vmi
+				}
+
+				reconcileOnline := func(r *snapshotv1.VirtualMachineRestore, vm *kubevirtv1.VirtualMachine, vmi *kubevirtv1.VirtualMachineInstance) bool {
+					vmiSource.Add(vmi)
+					vmRestoreSource.Add(r)
+					addVM(vm)
+					target, err := controller.getTarget(r)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(target).To(BeAssignableToTypeOf(&vmOnlineRestoreTarget{}))
+					ready, err := target.Ready()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ready).To(BeTrue())
+					res, err := target.Reconcile()
+					Expect(err).ToNot(HaveOccurred())
+					return res
+				}
+
+				BeforeEach(func() {
+					vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+					virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+				})
+
+				It("should mark the restore in progress on the running VM", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					vmRestoreSource.Add(r)
+					addVM(vm)
+					expectUpdateVMRestoreInProgress(vm)
+					target, err := controller.getTarget(r)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(target.UpdateRestoreInProgress()).To(Succeed())
+				})
+
+				It("should fail when the VM is not running", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					vmRestoreSource.Add(r)
+					addVM(vm)
+					target, err := controller.getTarget(r)
+					Expect(err).ToNot(HaveOccurred())
+					_, err = target.Ready()
+					Expect(err).To(MatchError(ContainSubstring("is not running")))
+				})
+
+				It("should freeze the guest and unplug the current volume", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					gomock.InOrder(
+						vmiInterface.EXPECT().Freeze(context.Background(), vmName, onlineRestoreFreezeTimeout).Return(nil),
+						vmInterface.EXPECT().RemoveVolume(context.Background(), vmName, &kubevirtv1.RemoveVolumeOptions{Name: diskName}).Return(nil),
+					)
+					Expect(reconcileOnline(r, vm, createRunningVMI(vm))).To(BeTrue())
+				})
+
+				It("should wait while the volume request is pending", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					vm.Status.VolumeRequests = []kubevirtv1.VirtualMachineVolumeRequest{
+						{RemoveVolumeOptions: &kubevirtv1.RemoveVolumeOptions{Name: diskName}},
+					}
+					Expect(reconcileOnline(r, vm, createRunningVMI(vm))).To(BeTrue())
+				})
+
+				It("should wait for the current volume to be unplugged from the guest", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					vmi := createRunningVMI(vm)
+					vm.Spec.Template.Spec.Volumes = nil
+					vmi.Status.FSFreezeStatus = "frozen"
+					vmi.Status.VolumeStatus = []kubevirtv1.VolumeStatus{{Name: diskName, Phase: kubevirtv1.VolumeReady}}
+					Expect(reconcileOnline(r, vm, vmi)).To(BeTrue())
+				})
+
+				It("should unfreeze the guest when unplugging the current volume fails", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					vmiSource.Add(createRunningVMI(vm))
+					vmRestoreSource.Add(r)
+					addVM(vm)
+					gomock.InOrder(
+						vmiInterface.EXPECT().Freeze(context.Background(), vmName, onlineRestoreFreezeTimeout).Return(nil),
+						vmInterface.EXPECT().RemoveVolume(context.Background(), vmName, &kubevirtv1.RemoveVolumeOptions{Name: diskName}).Return(fmt.Errorf("bad")),
+						vmiInterface.EXPECT().Unfreeze(context.Background(), vmName).Return(nil),
+					)
+					target, err := controller.getTarget(r)
+					Expect(err).ToNot(HaveOccurred())
+					_, err = target.Reconcile()
+					Expect(err).To(MatchError("bad"))
+				})
+
+				It("should plug the restored PVC once the current volume is unplugged", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					disk := vm.Spec.Template.Spec.Domain.Devices.Disks[0]
+					vm.Spec.Template.Spec.Volumes = nil
+					vmInterface.EXPECT().AddVolume(context.Background(), vmName, &kubevirtv1.AddVolumeOptions{
+						Name: diskName,
+						Disk: &disk,
+						VolumeSource: &kubevirtv1.HotplugVolumeSource{
+							PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: "restore-uid-disk1",
+								},
+								Hotpluggable: true,
+							},
+						},
+					}).Return(nil)
+					Expect(reconcileOnline(r, vm, createRunningVMI(vm))).To(BeTrue())
+				})
+
+				It("should unfreeze the guest before plugging the restored PVC", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					vmi := createRunningVMI(vm)
+					vmi.Status.FSFreezeStatus = "frozen"
+					vm.Spec.Template.Spec.Volumes = nil
+					gomock.InOrder(
+						vmiInterface.EXPECT().Unfreeze(context.Background(), vmName).Return(nil),
+						vmInterface.EXPECT().AddVolume(context.Background(), vmName, gomock.Any()).Return(nil),
+					)
+					Expect(reconcileOnline(r, vm, vmi)).To(BeTrue())
+				})
+
+				It("should unfreeze the guest once the restored volume is ready", func() {
+					r := createOnlineRestore()
+					vm := createSnapshotVM()
+					vm.Spec.Template.Spec.Volumes[0].VolumeSource = kubevirtv1.VolumeSource{
+						PersistentVolumeClaim: &kubevirtv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "restore-uid-disk1",
+							},
+							Hotpluggable: true,
+						},
+					}
+					vmi := createRunningVMI(vm)
+					vmi.Status.FSFreezeStatus = "frozen"
+					vmi.Status.VolumeStatus = []kubevirtv1.VolumeStatus{{Name: diskName, Phase: kubevirtv1.VolumeReady}}
+					vmiInterface.EXPECT().Unfreeze(context.Background(), vmName).Return(nil)
+					Expect(reconcileOnline(r, vm, vmi)).To(BeFalse())
+				})
+			})
+
 			Context("target VM is different than source VM", func() {
 
 				It("should be able to restore to a new VM", func() {
@@ -0,0 +1,455 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	"github.com/robfig/cron/v3"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	scheduledSnapshotCreatedEvent = "ScheduledSnapshotCreated"
+
+	scheduledSnapshotDeletedEvent = "ScheduledSnapshotDeleted"
+
+	snapshotScheduleErrorEvent = "VirtualMachineSnapshotScheduleError"
+)
+
+// scheduledSnapshotTimeFormat is used in the names of the scheduled snapshots, which have to be DNS subdomains
+const scheduledSnapshotTimeFormat = "20060102-1504"
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(scheduleIn *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	logger := log.Log.Object(scheduleIn)
+	logger.V(1).Infof("Updating VirtualMachineSnapshotSchedule")
+
+	if scheduleIn.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	scheduleOut := scheduleIn.DeepCopy()
+	if scheduleOut.Status == nil {
+		scheduleOut.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+	now := currentTime()
+
+	cronSchedule, selector, err := parseSnapshotSchedule(&scheduleOut.Spec)
+	if err != nil {
+		scheduleOut.Status.NextScheduleTime = nil
+		scheduleOut.Status.Error = &snapshotv1.Error{
+			Time:    now,
+			Message: pointer.P(err.Error()),
+		}
+		return 0, ctrl.updateVMSnapshotScheduleStatus(scheduleIn, scheduleOut)
+	}
+
+	vms, err := ctrl.selectedVMs(scheduleOut.Namespace, selector)
+	if err != nil {
+		return 0, err
+	}
+
+	snapshots, err := ctrl.scheduledSnapshots(scheduleOut)
+	if err != nil {
+		return 0, err
+	}
+
+	var syncErrors []string
+	disabled := scheduleOut.Spec.Disabled != nil && *scheduleOut.Spec.Disabled
+	since := scheduleOut.CreationTimestamp.Time
+	if since.IsZero() {
+		since = now.Time
+	}
+	if scheduleOut.Status.LastScheduleTime != nil {
+		since = scheduleOut.Status.LastScheduleTime.Time
+	}
+	if scheduled := lastActivation(cronSchedule, since, now.Time); !disabled && !scheduled.IsZero() {
+		for _, vm := range vms {
+			vmSnapshot, err := ctrl.createScheduledSnapshot(scheduleOut, vm, scheduled)
+			if err != nil {
+				syncErrors = append(syncErrors, err.Error())
+				setSnapshotScheduleVMFailure(scheduleOut, vm.Name, now, err.Error())
+				continue
+			}
+			snapshots = append(snapshots, vmSnapshot)
+		}
+		scheduleOut.Status.LastScheduleTime = &metav1.Time{Time: scheduled}
+	}
+
+	updateSnapshotScheduleVMStatuses(scheduleOut, vms, snapshots)
+
+	for _, vmSnapshot := range expiredScheduledSnapshots(scheduleOut.Spec.Retention, snapshots, now.Time) {
+		if err := ctrl.deleteScheduledSnapshot(scheduleOut, vmSnapshot); err != nil {
+			syncErrors = append(syncErrors, err.Error())
+		}
+	}
+
+	scheduleOut.Status.Error = nil
+	if len(syncErrors) > 0 {
+		scheduleOut.Status.Error = &snapshotv1.Error{
+			Time:    now,
+			Message: pointer.P(strings.Join(syncErrors, ", ")),
+		}
+	}
+
+	var requeue time.Duration
+	scheduleOut.Status.NextScheduleTime = nil
+	if next := cronSchedule.Next(now.UTC()); !disabled && !next.IsZero() {
+		scheduleOut.Status.NextScheduleTime = &metav1.Time{Time: next}
+		requeue = next.Sub(now.Time)
+	}
+
+	if err := ctrl.updateVMSnapshotScheduleStatus(scheduleIn, scheduleOut); err != nil {
+		return 0, err
+	}
+	return requeue, nil
+}
+
+func parseSnapshotSchedule(spec *snapshotv1.VirtualMachineSnapshotScheduleSpec) (cron.Schedule, labels.Selector, error) {
+	cronSchedule, err := cron.ParseStandard(spec.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule: %v", err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&spec.Selector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid selector: %v", err)
+	}
+
+	return cronSchedule, selector, nil
+}
+
+// lastActivation returns the most recent activation of the schedule after since and not after now.
+// Missed activations are coalesced into the most recent one. The zero time is returned if the schedule
+// did not activate.
+func lastActivation(cronSchedule cron.Schedule, since, now time.Time) time.Time {
+	var last time.Time
+	for next := cronSchedule.Next(since.UTC()); !next.IsZero() && !next.After(now); next = cronSchedule.Next(next) {
+		last = next
+	}
+	return last
+}
+
+func (ctrl *VMSnapshotScheduleController) selectedVMs(namespace string, selector labels.Selector) ([]*kubevirtv1.VirtualMachine, error) {
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	for _, obj := range objs {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok {
+			return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+		}
+		if vm.DeletionTimestamp == nil && selector.Matches(labels.Set(vm.Labels)) {
+			vms = append(vms, vm)
+		}
+	}
+	sort.Slice(vms, func(i, j int) bool { return vms[i].Name < vms[j].Name })
+
+	return vms, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) scheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("schedule", cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, obj := range objs {
+		vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if !ok {
+			return nil, fmt.Errorf(unexpectedResourceFmt, obj)
+		}
+		snapshots = append(snapshots, vmSnapshot)
+	}
+
+	return snapshots, nil
+}
+
+// scheduledSnapshotName returns the name of the snapshot of a VM scheduled at the given time. The names of the
+// schedule and of the VM are shortened and hashed when the name would be too long.
+func scheduledSnapshotName(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmName string, scheduled time.Time) string {
+	return naming.GetName(fmt.Sprintf("%s-%s", schedule.Name, vmName), scheduled.UTC().Format(scheduledSnapshotTimeFormat), validation.DNS1123SubdomainMaxLength)
+}
+
+func (ctrl *VMSnapshotScheduleController) createScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, scheduled time.Time) (*snapshotv1.VirtualMachineSnapshot, error) {
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scheduledSnapshotName(schedule, vm.Name, scheduled),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				snapshotv1.SnapshotScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+			DeletionPolicy:  schedule.Spec.DeletionPolicy,
+			FailureDeadline: schedule.Spec.FailureDeadline,
+		},
+	}
+
+	created, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return vmSnapshot, nil
+	}
+	if err != nil {
+		ctrl.Recorder.Eventf(schedule, corev1.EventTypeWarning, snapshotScheduleErrorEvent, "Error creating VirtualMachineSnapshot %s: %v", vmSnapshot.Name, err)
+		return nil, fmt.Errorf("failed to create VirtualMachineSnapshot %s: %v", vmSnapshot.Name, err)
+	}
+
+	ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, scheduledSnapshotCreatedEvent, "Created VirtualMachineSnapshot %s", created.Name)
+	return created, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) deleteScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
+	err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		ctrl.Recorder.Eventf(schedule, corev1.EventTypeWarning, snapshotScheduleErrorEvent, "Error deleting VirtualMachineSnapshot %s: %v", vmSnapshot.Name, err)
+		return fmt.Errorf("failed to delete VirtualMachineSnapshot %s: %v", vmSnapshot.Name, err)
+	}
+
+	ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, scheduledSnapshotDeletedEvent, "Deleted VirtualMachineSnapshot %s", vmSnapshot.Name)
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotScheduleStatus(scheduleIn, scheduleOut *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if equality.Semantic.DeepEqual(scheduleIn, scheduleOut) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleOut.Namespace).Update(context.Background(), scheduleOut, metav1.UpdateOptions{})
+	return err
+}
+
+// snapshotTime is the time at which a snapshot was taken, or requested when it is not taken yet
+func snapshotTime(vmSnapshot *snapshotv1.VirtualMachineSnapshot) time.Time {
+	if vmSnapshot.Status != nil && vmSnapshot.Status.CreationTime != nil {
+		return vmSnapshot.Status.CreationTime.Time
+	}
+	return vmSnapshot.CreationTimestamp.Time
+}
+
+func snapshotSucceeded(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Status != nil && vmSnapshot.Status.Phase == snapshotv1.Succeeded
+}
+
+func snapshotFailed(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Status != nil && vmSnapshot.Status.Phase == snapshotv1.Failed
+}
+
+// snapshotsByVM groups the snapshots by source VM, most recent first
+func snapshotsByVM(snapshots []*snapshotv1.VirtualMachineSnapshot) map[string][]*snapshotv1.VirtualMachineSnapshot {
+	byVM := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, vmSnapshot := range snapshots {
+		byVM[vmSnapshot.Spec.Source.Name] = append(byVM[vmSnapshot.Spec.Source.Name], vmSnapshot)
+	}
+	for _, vmSnapshots := range byVM {
+		sort.SliceStable(vmSnapshots, func(i, j int) bool {
+			return snapshotTime(vmSnapshots[i]).After(snapshotTime(vmSnapshots[j]))
+		})
+	}
+	return byVM
+}
+
+func setSnapshotScheduleVMFailure(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmName string, t *metav1.Time, message string) {
+	for i := range schedule.Status.VirtualMachines {
+		if schedule.Status.VirtualMachines[i].Name == vmName {
+			schedule.Status.VirtualMachines[i].LastFailure = &snapshotv1.Error{Time: t, Message: pointer.P(message)}
+			return
+		}
+	}
+	schedule.Status.VirtualMachines = append(schedule.Status.VirtualMachines, snapshotv1.VirtualMachineSnapshotScheduleVMStatus{
+		Name:        vmName,
+		LastFailure: &snapshotv1.Error{Time: t, Message: pointer.P(message)},
+	})
+}
+
+// updateSnapshotScheduleVMStatuses records the last snapshot, success and failure of the selected VMs.
+// Failures are kept until a more recent failure happens, since the failed snapshots get deleted.
+func updateSnapshotScheduleVMStatuses(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vms []*kubevirtv1.VirtualMachine, snapshots []*snapshotv1.VirtualMachineSnapshot) {
+	previous := map[string]snapshotv1.VirtualMachineSnapshotScheduleVMStatus{}
+	for _, vmStatus := range schedule.Status.VirtualMachines {
+		previous[vmStatus.Name] = vmStatus
+	}
+	byVM := snapshotsByVM(snapshots)
+
+	var statuses []snapshotv1.VirtualMachineSnapshotScheduleVMStatus
+	for _, vm := range vms {
+		vmStatus := previous[vm.Name]
+		vmStatus.Name = vm.Name
+
+		vmSnapshots := byVM[vm.Name]
+		if len(vmSnapshots) > 0 {
+			vmStatus.LastSnapshotName = pointer.P(vmSnapshots[0].Name)
+		}
+		for _, vmSnapshot := range vmSnapshots {
+			if snapshotSucceeded(vmSnapshot) {
+				vmStatus.LastSuccessfulSnapshotName = pointer.P(vmSnapshot.Name)
+				vmStatus.LastSuccessTime = &metav1.Time{Time: snapshotTime(vmSnapshot)}
+				break
+			}
+		}
+		for _, vmSnapshot := range vmSnapshots {
+			if !snapshotFailed(vmSnapshot) {
+				continue
+			}
+			failureTime := snapshotTime(vmSnapshot)
+			if vmSnapshot.Status.Error != nil && vmSnapshot.Status.Error.Time != nil {
+				failureTime = vmSnapshot.Status.Error.Time.Time
+			}
+			if vmStatus.LastFailure == nil || vmStatus.LastFailure.Time == nil || vmStatus.LastFailure.Time.Time.Before(failureTime) {
+				message := fmt.Sprintf("VirtualMachineSnapshot %s failed", vmSnapshot.Name)
+				if vmSnapshot.Status.Error != nil && vmSnapshot.Status.Error.Message != nil {
+					message = fmt.Sprintf("%s: %s", message, *vmSnapshot.Status.Error.Message)
+				}
+				vmStatus.LastFailure = &snapshotv1.Error{Time: &metav1.Time{Time: failureTime}, Message: pointer.P(message)}
+			}
+			break
+		}
+
+		statuses = append(statuses, vmStatus)
+	}
+
+	schedule.Status.VirtualMachines = statuses
+}
+
+// expiredScheduledSnapshots returns the snapshots which are not retained by the retention policy. Snapshots in
+// progress are never expired. Failed snapshots are expired once a more recent snapshot of the VM succeeded, and
+// the retention policy applies to the remaining ones separately, so that they do not pile up while the VM cannot
+// be snapshotted.
+func expiredScheduledSnapshots(retention *snapshotv1.SnapshotRetentionPolicy, snapshots []*snapshotv1.VirtualMachineSnapshot, now time.Time) []*snapshotv1.VirtualMachineSnapshot {
+	var expired []*snapshotv1.VirtualMachineSnapshot
+	for _, vmSnapshots := range snapshotsByVM(snapshots) {
+		var succeeded, failed []*snapshotv1.VirtualMachineSnapshot
+		for _, vmSnapshot := range vmSnapshots {
+			switch {
+			case vmSnapshot.DeletionTimestamp != nil:
+			case snapshotSucceeded(vmSnapshot):
+				succeeded = append(succeeded, vmSnapshot)
+			case snapshotFailed(vmSnapshot) && len(succeeded) > 0:
+				expired = append(expired, vmSnapshot)
+			case snapshotFailed(vmSnapshot):
+				failed = append(failed, vmSnapshot)
+			}
+		}
+
+		if retention == nil {
+			continue
+		}
+		expired = append(expired, unretainedSnapshots(retention, succeeded, now)...)
+		expired = append(expired, unretainedSnapshots(retention, failed, now)...)
+	}
+
+	sort.Slice(expired, func(i, j int) bool { return expired[i].Name < expired[j].Name })
+	return expired
+}
+
+// unretainedSnapshots returns the snapshots of a VM, most recent first, which are not selected by the keep rules
+// of the retention policy or which are older than MaxAge
+func unretainedSnapshots(retention *snapshotv1.SnapshotRetentionPolicy, snapshots []*snapshotv1.VirtualMachineSnapshot, now time.Time) []*snapshotv1.VirtualMachineSnapshot {
+	var unretained []*snapshotv1.VirtualMachineSnapshot
+	retained := retainedSnapshots(retention, snapshots)
+	for _, vmSnapshot := range snapshots {
+		tooOld := retention.MaxAge != nil && now.Sub(snapshotTime(vmSnapshot)) > retention.MaxAge.Duration
+		if !retained[vmSnapshot.Name] || tooOld {
+			unretained = append(unretained, vmSnapshot)
+		}
+	}
+	return unretained
+}
+
+// retainedSnapshots applies the keep rules of the retention policy to the snapshots of a VM, most recent first,
+// and returns the names of the snapshots which are kept
+func retainedSnapshots(retention *snapshotv1.SnapshotRetentionPolicy, snapshots []*snapshotv1.VirtualMachineSnapshot) map[string]bool {
+	retained := map[string]bool{}
+	if retention.Last == nil && retention.Daily == nil && retention.Weekly == nil && retention.Monthly == nil {
+		for _, vmSnapshot := range snapshots {
+			retained[vmSnapshot.Name] = true
+		}
+		return retained
+	}
+
+	if retention.Last != nil {
+		for i := 0; i < len(snapshots) && i < int(*retention.Last); i++ {
+			retained[snapshots[i].Name] = true
+		}
+	}
+
+	periodRules := []struct {
+		count  *int32
+		period func(time.Time) string
+	}{
+		{retention.Daily, func(t time.Time) string { return t.Format("2006-01-02") }},
+		{retention.Weekly, func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%d", year, week)
+		}},
+		{retention.Monthly, func(t time.Time) string { return t.Format("2006-01") }},
+	}
+	for _, rule := range periodRules {
+		if rule.count == nil {
+			continue
+		}
+		// the most recent snapshot of each of the last periods having snapshots is kept
+		periods := map[string]bool{}
+		for _, vmSnapshot := range snapshots {
+			period := rule.period(snapshotTime(vmSnapshot).UTC())
+			if periods[period] {
+				continue
+			}
+			if len(periods) == int(*rule.count) {
+				break
+			}
+			periods[period] = true
+			retained[vmSnapshot.Name] = true
+		}
+	}
+
+	return retained
+}
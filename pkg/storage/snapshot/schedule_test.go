@@ -0,0 +1,403 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const (
+		testNamespace = "default"
+		scheduleName  = "nightly"
+	)
+
+	// a Sunday
+	now := time.Date(2024, time.March, 10, 2, 30, 0, 0, time.UTC)
+
+	newScheduledSnapshot := func(vmName string, t time.Time, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("%s-%s-%s", scheduleName, vmName, t.Format(scheduledSnapshotTimeFormat)),
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.Time{Time: t},
+				Labels:            map[string]string{snapshotv1.SnapshotScheduleLabel: scheduleName},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:        phase,
+				CreationTime: &metav1.Time{Time: t},
+			},
+		}
+	}
+
+	snapshotNames := func(snapshots []*snapshotv1.VirtualMachineSnapshot) []string {
+		var names []string
+		for _, vmSnapshot := range snapshots {
+			names = append(names, vmSnapshot.Name)
+		}
+		return names
+	}
+
+	Context("retention", func() {
+		// snapshots every 12 hours during 90 days, most recent first
+		newSnapshots := func() []*snapshotv1.VirtualMachineSnapshot {
+			var snapshots []*snapshotv1.VirtualMachineSnapshot
+			for i := 0; i < 180; i++ {
+				snapshots = append(snapshots, newScheduledSnapshot("vm", now.Add(-time.Duration(i)*12*time.Hour), snapshotv1.Succeeded))
+			}
+			return snapshots
+		}
+
+		retainedNames := func(retention *snapshotv1.SnapshotRetentionPolicy, snapshots []*snapshotv1.VirtualMachineSnapshot) []string {
+			expired := map[string]bool{}
+			for _, vmSnapshot := range expiredScheduledSnapshots(retention, snapshots, now) {
+				expired[vmSnapshot.Name] = true
+			}
+			var names []string
+			for _, vmSnapshot := range snapshots {
+				if !expired[vmSnapshot.Name] {
+					names = append(names, vmSnapshot.Name)
+				}
+			}
+			return names
+		}
+
+		name := func(t time.Time) string {
+			return fmt.Sprintf("%s-vm-%s", scheduleName, t.Format(scheduledSnapshotTimeFormat))
+		}
+
+		It("should keep all the snapshots without retention", func() {
+			snapshots := newSnapshots()
+			Expect(expiredScheduledSnapshots(nil, snapshots, now)).To(BeEmpty())
+		})
+
+		DescribeTable("should keep", func(retention *snapshotv1.SnapshotRetentionPolicy, expected ...time.Time) {
+			var expectedNames []string
+			for _, t := range expected {
+				expectedNames = append(expectedNames, name(t))
+			}
+			Expect(retainedNames(retention, newSnapshots())).To(Equal(expectedNames))
+		},
+			Entry("the last snapshots", &snapshotv1.SnapshotRetentionPolicy{Last: pointer.P(int32(3))},
+				now, now.Add(-12*time.Hour), now.Add(-24*time.Hour)),
+			Entry("the most recent snapshot of the last days", &snapshotv1.SnapshotRetentionPolicy{Daily: pointer.P(int32(3))},
+				now, now.Add(-12*time.Hour), now.Add(-36*time.Hour)),
+			Entry("the most recent snapshot of the last weeks", &snapshotv1.SnapshotRetentionPolicy{Weekly: pointer.P(int32(2))},
+				now, now.Add(-7*24*time.Hour+12*time.Hour)),
+			Entry("the most recent snapshot of the last months", &snapshotv1.SnapshotRetentionPolicy{Monthly: pointer.P(int32(3))},
+				now, time.Date(2024, time.February, 29, 14, 30, 0, 0, time.UTC), time.Date(2024, time.January, 31, 14, 30, 0, 0, time.UTC)),
+			Entry("the snapshots selected by any rule", &snapshotv1.SnapshotRetentionPolicy{Last: pointer.P(int32(2)), Daily: pointer.P(int32(3))},
+				now, now.Add(-12*time.Hour), now.Add(-36*time.Hour)),
+			Entry("the snapshots younger than the max age", &snapshotv1.SnapshotRetentionPolicy{MaxAge: &metav1.Duration{Duration: 24 * time.Hour}},
+				now, now.Add(-12*time.Hour), now.Add(-24*time.Hour)),
+			Entry("the snapshots selected by the rules and younger than the max age", &snapshotv1.SnapshotRetentionPolicy{Daily: pointer.P(int32(5)), MaxAge: &metav1.Duration{Duration: 36 * time.Hour}},
+				now, now.Add(-12*time.Hour), now.Add(-36*time.Hour)),
+		)
+
+		It("should keep snapshots in progress and expire failed snapshots followed by a success", func() {
+			snapshots := []*snapshotv1.VirtualMachineSnapshot{
+				newScheduledSnapshot("vm", now, snapshotv1.InProgress),
+				newScheduledSnapshot("vm", now.Add(-1*time.Hour), snapshotv1.Failed),
+				newScheduledSnapshot("vm", now.Add(-2*time.Hour), snapshotv1.Succeeded),
+				newScheduledSnapshot("vm", now.Add(-3*time.Hour), snapshotv1.Failed),
+				newScheduledSnapshot("vm", now.Add(-4*time.Hour), snapshotv1.Succeeded),
+				newScheduledSnapshot("other", now.Add(-4*time.Hour), snapshotv1.Succeeded),
+			}
+			retention := &snapshotv1.SnapshotRetentionPolicy{Last: pointer.P(int32(1))}
+			Expect(snapshotNames(expiredScheduledSnapshots(retention, snapshots, now))).To(Equal([]string{
+				snapshots[4].Name, snapshots[3].Name,
+			}))
+		})
+	})
+
+	Context("retention of failed snapshots", func() {
+		DescribeTable("should expire failed snapshots not followed by a success", func(retention *snapshotv1.SnapshotRetentionPolicy, expected ...int) {
+			snapshots := []*snapshotv1.VirtualMachineSnapshot{
+				newScheduledSnapshot("vm", now, snapshotv1.Failed),
+				newScheduledSnapshot("vm", now.Add(-1*time.Hour), snapshotv1.Failed),
+				newScheduledSnapshot("vm", now.Add(-25*time.Hour), snapshotv1.Failed),
+				newScheduledSnapshot("vm", now.Add(-26*time.Hour), snapshotv1.Failed),
+			}
+			var expectedNames []string
+			for _, i := range expected {
+				expectedNames = append(expectedNames, snapshots[i].Name)
+			}
+			Expect(snapshotNames(expiredScheduledSnapshots(retention, snapshots, now))).To(ConsistOf(expectedNames))
+		},
+			Entry("by count", &snapshotv1.SnapshotRetentionPolicy{Last: pointer.P(int32(2))}, 2, 3),
+			Entry("by age", &snapshotv1.SnapshotRetentionPolicy{MaxAge: &metav1.Duration{Duration: 24 * time.Hour}}, 2, 3),
+			Entry("by count and age", &snapshotv1.SnapshotRetentionPolicy{Last: pointer.P(int32(1)), MaxAge: &metav1.Duration{Duration: 24 * time.Hour}}, 1, 2, 3),
+		)
+	})
+
+	It("should shorten the names of the snapshots of long schedules and VMs", func() {
+		schedule := &snapshotv1.VirtualMachineSnapshotSchedule{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("s", validation.DNS1123SubdomainMaxLength)}}
+		name := scheduledSnapshotName(schedule, strings.Repeat("v", validation.DNS1123SubdomainMaxLength), now)
+		Expect(len(name)).To(BeNumerically("<=", validation.DNS1123SubdomainMaxLength))
+		Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+		Expect(name).To(HaveSuffix(now.UTC().Format(scheduledSnapshotTimeFormat)))
+	})
+
+	Context("with a schedule", func() {
+		var (
+			controller     *VMSnapshotScheduleController
+			kubevirtClient *kubevirtfake.Clientset
+			recorder       *record.FakeRecorder
+			schedule       *snapshotv1.VirtualMachineSnapshotSchedule
+		)
+
+		newVM := func(name string, labels map[string]string) *kubevirtv1.VirtualMachine {
+			return &kubevirtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: testNamespace,
+					Labels:    labels,
+				},
+				Spec: kubevirtv1.VirtualMachineSpec{
+					Template: &kubevirtv1.VirtualMachineInstanceTemplateSpec{},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+				Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+				Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+
+			scheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+			vmSnapshotInformer, _ := testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+			vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&kubevirtv1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+			recorder = record.NewFakeRecorder(100)
+			recorder.IncludeObject = true
+
+			controller = &VMSnapshotScheduleController{
+				Client:                     virtClient,
+				VMSnapshotScheduleInformer: scheduleInformer,
+				VMSnapshotInformer:         vmSnapshotInformer,
+				VMInformer:                 vmInformer,
+				Recorder:                   recorder,
+			}
+			Expect(controller.Init()).To(Succeed())
+
+			Expect(vmInformer.GetStore().Add(newVM("vm1", map[string]string{"backup": "nightly"}))).To(Succeed())
+			Expect(vmInformer.GetStore().Add(newVM("vm2", map[string]string{"backup": "nightly"}))).To(Succeed())
+			Expect(vmInformer.GetStore().Add(newVM("vm3", nil))).To(Succeed())
+
+			schedule = &snapshotv1.VirtualMachineSnapshotSchedule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              scheduleName,
+					Namespace:         testNamespace,
+					CreationTimestamp: metav1.Time{Time: now.Add(-24 * time.Hour)},
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+					Schedule: "0 2 * * *",
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"backup": "nightly"},
+					},
+				},
+				Status: &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+					LastScheduleTime: &metav1.Time{Time: now.Add(-24*time.Hour - 30*time.Minute)},
+				},
+			}
+
+			originalCurrentTime := currentTime
+			currentTime = func() *metav1.Time {
+				return &metav1.Time{Time: now}
+			}
+			DeferCleanup(func() { currentTime = originalCurrentTime })
+		})
+
+		addSnapshot := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+			ExpectWithOffset(1, controller.VMSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+			_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		}
+
+		expectedSnapshotName := func(vmName string) string {
+			return fmt.Sprintf("%s-%s-20240310-0200", scheduleName, vmName)
+		}
+
+		updatedSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+			var updated *snapshotv1.VirtualMachineSnapshotSchedule
+			for _, action := range kubevirtClient.Actions() {
+				if action.Matches("update", "virtualmachinesnapshotschedules") {
+					updated = action.(testing.UpdateAction).GetObject().(*snapshotv1.VirtualMachineSnapshotSchedule)
+				}
+			}
+			ExpectWithOffset(1, updated).ToNot(BeNil())
+			return updated
+		}
+
+		actionNames := func(verb string) []string {
+			var names []string
+			for _, action := range kubevirtClient.Actions() {
+				switch a := action.(type) {
+				case testing.CreateAction:
+					if verb == "create" && a.GetResource().Resource == "virtualmachinesnapshots" {
+						names = append(names, a.GetObject().(*snapshotv1.VirtualMachineSnapshot).Name)
+					}
+				case testing.DeleteAction:
+					if verb == "delete" && a.GetResource().Resource == "virtualmachinesnapshots" {
+						names = append(names, a.GetName())
+					}
+				}
+			}
+			return names
+		}
+
+		update := func() time.Duration {
+			_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace).Create(context.Background(), schedule, metav1.CreateOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			kubevirtClient.ClearActions()
+			requeue, err := controller.updateVMSnapshotSchedule(schedule)
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return requeue
+		}
+
+		It("should snapshot the selected VMs when the schedule is due", func() {
+			requeue := update()
+			Expect(requeue).To(Equal(23*time.Hour + 30*time.Minute))
+			Expect(actionNames("create")).To(Equal([]string{expectedSnapshotName("vm1"), expectedSnapshotName("vm2")}))
+
+			created, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), expectedSnapshotName("vm1"), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotScheduleLabel, scheduleName))
+			Expect(created.Spec.Source.Kind).To(Equal("VirtualMachine"))
+			Expect(created.Spec.Source.Name).To(Equal("vm1"))
+			testutils.ExpectEvent(recorder, scheduledSnapshotCreatedEvent)
+			testutils.ExpectEvent(recorder, scheduledSnapshotCreatedEvent)
+
+			updated := updatedSchedule()
+			Expect(updated.Status.LastScheduleTime.Time).To(Equal(time.Date(2024, time.March, 10, 2, 0, 0, 0, time.UTC)))
+			Expect(updated.Status.NextScheduleTime.Time).To(Equal(time.Date(2024, time.March, 11, 2, 0, 0, 0, time.UTC)))
+			Expect(updated.Status.Error).To(BeNil())
+			Expect(updated.Status.VirtualMachines).To(HaveLen(2))
+			Expect(updated.Status.VirtualMachines[0].Name).To(Equal("vm1"))
+			Expect(*updated.Status.VirtualMachines[0].LastSnapshotName).To(Equal(expectedSnapshotName("vm1")))
+		})
+
+		It("should not snapshot the VMs when the schedule is not due", func() {
+			schedule.Status.LastScheduleTime = &metav1.Time{Time: time.Date(2024, time.March, 10, 2, 0, 0, 0, time.UTC)}
+			update()
+			Expect(actionNames("create")).To(BeEmpty())
+		})
+
+		It("should not snapshot the VMs when the schedule is disabled", func() {
+			schedule.Spec.Disabled = pointer.P(true)
+			Expect(update()).To(BeZero())
+			Expect(actionNames("create")).To(BeEmpty())
+			Expect(updatedSchedule().Status.NextScheduleTime).To(BeNil())
+		})
+
+		It("should report an invalid schedule", func() {
+			schedule.Spec.Schedule = "invalid"
+			Expect(update()).To(BeZero())
+			Expect(actionNames("create")).To(BeEmpty())
+			Expect(*updatedSchedule().Status.Error.Message).To(ContainSubstring("invalid schedule"))
+		})
+
+		It("should record the failure to create a snapshot", func() {
+			kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+				if action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot).Spec.Source.Name == "vm2" {
+					return true, nil, fmt.Errorf("quota exceeded")
+				}
+				return false, nil, nil
+			})
+			update()
+			testutils.ExpectEvent(recorder, scheduledSnapshotCreatedEvent)
+			testutils.ExpectEvent(recorder, snapshotScheduleErrorEvent)
+
+			updated := updatedSchedule()
+			Expect(*updated.Status.Error.Message).To(ContainSubstring("quota exceeded"))
+			Expect(updated.Status.VirtualMachines[0].LastFailure).To(BeNil())
+			Expect(*updated.Status.VirtualMachines[1].LastFailure.Message).To(ContainSubstring("quota exceeded"))
+		})
+
+		It("should record the last success and failure of each VM", func() {
+			schedule.Status.LastScheduleTime = &metav1.Time{Time: time.Date(2024, time.March, 10, 2, 0, 0, 0, time.UTC)}
+			succeeded := newScheduledSnapshot("vm1", now.Add(-48*time.Hour), snapshotv1.Succeeded)
+			failed := newScheduledSnapshot("vm1", now.Add(-24*time.Hour), snapshotv1.Failed)
+			failed.Status.Error = &snapshotv1.Error{Message: pointer.P("deadline exceeded")}
+			addSnapshot(succeeded)
+			addSnapshot(failed)
+			update()
+
+			vmStatus := updatedSchedule().Status.VirtualMachines[0]
+			Expect(*vmStatus.LastSnapshotName).To(Equal(failed.Name))
+			Expect(*vmStatus.LastSuccessfulSnapshotName).To(Equal(succeeded.Name))
+			Expect(vmStatus.LastSuccessTime.Time).To(Equal(succeeded.Status.CreationTime.Time))
+			Expect(vmStatus.LastFailure.Time.Time).To(Equal(failed.Status.CreationTime.Time))
+			Expect(*vmStatus.LastFailure.Message).To(Equal(fmt.Sprintf("VirtualMachineSnapshot %s failed: deadline exceeded", failed.Name)))
+		})
+
+		It("should delete the expired snapshots", func() {
+			schedule.Status.LastScheduleTime = &metav1.Time{Time: time.Date(2024, time.March, 10, 2, 0, 0, 0, time.UTC)}
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetentionPolicy{Last: pointer.P(int32(2))}
+			var snapshots []*snapshotv1.VirtualMachineSnapshot
+			for i := 0; i < 4; i++ {
+				vmSnapshot := newScheduledSnapshot("vm1", now.Add(-time.Duration(i)*24*time.Hour), snapshotv1.Succeeded)
+				addSnapshot(vmSnapshot)
+				snapshots = append(snapshots, vmSnapshot)
+			}
+			unrelated := newScheduledSnapshot("vm1", now.Add(-96*time.Hour), snapshotv1.Succeeded)
+			unrelated.Labels = nil
+			addSnapshot(unrelated)
+			update()
+
+			Expect(actionNames("delete")).To(Equal([]string{snapshots[3].Name, snapshots[2].Name}))
+			testutils.ExpectEvent(recorder, scheduledSnapshotDeletedEvent)
+			testutils.ExpectEvent(recorder, scheduledSnapshotDeletedEvent)
+		})
+	})
+})
@@ -22,6 +22,7 @@ package snapshot
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -266,6 +267,7 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	var volumeSnapshotStatus []snapshotv1.VolumeSnapshotStatus
 	var deletedSnapshots, skippedSnapshots []string
 	var didFreeze bool
+	var hookResults []snapshotv1.SnapshotHookResult
 
 	vmSnapshot, err := ctrl.getVMSnapshot(content)
 	if err != nil {
@@ -278,6 +280,8 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 			log.Log.Warningf("Failed to unfreeze source for snapshot content %s/%s: %+v",
 				content.Namespace, content.Name, err)
 		}
+		// a hook still running is not recorded anymore
+		ctrl.snapshotHookRuns.Delete(content.UID)
 		err = ctrl.removeContentFinalizer(content)
 		if err != nil {
 			return 0, err
@@ -296,6 +300,9 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 
 	contentCreated := vmSnapshotContentCreated(content)
 	currentlyError := (content.Status != nil && content.Status.Error != nil) || vmSnapshotError(vmSnapshot) != nil
+	if content.Status != nil {
+		hookResults = slices.Clone(content.Status.HookResults)
+	}
 
 	for _, volumeBackup := range content.Spec.VolumeBackups {
 		if volumeBackup.VolumeSnapshotName == nil {
@@ -352,6 +359,23 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				}
 
 				if !frozen {
+					var hooksPending bool
+					hookResults, hooksPending, err = ctrl.runSnapshotHooks(content, vmSnapshot, source, snapshotv1.SnapshotHookStagePreFreeze, hookResults)
+					if err != nil {
+						return 0, err
+					}
+
+					if hooksPending {
+						return ctrl.updateSnapshotHookResults(content, hookResults)
+					}
+
+					if hookErr := snapshotHookStageError(vmSnapshot, hookResults, snapshotv1.SnapshotHookStagePreFreeze); hookErr != nil {
+						log.Log.Reason(hookErr).Warningf("Not freezing vm for snapshot content %s/%s", content.Namespace, content.Name)
+						currentlyError = true
+						skippedSnapshots = append(skippedSnapshots, vsName)
+						continue
+					}
+
 					err := source.Freeze()
 					if err != nil {
 						return 0, err
@@ -419,12 +443,44 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	}
 
 	if created && contentCpy.Status.CreationTime == nil {
-		contentCpy.Status.CreationTime = currentTime()
+		if !snapshotHooksStarted(hookResults, snapshotv1.SnapshotHookStagePostThaw) {
+			err = ctrl.unfreezeSource(vmSnapshot)
+			if err != nil {
+				return 0, err
+			}
+		}
 
-		err = ctrl.unfreezeSource(vmSnapshot)
+		var hooksPending bool
+		hookResults, hooksPending, err = ctrl.runPostThawHooks(content, vmSnapshot, hookResults)
 		if err != nil {
 			return 0, err
 		}
+
+		if hooksPending {
+			return ctrl.updateSnapshotHookResults(content, hookResults)
+		}
+
+		if snapshotHookError(vmSnapshot, hookResults) != nil {
+			// the snapshot is not complete when a post thaw hook aborts it
+			ready = false
+		} else {
+			contentCpy.Status.CreationTime = currentTime()
+		}
+	} else if snapshotHookStageError(vmSnapshot, hookResults, snapshotv1.SnapshotHookStagePreFreeze) != nil {
+		// the post thaw hooks undo what the pre freeze hooks run before the abort did
+		var hooksPending bool
+		hookResults, hooksPending, err = ctrl.runPostThawHooks(content, vmSnapshot, hookResults)
+		if err != nil {
+			return 0, err
+		}
+
+		if hooksPending {
+			return ctrl.updateSnapshotHookResults(content, hookResults)
+		}
+	}
+
+	if err := snapshotHookError(vmSnapshot, hookResults); err != nil {
+		errorMessage = err.Error()
 	}
 
 	if errorMessage != "" && !ready {
@@ -436,6 +492,7 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 
 	contentCpy.Status.ReadyToUse = &ready
 	contentCpy.Status.VolumeSnapshotStatus = volumeSnapshotStatus
+	contentCpy.Status.HookResults = hookResults
 
 	if !equality.Semantic.DeepEqual(content, contentCpy) {
 		if _, err := ctrl.Client.VirtualMachineSnapshotContent(contentCpy.Namespace).Update(context.Background(), contentCpy, metav1.UpdateOptions{}); err != nil {
@@ -693,6 +750,7 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		vmSnapshotCpy.Status.CreationTime = content.Status.CreationTime
 		vmSnapshotCpy.Status.ReadyToUse = content.Status.ReadyToUse
 		vmSnapshotCpy.Status.Error = content.Status.Error
+		vmSnapshotCpy.Status.HookResults = content.Status.HookResults
 	}
 
 	// terminal phase 1 - failed
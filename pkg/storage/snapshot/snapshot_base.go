@@ -94,6 +94,9 @@ type VMSnapshotController struct {
 	eventHandlerMap    map[string]cache.ResourceEventHandlerFuncs
 
 	vmStatusUpdater *status.VMStatusUpdater
+
+	// hooks running in the background, keyed by snapshot content UID
+	snapshotHookRuns sync.Map
 }
 
 var supportedCRDVersions = []string{"v1"}
@@ -39,6 +39,7 @@ import (
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/status"
@@ -1625,6 +1626,389 @@ var _ = Describe("Snapshot controlleer", func() {
 				Entry("created and ready", timeFunc(), true),
 			)
 
+			Context("with snapshot hooks", func() {
+				var (
+					vm                *v1.VirtualMachine
+					vmSnapshot        *snapshotv1.VirtualMachineSnapshot
+					vmSnapshotContent *snapshotv1.VirtualMachineSnapshotContent
+					updatedVMSnapshot *snapshotv1.VirtualMachineSnapshot
+				)
+
+				hookResult := func(name string, stage snapshotv1.SnapshotHookStage, exitCode int32) snapshotv1.SnapshotHookResult {
+					result := snapshotv1.SnapshotHookResult{
+						Name:           name,
+						Stage:          stage,
+						Phase:          snapshotv1.SnapshotHookSucceeded,
+						StartTime:      timeFunc(),
+						CompletionTime: timeFunc(),
+						ExitCode:       pointer.P(exitCode),
+						Output:         "output",
+					}
+					if exitCode != 0 {
+						result.Phase = snapshotv1.SnapshotHookFailed
+						result.Message = pointer.P(fmt.Sprintf("command exited with code %d", exitCode))
+					}
+					return result
+				}
+
+				runningHookResult := func(name string, stage snapshotv1.SnapshotHookStage) snapshotv1.SnapshotHookResult {
+					return snapshotv1.SnapshotHookResult{
+						Name:      name,
+						Stage:     stage,
+						Phase:     snapshotv1.SnapshotHookRunning,
+						StartTime: timeFunc(),
+					}
+				}
+
+				BeforeEach(func() {
+					storageClassSource.Add(createStorageClass())
+					pvcs := createPersistentVolumeClaims()
+					for i := range pvcs {
+						pvcSource.Add(&pvcs[i])
+					}
+
+					vm = createLockedVM()
+					vmSource.Add(vm)
+					vmi := createVMI(vm)
+					vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+						Type:          v1.VirtualMachineInstanceAgentConnected,
+						LastProbeTime: metav1.Now(),
+						Status:        corev1.ConditionTrue,
+					})
+					vmiSource.Add(vmi)
+
+					vmSnapshot = createVMSnapshotInProgress()
+					vmSnapshot.Spec.Hooks = &snapshotv1.SnapshotHooks{
+						PreFreeze: []snapshotv1.SnapshotHook{
+							{Name: "flush", Command: []string{"/bin/sync"}},
+						},
+						PostThaw: []snapshotv1.SnapshotHook{
+							{Name: "resume", Command: []string{"/bin/resume"}},
+						},
+					}
+					vmSnapshot.Status.Indications = append(vmSnapshot.Status.Indications, snapshotv1.VMSnapshotOnlineSnapshotIndication)
+					updatedVMSnapshot = vmSnapshot.DeepCopy()
+					vmSnapshot.Status.Indications = append(vmSnapshot.Status.Indications, snapshotv1.VMSnapshotNoGuestAgentIndication)
+					updatedVMSnapshot.ResourceVersion = "1"
+					updatedVMSnapshot.Status.Indications = append(updatedVMSnapshot.Status.Indications, snapshotv1.VMSnapshotGuestAgentIndication)
+
+					vmSnapshotContent = createVMSnapshotContent()
+					vmSnapshotContent.UID = contentUID
+				})
+
+				It("should start the pre freeze hooks in the background before freezing the vm", func() {
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							runningHookResult("flush", snapshotv1.SnapshotHookStagePreFreeze),
+						},
+					}
+
+					vmiInterface.EXPECT().GuestExec(gomock.Any(), vm.Name, &v1.GuestExecOptions{
+						Command:        "/bin/sync",
+						Args:           []string{},
+						TimeoutSeconds: 30,
+					}).Return(v1.GuestExecResult{StdOut: "output"}, nil)
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					// the retry of the content and its enqueue once the hook completed
+					mockVMSnapshotContentQueue.ExpectAdds(2)
+					controller.processVMSnapshotContentWorkItem()
+					mockVMSnapshotContentQueue.Wait()
+
+					obj, exists := controller.snapshotHookRuns.Load(types.UID(contentUID))
+					Expect(exists).To(BeTrue())
+					run := obj.(*snapshotHookRun)
+					Expect(run.completed()).To(BeTrue())
+					Expect(run.result.Phase).To(Equal(snapshotv1.SnapshotHookSucceeded))
+					Expect(run.result.Output).To(Equal("output"))
+				})
+
+				It("should not start a hook again when its running result was not persisted", func() {
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					run := &snapshotHookRun{
+						stage: snapshotv1.SnapshotHookStagePreFreeze,
+						name:  "flush",
+						done:  make(chan struct{}),
+					}
+					controller.snapshotHookRuns.Store(types.UID(contentUID), run)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							runningHookResult("flush", snapshotv1.SnapshotHookStagePreFreeze),
+						},
+					}
+
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					controller.processVMSnapshotContentWorkItem()
+
+					obj, exists := controller.snapshotHookRuns.Load(types.UID(contentUID))
+					Expect(exists).To(BeTrue())
+					Expect(obj).To(BeIdenticalTo(run))
+				})
+
+				It("should cap the timeout of the hooks", func() {
+					vmSnapshot.Spec.Hooks.PreFreeze[0].Timeout = &metav1.Duration{Duration: time.Hour}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					vmiInterface.EXPECT().GuestExec(gomock.Any(), vm.Name, &v1.GuestExecOptions{
+						Command:        "/bin/sync",
+						Args:           []string{},
+						TimeoutSeconds: int32(MaxSnapshotHookTimeout.Seconds()),
+					}).Return(v1.GuestExecResult{}, nil)
+					vmSnapshotClient.Fake.PrependReactor("update", "virtualmachinesnapshotcontents", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						return true, action.(testing.UpdateAction).GetObject(), nil
+					})
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					mockVMSnapshotContentQueue.ExpectAdds(2)
+					controller.processVMSnapshotContentWorkItem()
+					mockVMSnapshotContentQueue.Wait()
+				})
+
+				It("should record the result of a completed hook before running the next one", func() {
+					vmSnapshot.Spec.Hooks.PreFreeze = append(vmSnapshot.Spec.Hooks.PreFreeze, snapshotv1.SnapshotHook{
+						Name: "lock", Command: []string{"/bin/lock"},
+					})
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							runningHookResult("flush", snapshotv1.SnapshotHookStagePreFreeze),
+						},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					run := &snapshotHookRun{
+						stage:  snapshotv1.SnapshotHookStagePreFreeze,
+						name:   "flush",
+						done:   make(chan struct{}),
+						result: hookResult("flush", snapshotv1.SnapshotHookStagePreFreeze, 0),
+					}
+					close(run.done)
+					controller.snapshotHookRuns.Store(types.UID(contentUID), run)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status.HookResults = []snapshotv1.SnapshotHookResult{
+						hookResult("flush", snapshotv1.SnapshotHookStagePreFreeze, 0),
+					}
+
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					controller.processVMSnapshotContentWorkItem()
+
+					_, exists := controller.snapshotHookRuns.Load(types.UID(contentUID))
+					Expect(exists).To(BeFalse())
+				})
+
+				It("should fail a running hook which is not running anymore", func() {
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							runningHookResult("flush", snapshotv1.SnapshotHookStagePreFreeze),
+						},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					result := runningHookResult("flush", snapshotv1.SnapshotHookStagePreFreeze)
+					result.Phase = snapshotv1.SnapshotHookFailed
+					result.CompletionTime = timeFunc()
+					result.Message = pointer.P(snapshotHookLostMessage)
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status.HookResults = []snapshotv1.SnapshotHookResult{result}
+
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					controller.processVMSnapshotContentWorkItem()
+					testutils.ExpectEvent(recorder, "SnapshotHookFailed")
+				})
+
+				DescribeTable("should freeze the vm once the pre freeze hooks completed", func(failurePolicy snapshotv1.SnapshotHookFailurePolicy, exitCode int32) {
+					vmSnapshot.Spec.Hooks.PreFreeze[0].FailurePolicy = pointer.P(failurePolicy)
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							hookResult("flush", snapshotv1.SnapshotHookStagePreFreeze, exitCode),
+						},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					for _, volumeSnapshot := range createVolumeSnapshots(vmSnapshotContent) {
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: volumeSnapshot.Name,
+						})
+					}
+
+					vmiInterface.EXPECT().Freeze(context.Background(), vm.Name, 0*time.Second).Return(nil)
+					expectVMSnapshotUpdate(vmSnapshotClient, updatedVMSnapshot)
+					expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClassName, vmSnapshotContent)
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					controller.processVMSnapshotContentWorkItem()
+					testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+				},
+					Entry("when they succeeded", snapshotv1.SnapshotHookFailurePolicyAbort, int32(0)),
+					Entry("when a failed hook does not abort the snapshot", snapshotv1.SnapshotHookFailurePolicyContinue, int32(2)),
+				)
+
+				It("should not freeze the vm and run the post thaw hooks when a pre freeze hook aborts the snapshot", func() {
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							hookResult("flush", snapshotv1.SnapshotHookStagePreFreeze, 1),
+						},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status.HookResults = append(updatedContent.Status.HookResults,
+						runningHookResult("resume", snapshotv1.SnapshotHookStagePostThaw),
+					)
+
+					vmiInterface.EXPECT().GuestExec(gomock.Any(), vm.Name, gomock.Any()).Return(v1.GuestExecResult{}, nil)
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					mockVMSnapshotContentQueue.ExpectAdds(2)
+					controller.processVMSnapshotContentWorkItem()
+					mockVMSnapshotContentQueue.Wait()
+				})
+
+				It("should fail the snapshot once the post thaw hooks run after a pre freeze hook aborted it", func() {
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							hookResult("flush", snapshotv1.SnapshotHookStagePreFreeze, 1),
+							hookResult("resume", snapshotv1.SnapshotHookStagePostThaw, 0),
+						},
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					errorMessage := "PreFreeze hook flush failed: command exited with code 1"
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status.Error = &snapshotv1.Error{
+						Time:    timeFunc(),
+						Message: &errorMessage,
+					}
+
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					controller.processVMSnapshotContentWorkItem()
+				})
+
+				It("should unfreeze the vm before starting the post thaw hooks", func() {
+					preFreezeResult := hookResult("flush", snapshotv1.SnapshotHookStagePreFreeze, 0)
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse:  &f,
+						HookResults: []snapshotv1.SnapshotHookResult{preFreezeResult},
+					}
+					volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+					for i := range volumeSnapshots {
+						volumeSnapshots[i].Status.ReadyToUse = &t
+						volumeSnapshots[i].Status.CreationTime = timeFunc()
+						volumeSnapshotSource.Add(&volumeSnapshots[i])
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status.HookResults = append(updatedContent.Status.HookResults,
+						runningHookResult("resume", snapshotv1.SnapshotHookStagePostThaw),
+					)
+
+					gomock.InOrder(
+						vmiInterface.EXPECT().Unfreeze(context.Background(), vm.Name).Return(nil),
+						vmiInterface.EXPECT().GuestExec(gomock.Any(), vm.Name, &v1.GuestExecOptions{
+							Command:        "/bin/resume",
+							Args:           []string{},
+							TimeoutSeconds: 30,
+						}).Return(v1.GuestExecResult{StdOut: "output"}, nil),
+					)
+					expectVMSnapshotUpdate(vmSnapshotClient, updatedVMSnapshot)
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					mockVMSnapshotContentQueue.ExpectAdds(2)
+					controller.processVMSnapshotContentWorkItem()
+					mockVMSnapshotContentQueue.Wait()
+				})
+
+				DescribeTable("should complete the snapshot once the post thaw hooks completed", func(exitCode int32) {
+					vmSnapshot.Spec.Hooks.PostThaw = append(vmSnapshot.Spec.Hooks.PostThaw, snapshotv1.SnapshotHook{
+						Name: "unlock", Command: []string{"/bin/unlock"},
+					})
+					preFreezeResult := hookResult("flush", snapshotv1.SnapshotHookStagePreFreeze, 0)
+					vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+						HookResults: []snapshotv1.SnapshotHookResult{
+							preFreezeResult,
+							hookResult("resume", snapshotv1.SnapshotHookStagePostThaw, exitCode),
+							hookResult("unlock", snapshotv1.SnapshotHookStagePostThaw, 0),
+						},
+					}
+					volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+					for i := range volumeSnapshots {
+						volumeSnapshots[i].Status.ReadyToUse = &t
+						volumeSnapshots[i].Status.CreationTime = timeFunc()
+						volumeSnapshotSource.Add(&volumeSnapshots[i])
+						vmSnapshotContent.Status.VolumeSnapshotStatus = append(vmSnapshotContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: volumeSnapshots[i].Name,
+						})
+					}
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+
+					ready := exitCode == 0
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status.ReadyToUse = &ready
+					updatedContent.Status.VolumeSnapshotStatus = nil
+					if ready {
+						updatedContent.Status.CreationTime = timeFunc()
+					} else {
+						errorMessage := "PostThaw hook resume failed: command exited with code 1"
+						updatedContent.Status.Error = &snapshotv1.Error{
+							Time:    timeFunc(),
+							Message: &errorMessage,
+						}
+					}
+					for i := range volumeSnapshots {
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: volumeSnapshots[i].Name,
+							ReadyToUse:         volumeSnapshots[i].Status.ReadyToUse,
+							CreationTime:       volumeSnapshots[i].Status.CreationTime,
+						})
+					}
+
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+					controller.processVMSnapshotContentWorkItem()
+				},
+					Entry("and succeeded", int32(0)),
+					Entry("and one of them aborted it", int32(1)),
+				)
+			})
+
 			DescribeTable("should attempt to unfreeze vm and remove content finalizer if vmsnapshot deleting", func(freezeError error) {
 				vm := createLockedVM()
 				vmSource.Add(vm)
@@ -58,6 +58,7 @@ type snapshotSource interface {
 	Frozen() (bool, error)
 	Freeze() error
 	Unfreeze() error
+	Exec(command []string, timeout time.Duration) (kubevirtv1.GuestExecResult, error)
 	Spec() (snapshotv1.SourceSpec, error)
 	PersistentVolumeClaims() (map[string]string, error)
 }
@@ -361,6 +362,29 @@ func (s *vmSnapshotSource) Unfreeze() error {
 	return nil
 }
 
+func (s *vmSnapshotSource) Exec(command []string, timeout time.Duration) (kubevirtv1.GuestExecResult, error) {
+	exists, err := s.GuestAgent()
+	if err != nil {
+		return kubevirtv1.GuestExecResult{}, err
+	}
+	if !exists {
+		return kubevirtv1.GuestExecResult{}, fmt.Errorf("guest agent is not connected")
+	}
+
+	opts := &kubevirtv1.GuestExecOptions{
+		Command:        command[0],
+		Args:           command[1:],
+		TimeoutSeconds: int32(timeout.Seconds()),
+	}
+
+	// give the request a bit more time than the command
+	ctx, cancel := context.WithTimeout(context.Background(), timeout+snapshotRetryInterval)
+	defer cancel()
+
+	defer timeTrack(time.Now(), fmt.Sprintf("Running %s in vmi %s", command[0], s.vm.Name))
+	return s.controller.Client.VirtualMachineInstance(s.vm.Namespace).GuestExec(ctx, s.vm.Name, opts)
+}
+
 func (s *vmSnapshotSource) PersistentVolumeClaims() (map[string]string, error) {
 	return storagetypes.GetPVCsFromVolumes(s.vm.Spec.Template.Spec.Volumes), nil
 }
@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// CheckMaintenanceWindows returns whether one of the maintenance windows is open at the given time. When none of
+// them is open, the time at which the next one opens is returned as well. It is zero if no window ever opens.
+func CheckMaintenanceWindows(windows []v1.MaintenanceWindow, now time.Time) (open bool, nextStart time.Time, err error) {
+	now = now.UTC()
+	for _, window := range windows {
+		schedule, err := cron.ParseStandard(window.Schedule)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("invalid maintenance window schedule %q: %v", window.Schedule, err)
+		}
+
+		// The window is open if it opened less than its duration ago
+		start := schedule.Next(now.Add(-window.Duration.Duration))
+		if start.IsZero() {
+			continue
+		}
+		if !start.After(now) {
+			return true, time.Time{}, nil
+		}
+		if nextStart.IsZero() || start.Before(nextStart) {
+			nextStart = start
+		}
+	}
+
+	return false, nextStart, nil
+}
+
+// ValidateMaintenanceWindows validates the schedules and durations of maintenance windows.
+func ValidateMaintenanceWindows(field *k8sfield.Path, windows []v1.MaintenanceWindow) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for i, window := range windows {
+		if _, err := cron.ParseStandard(window.Schedule); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid cron expression: %v", err),
+				Field:   field.Index(i).Child("schedule").String(),
+			})
+		}
+		if window.Duration.Duration <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must be greater than zero",
+				Field:   field.Index(i).Child("duration").String(),
+			})
+		}
+	}
+	return causes
+}
@@ -0,0 +1,80 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Maintenance windows", func() {
+
+	nightly := v1.MaintenanceWindow{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: 6 * time.Hour}}
+	weekend := v1.MaintenanceWindow{Schedule: "0 8 * * sat", Duration: metav1.Duration{Duration: 2 * time.Hour}}
+
+	parseTime := func(value string) time.Time {
+		t, err := time.Parse(time.RFC3339, value)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		return t
+	}
+
+	DescribeTable("should report", func(windows []v1.MaintenanceWindow, now string, expectedOpen bool, expectedNextStart string) {
+		open, nextStart, err := CheckMaintenanceWindows(windows, parseTime(now))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(open).To(Equal(expectedOpen))
+		if expectedNextStart == "" {
+			Expect(nextStart).To(BeZero())
+		} else {
+			Expect(nextStart).To(Equal(parseTime(expectedNextStart)))
+		}
+	},
+		Entry("an open window", []v1.MaintenanceWindow{nightly}, "2024-03-09T23:30:00Z", true, ""),
+		Entry("a window open since the previous day", []v1.MaintenanceWindow{nightly}, "2024-03-10T03:59:00Z", true, ""),
+		Entry("a window that just opened", []v1.MaintenanceWindow{nightly}, "2024-03-09T22:00:00Z", true, ""),
+		Entry("a window that just closed", []v1.MaintenanceWindow{nightly}, "2024-03-10T04:00:00Z", false, "2024-03-10T22:00:00Z"),
+		Entry("a window in another time zone", []v1.MaintenanceWindow{nightly}, "2024-03-10T00:30:00+02:00", true, ""),
+		Entry("the earliest of the next windows", []v1.MaintenanceWindow{nightly, weekend}, "2024-03-09T07:00:00Z", false, "2024-03-09T08:00:00Z"),
+		Entry("any of the open windows", []v1.MaintenanceWindow{nightly, weekend}, "2024-03-09T09:00:00Z", true, ""),
+		Entry("no open window if there are no windows", nil, "2024-03-09T09:00:00Z", false, ""),
+	)
+
+	It("should fail on invalid schedules", func() {
+		_, _, err := CheckMaintenanceWindows([]v1.MaintenanceWindow{{Schedule: "0 25 * * *", Duration: nightly.Duration}}, time.Now())
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should validate maintenance windows", func() {
+		causes := ValidateMaintenanceWindows(k8sfield.NewPath("spec", "maintenanceWindows"), []v1.MaintenanceWindow{
+			nightly,
+			{Schedule: "every night", Duration: metav1.Duration{Duration: time.Hour}},
+			{Schedule: "0 22 * * *"},
+		})
+		Expect(causes).To(HaveLen(2))
+		Expect(causes[0].Field).To(Equal("spec.maintenanceWindows[1].schedule"))
+		Expect(causes[1].Field).To(Equal("spec.maintenanceWindows[2].duration"))
+	})
+})
@@ -58,6 +58,22 @@ func FilterRunningMigrations(migrations []*v1.VirtualMachineInstanceMigration) [
 	return runningMigrations
 }
 
+// PriorityRank returns the rank of the priority of a migration. Pending migrations with a higher rank are started
+// first. Migrations without a priority are ranked like user-triggered migrations.
+func PriorityRank(migration *v1.VirtualMachineInstanceMigration) int {
+	if migration.Spec.Priority == nil {
+		return 1
+	}
+	switch *migration.Spec.Priority {
+	case v1.MigrationPrioritySystemCritical:
+		return 2
+	case v1.MigrationPrioritySystemMaintenance:
+		return 0
+	default:
+		return 1
+	}
+}
+
 // IsMigrating returns true if a given VMI is still migrating and false otherwise.
 func IsMigrating(vmi *v1.VirtualMachineInstance) bool {
 	if vmi == nil {
@@ -91,6 +107,13 @@ func MigrationFailed(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// IsMigrationReceiver returns true if the VMI waits to receive a decentralized migration from another cluster.
+// Its pod is scheduled, but the guest is not started.
+func IsMigrationReceiver(vmi *v1.VirtualMachineInstance) bool {
+	_, isReceiver := vmi.Annotations[v1.MigrationReceiverAnnotation]
+	return isReceiver && vmi.Status.Phase == v1.Scheduled
+}
+
 func VMIEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) *v1.EvictionStrategy {
 	if vmi != nil && vmi.Spec.EvictionStrategy != nil {
 		return vmi.Spec.EvictionStrategy
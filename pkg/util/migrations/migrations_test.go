@@ -0,0 +1,44 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Migrations", func() {
+
+	DescribeTable("should rank migration priorities", func(priority *v1.MigrationPriority, otherPriority *v1.MigrationPriority, higher bool) {
+		migration := &v1.VirtualMachineInstanceMigration{Spec: v1.VirtualMachineInstanceMigrationSpec{Priority: priority}}
+		otherMigration := &v1.VirtualMachineInstanceMigration{Spec: v1.VirtualMachineInstanceMigrationSpec{Priority: otherPriority}}
+		Expect(PriorityRank(migration) > PriorityRank(otherMigration)).To(Equal(higher))
+	},
+		Entry("system-critical above user-triggered", pointer.P(v1.MigrationPrioritySystemCritical), pointer.P(v1.MigrationPriorityUserTriggered), true),
+		Entry("user-triggered above system-maintenance", pointer.P(v1.MigrationPriorityUserTriggered), pointer.P(v1.MigrationPrioritySystemMaintenance), true),
+		Entry("no priority above system-maintenance", nil, pointer.P(v1.MigrationPrioritySystemMaintenance), true),
+		Entry("no priority like user-triggered", nil, pointer.P(v1.MigrationPriorityUserTriggered), false),
+		Entry("system-maintenance below system-critical", pointer.P(v1.MigrationPrioritySystemMaintenance), pointer.P(v1.MigrationPrioritySystemCritical), false),
+	)
+})
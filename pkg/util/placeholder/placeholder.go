@@ -0,0 +1,42 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+// Package placeholder handles the {{ .Index }} placeholder, which is replaced by the index of
+// every VirtualMachine stamped out of a single template by pools and bulk clones.
+package placeholder
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// Index is the placeholder replaced by the index of a VirtualMachine
+const Index = "{{ .Index }}"
+
+var indexPattern = regexp.MustCompile(`\{\{\s*\.Index\s*\}\}`)
+
+// HasIndex returns true if the text contains the index placeholder
+func HasIndex(text string) bool {
+	return indexPattern.MatchString(text)
+}
+
+// ReplaceIndex replaces every index placeholder in the text with the given index
+func ReplaceIndex(text string, index int) string {
+	return indexPattern.ReplaceAllLiteralString(text, strconv.Itoa(index))
+}
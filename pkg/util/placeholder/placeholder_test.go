@@ -0,0 +1,40 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package placeholder
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Index placeholder", func() {
+	DescribeTable("should detect the placeholder", func(text string, expected bool) {
+		Expect(HasIndex(text)).To(Equal(expected))
+	},
+		Entry("with spaces", "vm-{{ .Index }}", true),
+		Entry("without spaces", "vm-{{.Index}}", true),
+		Entry("without the placeholder", "vm-index", false),
+		Entry("with another field", "vm-{{ .Other }}", false),
+	)
+
+	It("should replace every placeholder", func() {
+		Expect(ReplaceIndex("vm-{{ .Index }}-{{.Index}}-{{ .Other }}", 3)).To(Equal("vm-3-3-{{ .Other }}"))
+	})
+})
@@ -28,6 +28,9 @@ import (
 	"kubevirt.io/api/clone"
 	clonev1lpha1 "kubevirt.io/api/clone/v1alpha1"
 
+	"kubevirt.io/api/hooks"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
+
 	"kubevirt.io/api/instancetype"
 
 	"kubevirt.io/api/migrations"
@@ -62,6 +65,7 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		exportApiServiceDefinitions,
 		instancetypeApiServiceDefinitions,
 		migrationPoliciesApiServiceDefinitions,
+		hookSidecarsApiServiceDefinitions,
 		poolApiServiceDefinitions,
 		vmCloneDefinitions,
 	} {
@@ -124,6 +128,7 @@ func kubevirtApiServiceDefinitions() []*restful.WebService {
 func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
@@ -141,6 +146,11 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws, err = genericNamespacedResourceProxy(ws, vmrGVR, &snapshotv1.VirtualMachineRestore{}, "VirtualMachineRestore", &snapshotv1.VirtualMachineRestoreList{})
 	if err != nil {
 		panic(err)
@@ -193,6 +203,26 @@ func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func hookSidecarsApiServiceDefinitions() []*restful.WebService {
+	hookSidecarGVR := hooksv1alpha1.SchemeGroupVersion.WithResource(hooks.ResourceHookSidecars)
+
+	ws, err := groupVersionProxyBase(hooksv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, hookSidecarGVR, &hooksv1alpha1.HookSidecar{}, hooksv1alpha1.HookSidecarKind.Kind, &hooksv1alpha1.HookSidecarList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(hookSidecarGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
 func instancetypeApiServiceDefinitions() []*restful.WebService {
 	instancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.PluralResourceName)
 	clusterInstancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.ClusterPluralResourceName)
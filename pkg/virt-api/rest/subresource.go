@@ -20,6 +20,7 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	goerror "errors"
@@ -298,12 +299,14 @@ func (app *SubresourceAPIApp) MigrateVMRequestHandler(request *restful.Request,
 	}
 
 	createMigrationJob := func() *errors.StatusError {
+		priority := v1.MigrationPriorityUserTriggered
 		_, err := app.virtCli.VirtualMachineInstanceMigration(namespace).Create(context.Background(), &v1.VirtualMachineInstanceMigration{
 			ObjectMeta: k8smetav1.ObjectMeta{
 				GenerateName: "kubevirt-migrate-vm-",
 			},
 			Spec: v1.VirtualMachineInstanceMigrationSpec{
-				VMIName: name,
+				VMIName:  name,
+				Priority: &priority,
 			},
 		}, k8smetav1.CreateOptions{DryRun: bodyStruct.DryRun})
 		if err != nil {
@@ -805,6 +808,74 @@ func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Reque
 	app.putRequestHandler(request, response, validate, getURL, false)
 }
 
+// GuestExecVMIRequestHandler runs a command in the guest through the guest agent and returns its result
+func (app *SubresourceAPIApp) GuestExecVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: guest exec options are required"), response)
+		return
+	}
+
+	opts := &v1.GuestExecOptions{}
+	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+
+	if opts.Command == "" {
+		writeError(errors.NewBadRequest("Command is required"), response)
+		return
+	}
+
+	if opts.TimeoutSeconds < 0 {
+		writeError(errors.NewBadRequest("TimeoutSeconds must not be negative"), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestExecURI(vmi)
+	}
+
+	_, url, conn, statusErr := app.prepareConnection(request, validate, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	resp, err := conn.PutWithResponse(url, io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	result := v1.GuestExecResult{}
+	if err := json.Unmarshal([]byte(resp), &result); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteEntity(result)
+}
+
 func (app *SubresourceAPIApp) fetchVirtualMachine(name string, namespace string) (*v1.VirtualMachine, *errors.StatusError) {
 
 	vm, err := app.virtCli.VirtualMachine(namespace).Get(context.Background(), name, k8smetav1.GetOptions{})
@@ -1874,6 +1874,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			migrateClient.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).Do(
 				func(ctx context.Context, obj interface{}, opts k8smetav1.CreateOptions) {
 					Expect(opts.DryRun).To(BeEquivalentTo(migrateOptions.DryRun))
+					Expect(obj.(*v1.VirtualMachineInstanceMigration).Spec.Priority).To(HaveValue(Equal(v1.MigrationPriorityUserTriggered)))
 				}).Return(&migration, nil)
 			app.MigrateVMRequestHandler(request, response)
 
@@ -83,6 +83,11 @@ func addMigrationSelectorLabel(migration *v1.VirtualMachineInstanceMigration) {
 	}
 
 	migration.Labels[v1.MigrationSelectorLabel] = migration.Spec.VMIName
+	if migration.Spec.SendTo != nil {
+		migration.Labels[v1.MigrationIDLabel] = migration.Spec.SendTo.MigrationID
+	} else if migration.Spec.Receive != nil {
+		migration.Labels[v1.MigrationIDLabel] = migration.Spec.Receive.MigrationID
+	}
 }
 
 func addMigrationFinalizer(migration *v1.VirtualMachineInstanceMigration) {
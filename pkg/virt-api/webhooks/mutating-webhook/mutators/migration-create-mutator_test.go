@@ -59,6 +59,23 @@ var _ = Describe("VirtualMachineInstanceMigration Mutator", func() {
 			},
 		))
 	})
+
+	It("Should label decentralized migrations with their migration ID", func() {
+		migration := newMigration()
+		migration.Spec.Receive = &v1.VirtualMachineInstanceMigrationTarget{MigrationID: "migration-id"}
+
+		admissionReview, err := newAdmissionReviewForVMIMCreation(migration)
+		Expect(err).ToNot(HaveOccurred())
+
+		mutator := &mutators.MigrationCreateMutator{}
+
+		expectedObjectMeta := expectedMigrationObjectMeta(migration.ObjectMeta, migration.Spec.VMIName)
+		expectedObjectMeta.Labels[v1.MigrationIDLabel] = "migration-id"
+		expectedJSONPatch, err := expectedJSONPatchForVMIMCreation(expectedObjectMeta)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mutator.Mutate(admissionReview).Patch).To(Equal(expectedJSONPatch))
+	})
 })
 
 func newMigration() *v1.VirtualMachineInstanceMigration {
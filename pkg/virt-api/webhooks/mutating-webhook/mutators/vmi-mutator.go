@@ -34,6 +34,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
@@ -44,6 +45,7 @@ import (
 type VMIsMutator struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	VMIPresetInformer       cache.SharedIndexInformer
+	HookSidecarInformer     cache.SharedIndexInformer
 	KubeVirtServiceAccounts map[string]struct{}
 }
 
@@ -110,6 +112,10 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			}
 		}
 
+		if err = mutator.selectHookSidecars(newVMI); err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
 		// Add foreground finalizer
 		newVMI.Finalizers = append(newVMI.Finalizers, v1.VirtualMachineInstanceFinalizer)
 
@@ -171,6 +177,26 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 	return response
 }
 
+// selectHookSidecars records the HookSidecar objects whose selector matches the new VMI,
+// the pods of its migration targets get the same sidecars even when the objects change
+func (mutator *VMIsMutator) selectHookSidecars(vmi *v1.VirtualMachineInstance) error {
+	delete(vmi.Annotations, hooks.SelectedHookSidecarNamesAnnotationName)
+	if !mutator.ClusterConfig.HookSidecarRegistryEnabled() || mutator.HookSidecarInformer == nil {
+		return nil
+	}
+
+	selected, err := hooks.SelectHookSidecarNames(vmi, mutator.HookSidecarInformer.GetStore())
+	if err != nil || len(selected) == 0 {
+		return err
+	}
+
+	if vmi.Annotations == nil {
+		vmi.Annotations = map[string]string{}
+	}
+	vmi.Annotations[hooks.SelectedHookSidecarNamesAnnotationName] = strings.Join(selected, ",")
+	return nil
+}
+
 func addNodeSelector(vmi *v1.VirtualMachineInstance, label string) {
 	if vmi.Spec.NodeSelector == nil {
 		vmi.Spec.NodeSelector = map[string]string{}
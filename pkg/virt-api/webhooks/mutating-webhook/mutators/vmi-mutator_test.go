@@ -41,8 +41,10 @@ import (
 	"kubevirt.io/client-go/api"
 
 	v1 "kubevirt.io/api/core/v1"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -592,6 +594,39 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(exist).To(BeTrue())
 	})
 
+	DescribeTable("should record the hook sidecars selected by the VMI labels", func(featureGates []string, expected string) {
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+				},
+			},
+		})
+		hookSidecarInformer, _ := testutils.NewFakeInformerFor(&hooksv1alpha1.HookSidecar{})
+		for _, hookSidecar := range []*hooksv1alpha1.HookSidecar{
+			{ObjectMeta: k8smetav1.ObjectMeta{Name: "smbios"}, Spec: hooksv1alpha1.HookSidecarSpec{Selector: &k8smetav1.LabelSelector{MatchLabels: map[string]string{"test": "test"}}}},
+			{ObjectMeta: k8smetav1.ObjectMeta{Name: "audit"}, Spec: hooksv1alpha1.HookSidecarSpec{Selector: &k8smetav1.LabelSelector{}}},
+			{ObjectMeta: k8smetav1.ObjectMeta{Name: "numa"}, Spec: hooksv1alpha1.HookSidecarSpec{Selector: &k8smetav1.LabelSelector{MatchLabels: map[string]string{"numa": "true"}}}},
+			{ObjectMeta: k8smetav1.ObjectMeta{Name: "named"}},
+		} {
+			Expect(hookSidecarInformer.GetStore().Add(hookSidecar)).To(Succeed())
+		}
+		mutator.HookSidecarInformer = hookSidecarInformer
+		vmi.Annotations = map[string]string{hooks.SelectedHookSidecarNamesAnnotationName: "named"}
+
+		vmiMeta, _, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+		if expected == "" {
+			Expect(vmiMeta.Annotations).ToNot(HaveKey(hooks.SelectedHookSidecarNamesAnnotationName))
+		} else {
+			Expect(vmiMeta.Annotations).To(HaveKeyWithValue(hooks.SelectedHookSidecarNamesAnnotationName, expected))
+		}
+	},
+		Entry("with the HookSidecarRegistry feature gate", []string{virtconfig.HookSidecarRegistryGate}, "audit,smbios"),
+		Entry("without the HookSidecarRegistry feature gate", nil, ""),
+	)
+
 	It("should convert CPU requests to sockets", func() {
 		vmi.Spec.Domain.CPU = &v1.CPU{Model: "EPYC"}
 		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
@@ -74,10 +74,11 @@ var MigrationGroupVersionResource = metav1.GroupVersionResource{
 }
 
 type Informers struct {
-	VMIPresetInformer  cache.SharedIndexInformer
-	VMRestoreInformer  cache.SharedIndexInformer
-	DataSourceInformer cache.SharedIndexInformer
-	NamespaceInformer  cache.SharedIndexInformer
+	VMIPresetInformer   cache.SharedIndexInformer
+	VMRestoreInformer   cache.SharedIndexInformer
+	DataSourceInformer  cache.SharedIndexInformer
+	NamespaceInformer   cache.SharedIndexInformer
+	HookSidecarInformer cache.SharedIndexInformer
 }
 
 func IsComponentServiceAccount(serviceAccount, namespace, component string) bool {
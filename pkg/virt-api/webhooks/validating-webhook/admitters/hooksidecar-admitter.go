@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/hooks"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
+
+	kvhooks "kubevirt.io/kubevirt/pkg/hooks"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+// HookSidecarAdmitter validates HookSidecars
+type HookSidecarAdmitter struct {
+}
+
+// NewHookSidecarAdmitter creates a HookSidecarAdmitter
+func NewHookSidecarAdmitter() *HookSidecarAdmitter {
+	return &HookSidecarAdmitter{}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *HookSidecarAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != hooksv1alpha1.HookSidecarKind.Group ||
+		ar.Request.Resource.Resource != hooks.ResourceHookSidecars {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	hookSidecar := &hooksv1alpha1.HookSidecar{}
+	err := json.Unmarshal(ar.Request.Object.Raw, hookSidecar)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	causes := validateHookSidecarSpec(k8sfield.NewPath("spec"), &hookSidecar.Spec)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateHookSidecarSpec(field *k8sfield.Path, spec *hooksv1alpha1.HookSidecarSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Image == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf(requiredFieldFmt, field.Child("image").String()),
+			Field:   field.Child("image").String(),
+		})
+	}
+
+	switch spec.ImagePullPolicy {
+	case "", k8sv1.PullAlways, k8sv1.PullNever, k8sv1.PullIfNotPresent:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("image pull policy %s is not supported", spec.ImagePullPolicy),
+			Field:   field.Child("imagePullPolicy").String(),
+		})
+	}
+
+	if spec.Version != "" && !slices.Contains(kvhooks.SupportedVersions, spec.Version) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("hook version %s is not supported, supported versions are %v", spec.Version, kvhooks.SupportedVersions),
+			Field:   field.Child("version").String(),
+		})
+	}
+
+	if spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Child("selector").String(),
+			})
+		}
+	}
+
+	return causes
+}
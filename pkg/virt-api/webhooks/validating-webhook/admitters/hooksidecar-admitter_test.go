@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"kubevirt.io/api/hooks"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
+)
+
+var _ = Describe("Validating HookSidecar Admitter", func() {
+	var admitter *HookSidecarAdmitter
+
+	BeforeEach(func() {
+		admitter = NewHookSidecarAdmitter()
+	})
+
+	It("should reject unexpected resources", func() {
+		ar := createHookSidecarAdmissionReview(&hooksv1alpha1.HookSidecar{})
+		ar.Request.Resource.Resource = "migrationpolicies"
+		resp := admitter.Admit(ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("unexpected resource"))
+	})
+
+	DescribeTable("should reject hook sidecar with", func(spec hooksv1alpha1.HookSidecarSpec, field string) {
+		resp := admitter.Admit(createHookSidecarAdmissionReview(&hooksv1alpha1.HookSidecar{Spec: spec}))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("missing image",
+			hooksv1alpha1.HookSidecarSpec{}, "spec.image",
+		),
+		Entry("unsupported image pull policy",
+			hooksv1alpha1.HookSidecarSpec{Image: "sidecar:v1", ImagePullPolicy: "Sometimes"}, "spec.imagePullPolicy",
+		),
+		Entry("unsupported version",
+			hooksv1alpha1.HookSidecarSpec{Image: "sidecar:v1", Version: "v2"}, "spec.version",
+		),
+		Entry("invalid selector",
+			hooksv1alpha1.HookSidecarSpec{Image: "sidecar:v1", Selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Within"}},
+			}}, "spec.selector",
+		),
+	)
+
+	DescribeTable("should accept hook sidecar with", func(spec hooksv1alpha1.HookSidecarSpec) {
+		resp := admitter.Admit(createHookSidecarAdmissionReview(&hooksv1alpha1.HookSidecar{Spec: spec}))
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("only an image",
+			hooksv1alpha1.HookSidecarSpec{Image: "sidecar:v1"},
+		),
+		Entry("all fields",
+			hooksv1alpha1.HookSidecarSpec{
+				Image:           "sidecar:v1",
+				ImagePullPolicy: k8sv1.PullIfNotPresent,
+				Version:         "v1alpha4",
+				Args:            []string{"--debug"},
+				Resources:       &k8sv1.ResourceRequirements{},
+				Selector:        &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			},
+		),
+	)
+})
+
+func createHookSidecarAdmissionReview(hookSidecar *hooksv1alpha1.HookSidecar) *admissionv1.AdmissionReview {
+	hookSidecarBytes, _ := json.Marshal(hookSidecar)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Resource: metav1.GroupVersionResource{
+				Group:    hooksv1alpha1.HookSidecarKind.Group,
+				Resource: hooks.ResourceHookSidecars,
+			},
+			Object: runtime.RawExtension{
+				Raw: hookSidecarBytes,
+			},
+		},
+	}
+}
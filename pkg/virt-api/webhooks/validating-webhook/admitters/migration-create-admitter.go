@@ -21,16 +21,22 @@ package admitters
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	"kubevirt.io/api/core"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
@@ -92,6 +98,18 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if (migration.IsDecentralizedSource() || migration.IsDecentralizedTarget()) && !admitter.ClusterConfig.DecentralizedLiveMigrationEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("%s feature gate is not enabled", virtconfig.DecentralizedLiveMigrationGate))
+	}
+
+	causes, err = admitter.validatePrivilegedFields(migration, ar.Request.UserInfo)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	vmi, err := admitter.VirtClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), migration.Spec.VMIName, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// ensure VMI exists for the migration
@@ -111,6 +129,11 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
+	err = validateDecentralizedMigrationVMI(migration, vmi)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
 	// Don't allow new migration jobs to be introduced when previous migration jobs
 	// are already in flight.
 	err = EnsureNoMigrationConflict(admitter.VirtClient, migration.Spec.VMIName, migration.Namespace)
@@ -123,6 +146,56 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 	return &reviewResponse
 }
 
+// validatePrivilegedFields rejects urgent migrations and migrations with a system priority unless they are
+// created by a migration admin
+func (admitter *MigrationCreateAdmitter) validatePrivilegedFields(migration *v1.VirtualMachineInstanceMigration, userInfo authenticationv1.UserInfo) ([]metav1.StatusCause, error) {
+	var fields []*k8sfield.Path
+	if migration.Spec.Urgent {
+		fields = append(fields, k8sfield.NewPath("spec", "urgent"))
+	}
+	if migration.Spec.Priority != nil && *migration.Spec.Priority != v1.MigrationPriorityUserTriggered {
+		fields = append(fields, k8sfield.NewPath("spec", "priority"))
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	allowed, err := isMigrationAdmin(admitter.VirtClient, userInfo)
+	if err != nil || allowed {
+		return nil, err
+	}
+
+	var causes []metav1.StatusCause
+	for _, field := range fields {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("user %s is not allowed to set %s", userInfo.Username, field.String()),
+			Field:   field.String(),
+		})
+	}
+	return causes, nil
+}
+
+// isMigrationAdmin returns true if the user is a KubeVirt component or is allowed to update the KubeVirt
+// installation. Only those users can create migrations bypassing the maintenance windows.
+func isMigrationAdmin(client kubecli.KubevirtClient, userInfo authenticationv1.UserInfo) (bool, error) {
+	if webhooks.IsKubeVirtServiceAccount(userInfo.Username) {
+		return true, nil
+	}
+
+	proxy := &authProxy{client: client}
+	response, err := proxy.CreateSar(newUserSubjectAccessReview(userInfo, authv1.ResourceAttributes{
+		Namespace: webhooks.GetNamespace(),
+		Verb:      "update",
+		Group:     core.GroupName,
+		Resource:  "kubevirts",
+	}))
+	if err != nil {
+		return false, err
+	}
+	return response.Status.Allowed, nil
+}
+
 func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.VirtualMachineInstanceMigration, old *v1.VirtualMachineInstanceMigration, err error) {
 
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.MigrationGroupVersionResource.Group, webhooks.MigrationGroupVersionResource.Resource) {
@@ -161,5 +234,77 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	if spec.SendTo != nil && spec.Receive != nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "sendTo and receive are mutually exclusive",
+			Field:   field.Child("sendTo").String(),
+		})
+	}
+
+	if spec.SendTo != nil {
+		causes = append(causes, validateMigrationID(field.Child("sendTo", "migrationID"), spec.SendTo.MigrationID)...)
+		if _, _, err := net.SplitHostPort(spec.SendTo.ConnectURL); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("connectURL must be the host:port address of the target migration endpoint: %v", err),
+				Field:   field.Child("sendTo", "connectURL").String(),
+			})
+		}
+		if spec.SendTo.Token == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "token is missing, it has to be taken from the status of the receiving migration",
+				Field:   field.Child("sendTo", "token").String(),
+			})
+		} else if _, err := hex.DecodeString(spec.SendTo.Token); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "token must be the hex encoded receive token of the receiving migration",
+				Field:   field.Child("sendTo", "token").String(),
+			})
+		}
+	}
+
+	if spec.Receive != nil {
+		causes = append(causes, validateMigrationID(field.Child("receive", "migrationID"), spec.Receive.MigrationID)...)
+	}
+
 	return causes
 }
+
+func validateMigrationID(field *k8sfield.Path, migrationID string) []metav1.StatusCause {
+	if migrationID == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "migrationID is missing",
+			Field:   field.String(),
+		}}
+	}
+	if errs := validation.IsValidLabelValue(migrationID); len(errs) > 0 {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("migrationID must be a valid label value: %s", errs[0]),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
+
+// validateDecentralizedMigrationVMI ensures the VMI of a migration across clusters can take part in it
+func validateDecentralizedMigrationVMI(migration *v1.VirtualMachineInstanceMigration, vmi *v1.VirtualMachineInstance) error {
+	if migration.IsDecentralizedTarget() {
+		if _, exists := vmi.Annotations[v1.MigrationReceiverAnnotation]; !exists {
+			return fmt.Errorf("the VMI \"%s/%s\" has to be created with the %s annotation to receive a migration", vmi.Namespace, vmi.Name, v1.MigrationReceiverAnnotation)
+		}
+	}
+	if migration.IsDecentralizedSource() {
+		if _, exists := vmi.Annotations[v1.MigrationReceiverAnnotation]; exists {
+			return fmt.Errorf("the VMI \"%s/%s\" is a migration receiver and cannot be sent to another cluster", vmi.Namespace, vmi.Name)
+		}
+		if vmi.IsCPUDedicated() {
+			return fmt.Errorf("VMIs with dedicated CPUs cannot be migrated to another cluster")
+		}
+	}
+	return nil
+}
@@ -27,17 +27,23 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	"kubevirt.io/client-go/api"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/deprecation"
 )
 
@@ -301,6 +307,183 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 		})
 
+		Context("with a decentralized migration", func() {
+			newMigrationReview := func(migration *v1.VirtualMachineInstanceMigration) *admissionv1.AdmissionReview {
+				migrationBytes, _ := json.Marshal(migration)
+				return &admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource: webhooks.MigrationGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: migrationBytes,
+						},
+					},
+				}
+			}
+
+			newDecentralizedMigration := func(vmiName string, sendTo *v1.VirtualMachineInstanceMigrationSource, receive *v1.VirtualMachineInstanceMigrationTarget) *v1.VirtualMachineInstanceMigration {
+				return &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: vmiName,
+						SendTo:  sendTo,
+						Receive: receive,
+					},
+				}
+			}
+
+			It("should reject it when the feature gate is disabled", func() {
+				migration := newDecentralizedMigration("testvmi", &v1.VirtualMachineInstanceMigrationSource{
+					MigrationID: "my-migration",
+					ConnectURL:  "192.168.1.10:8187",
+					Token:       "0123abcd",
+				}, nil)
+
+				resp := migrationCreateAdmitter.Admit(newMigrationReview(migration))
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Message).To(ContainSubstring(virtconfig.DecentralizedLiveMigrationGate))
+			})
+
+			DescribeTable("should reject an invalid spec", func(sendTo *v1.VirtualMachineInstanceMigrationSource, receive *v1.VirtualMachineInstanceMigrationTarget, field string) {
+				enableFeatureGate(virtconfig.DecentralizedLiveMigrationGate)
+				migration := newDecentralizedMigration("testvmi", sendTo, receive)
+
+				resp := migrationCreateAdmitter.Admit(newMigrationReview(migration))
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+			},
+				Entry("with both sendTo and receive",
+					&v1.VirtualMachineInstanceMigrationSource{MigrationID: "my-migration", ConnectURL: "192.168.1.10:8187", Token: "0123abcd"},
+					&v1.VirtualMachineInstanceMigrationTarget{MigrationID: "my-migration"},
+					"spec.sendTo",
+				),
+				Entry("with a missing migration ID",
+					&v1.VirtualMachineInstanceMigrationSource{ConnectURL: "192.168.1.10:8187", Token: "0123abcd"}, nil,
+					"spec.sendTo.migrationID",
+				),
+				Entry("with a migration ID which is not a label value",
+					nil, &v1.VirtualMachineInstanceMigrationTarget{MigrationID: "my migration"},
+					"spec.receive.migrationID",
+				),
+				Entry("with a connect URL without port",
+					&v1.VirtualMachineInstanceMigrationSource{MigrationID: "my-migration", ConnectURL: "192.168.1.10", Token: "0123abcd"}, nil,
+					"spec.sendTo.connectURL",
+				),
+				Entry("with a missing token",
+					&v1.VirtualMachineInstanceMigrationSource{MigrationID: "my-migration", ConnectURL: "192.168.1.10:8187"}, nil,
+					"spec.sendTo.token",
+				),
+				Entry("with a token which is not hex encoded",
+					&v1.VirtualMachineInstanceMigrationSource{MigrationID: "my-migration", ConnectURL: "192.168.1.10:8187", Token: "my token"}, nil,
+					"spec.sendTo.token",
+				),
+			)
+
+			DescribeTable("should validate the VMI", func(annotations map[string]string, sendTo *v1.VirtualMachineInstanceMigrationSource, receive *v1.VirtualMachineInstanceMigrationTarget, allowed bool) {
+				enableFeatureGate(virtconfig.DecentralizedLiveMigrationGate)
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Annotations = annotations
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+				migration := newDecentralizedMigration(vmi.Name, sendTo, receive)
+
+				resp := migrationCreateAdmitter.Admit(newMigrationReview(migration))
+				Expect(resp.Allowed).To(Equal(allowed))
+			},
+				Entry("accepting a source",
+					nil, &v1.VirtualMachineInstanceMigrationSource{MigrationID: "my-migration", ConnectURL: "migration.example.com:8187", Token: "0123abcd"}, nil,
+					true,
+				),
+				Entry("rejecting a source which is a migration receiver",
+					map[string]string{v1.MigrationReceiverAnnotation: ""},
+					&v1.VirtualMachineInstanceMigrationSource{MigrationID: "my-migration", ConnectURL: "migration.example.com:8187", Token: "0123abcd"}, nil,
+					false,
+				),
+				Entry("accepting a target which is a migration receiver",
+					map[string]string{v1.MigrationReceiverAnnotation: ""},
+					nil, &v1.VirtualMachineInstanceMigrationTarget{MigrationID: "my-migration"},
+					true,
+				),
+				Entry("rejecting a target which is no migration receiver",
+					nil, nil, &v1.VirtualMachineInstanceMigrationTarget{MigrationID: "my-migration"},
+					false,
+				),
+			)
+		})
+
+		Context("with an urgent migration or a system priority", func() {
+			var kubevirtAdmin bool
+
+			BeforeEach(func() {
+				kubevirtAdmin = false
+				k8sClient := k8sfake.NewSimpleClientset()
+				k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+					Expect(sar.Spec.User).To(Equal("user"))
+					Expect(sar.Spec.ResourceAttributes.Resource).To(Equal("kubevirts"))
+					Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("update"))
+					sar.Status.Allowed = kubevirtAdmin
+					return true, sar, nil
+				})
+				virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+				enableFeatureGate(deprecation.LiveMigrationGate)
+			})
+
+			admitMigration := func(username string, urgent bool, priority *v1.MigrationPriority) *admissionv1.AdmissionResponse {
+				vmi := api.NewMinimalVMI("testvmi")
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil).MaxTimes(1)
+
+				migration := &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: vmi.Namespace,
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName:  vmi.Name,
+						Urgent:   urgent,
+						Priority: priority,
+					},
+				}
+				migrationBytes, _ := json.Marshal(migration)
+				return migrationCreateAdmitter.Admit(&admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource: webhooks.MigrationGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: migrationBytes,
+						},
+						UserInfo: authenticationv1.UserInfo{Username: username},
+					},
+				})
+			}
+
+			DescribeTable("should reject it for users not allowed to update KubeVirt", func(urgent bool, priority *v1.MigrationPriority, fields []string) {
+				resp := admitMigration("user", urgent, priority)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(len(fields)))
+				for i, field := range fields {
+					Expect(resp.Result.Details.Causes[i].Field).To(Equal(field))
+				}
+			},
+				Entry("when urgent", true, nil, []string{"spec.urgent"}),
+				Entry("with the system-critical priority", false, pointer.P(v1.MigrationPrioritySystemCritical), []string{"spec.priority"}),
+				Entry("with the system-maintenance priority", false, pointer.P(v1.MigrationPrioritySystemMaintenance), []string{"spec.priority"}),
+				Entry("when urgent and with a system priority", true, pointer.P(v1.MigrationPrioritySystemCritical), []string{"spec.urgent", "spec.priority"}),
+			)
+
+			It("should accept the user-triggered priority for any user", func() {
+				Expect(admitMigration("user", false, pointer.P(v1.MigrationPriorityUserTriggered)).Allowed).To(BeTrue())
+			})
+
+			It("should accept it for users allowed to update KubeVirt", func() {
+				kubevirtAdmin = true
+				Expect(admitMigration("user", true, pointer.P(v1.MigrationPrioritySystemCritical)).Allowed).To(BeTrue())
+			})
+
+			It("should accept it for KubeVirt service accounts", func() {
+				Expect(admitMigration("system:serviceaccount:kubevirt:kubevirt-controller", true, pointer.P(v1.MigrationPrioritySystemMaintenance)).Allowed).To(BeTrue())
+			})
+		})
+
 		DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 			input := map[string]interface{}{}
 			json.Unmarshal([]byte(data), &input)
@@ -32,6 +32,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
@@ -70,6 +71,40 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		})
 	}
 
+	if spec.ProgressTimeout != nil && *spec.ProgressTimeout < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   sourceField.Child("progressTimeout").String(),
+		})
+	}
+
+	if spec.ParallelMigrationsPerCluster != nil && *spec.ParallelMigrationsPerCluster == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   sourceField.Child("parallelMigrationsPerCluster").String(),
+		})
+	}
+
+	if spec.ParallelOutboundMigrationsPerNode != nil && *spec.ParallelOutboundMigrationsPerNode == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   sourceField.Child("parallelOutboundMigrationsPerNode").String(),
+		})
+	}
+
+	if spec.Network != nil && *spec.Network == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be empty",
+			Field:   sourceField.Child("network").String(),
+		})
+	}
+
+	causes = append(causes, migrationutils.ValidateMaintenanceWindows(sourceField.Child("maintenanceWindows"), spec.MaintenanceWindows)...)
+
 	if spec.BandwidthPerMigration != nil {
 		quantity, ok := spec.BandwidthPerMigration.AsInt64()
 		if !ok {
@@ -21,10 +21,12 @@ package admitters
 
 import (
 	"encoding/json"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/pointer"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -62,6 +64,34 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.Int64Ptr(-1)},
 		),
+
+		Entry("negative ProgressTimeout",
+			migrationsv1.MigrationPolicySpec{ProgressTimeout: pointer.Int64Ptr(-1)},
+		),
+
+		Entry("zero ParallelMigrationsPerCluster",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationsPerCluster: pointer.Uint32(0)},
+		),
+
+		Entry("zero ParallelOutboundMigrationsPerNode",
+			migrationsv1.MigrationPolicySpec{ParallelOutboundMigrationsPerNode: pointer.Uint32(0)},
+		),
+
+		Entry("empty Network",
+			migrationsv1.MigrationPolicySpec{Network: pointer.String("")},
+		),
+
+		Entry("invalid MaintenanceWindows schedule",
+			migrationsv1.MigrationPolicySpec{MaintenanceWindows: []v1.MaintenanceWindow{
+				{Schedule: "0 22 * *", Duration: metav1.Duration{Duration: time.Hour}},
+			}},
+		),
+
+		Entry("zero MaintenanceWindows duration",
+			migrationsv1.MigrationPolicySpec{MaintenanceWindows: []v1.MaintenanceWindow{
+				{Schedule: "0 22 * * *"},
+			}},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -88,6 +118,21 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("all migration configurations",
+			migrationsv1.MigrationPolicySpec{
+				Priority:                          pointer.Int32(-5),
+				ProgressTimeout:                   pointer.Int64(150),
+				UnsafeMigrationOverride:           pointer.Bool(true),
+				DisableTLS:                        pointer.Bool(true),
+				Network:                           pointer.String("migration-net"),
+				ParallelMigrationsPerCluster:      pointer.Uint32(3),
+				ParallelOutboundMigrationsPerNode: pointer.Uint32(1),
+				MaintenanceWindows: []v1.MaintenanceWindow{
+					{Schedule: "0 22 * * 1-5", Duration: metav1.Duration{Duration: 6 * time.Hour}},
+				},
+			},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"strings"
 
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -30,15 +31,20 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	"kubevirt.io/api/clone"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	"kubevirt.io/api/core"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/util/placeholder"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -108,6 +114,14 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ar *admissionv1.AdmissionRevi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validateTargetNamespace(admitter.Client, ar.Request.UserInfo, vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
+	if newCauses := validateReplicas(vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -160,7 +174,7 @@ func validateSourceAndTargetKind(vmClone *clonev1alpha1.VirtualMachineClone) []m
 	sourceField := k8sfield.NewPath("spec")
 
 	supportedSourceTypes := []string{virtualMachineKind, virtualMachineSnapshotKind}
-	supportedTargetTypes := []string{virtualMachineKind}
+	supportedTargetTypes := []string{virtualMachineKind, virtualMachineSnapshotKind}
 
 	if !doesSliceContainStr(supportedSourceTypes, vmClone.Spec.Source.Kind) {
 		causes = []metav1.StatusCause{{
@@ -253,6 +267,155 @@ func validateTarget(vmClone *clonev1alpha1.VirtualMachineClone) []metav1.StatusC
 		})
 	}
 
+	if target != nil && target.Kind == virtualMachineSnapshotKind {
+		if source != nil && source.Kind != virtualMachineKind {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Only a VirtualMachine can be cloned into a VirtualMachineSnapshot",
+				Field:   k8sfield.NewPath("spec").Child("target").Child("kind").String(),
+			})
+		}
+		if target.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Target name cannot be empty when the target is a VirtualMachineSnapshot",
+				Field:   k8sfield.NewPath("spec").Child("target").Child("name").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateTargetNamespace(client kubecli.KubevirtClient, userInfo authenticationv1.UserInfo, vmClone *clonev1alpha1.VirtualMachineClone) []metav1.StatusCause {
+	targetNamespace := vmClone.Spec.TargetNamespace
+	if targetNamespace == nil || *targetNamespace == "" || *targetNamespace == vmClone.Namespace {
+		return nil
+	}
+
+	field := k8sfield.NewPath("spec").Child("targetNamespace")
+	target := vmClone.Spec.Target
+	if target == nil || target.Kind != virtualMachineKind || target.Name == "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Only a named VirtualMachine target can be cloned into another namespace",
+			Field:   field.String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	proxy := &authProxy{client: client}
+
+	// the target VM and the transferred volumes are created on behalf of the requester
+	for _, resourceAttributes := range []authv1.ResourceAttributes{
+		{Namespace: *targetNamespace, Verb: "create", Group: core.GroupName, Resource: "virtualmachines"},
+		{Namespace: *targetNamespace, Verb: "create", Resource: "persistentvolumeclaims"},
+	} {
+		response, err := proxy.CreateSar(newUserSubjectAccessReview(userInfo, resourceAttributes))
+		if err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("error occurred while checking access to namespace %s: %v", *targetNamespace, err),
+				Field:   field.String(),
+			})
+			continue
+		}
+
+		if !response.Status.Allowed {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("User %s is not allowed to create %s in namespace %s", userInfo.Username, resourceAttributes.Resource, *targetNamespace),
+				Field:   field.String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// maxCloneReplicas caps the number of VirtualMachines a single clone creates
+const maxCloneReplicas = 100
+
+func validateReplicas(vmClone *clonev1alpha1.VirtualMachineClone) []metav1.StatusCause {
+	replicas := vmClone.Spec.Replicas
+	if replicas == nil || *replicas == 1 {
+		return nil
+	}
+
+	specField := k8sfield.NewPath("spec")
+	if *replicas < 1 || *replicas > maxCloneReplicas {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("replicas must be between 1 and %d, got %d", maxCloneReplicas, *replicas),
+			Field:   specField.Child("replicas").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	target := vmClone.Spec.Target
+	if target != nil && target.Kind != virtualMachineKind {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Only VirtualMachine targets can be cloned into more than one replica",
+			Field:   specField.Child("replicas").String(),
+		})
+	}
+	if targetNamespace := vmClone.Spec.TargetNamespace; targetNamespace != nil && *targetNamespace != "" && *targetNamespace != vmClone.Namespace {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Cannot clone more than one replica into another namespace",
+			Field:   specField.Child("replicas").String(),
+		})
+	}
+
+	if pattern := vmClone.Spec.TargetNamePattern; pattern != "" {
+		if !placeholder.HasIndex(pattern) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Target name pattern must contain the {{ .Index }} placeholder",
+				Field:   specField.Child("targetNamePattern").String(),
+			})
+		} else {
+			causes = append(causes, validateReplicaNames(pattern, *replicas, specField.Child("targetNamePattern"))...)
+		}
+	} else if target == nil || target.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Either a target name or a target name pattern is required to clone more than one replica",
+			Field:   specField.Child("targetNamePattern").String(),
+		})
+	} else {
+		causes = append(causes, validateReplicaNames(target.Name+"-"+placeholder.Index, *replicas, specField.Child("target").Child("name"))...)
+	}
+
+	for ifaceName, macAddress := range vmClone.Spec.NewMacAddresses {
+		if hwAddr, err := net.ParseMAC(macAddress); err != nil || len(hwAddr) != 6 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("MAC address %s of interface %s must be a valid 48-bit MAC address to clone more than one replica", macAddress, ifaceName),
+				Field:   specField.Child("newMacAddresses").Key(ifaceName).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateReplicaNames checks that the names rendered for the first and the last replica, which is the
+// longest one, are valid VirtualMachine names
+func validateReplicaNames(pattern string, replicas int32, field *k8sfield.Path) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for _, index := range []int{0, int(replicas) - 1} {
+		name := placeholder.ReplaceIndex(pattern, index)
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Replica name %s is not valid: %s", name, strings.Join(errs, ", ")),
+				Field:   field.String(),
+			})
+			break
+		}
+	}
 	return causes
 }
 
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -32,10 +33,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
 
@@ -289,6 +292,132 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		})
 	})
 
+	Context("VirtualMachineSnapshot target", func() {
+		BeforeEach(func() {
+			vmClone.Spec.Target.APIGroup = pointer.String("snapshot.kubevirt.io")
+			vmClone.Spec.Target.Kind = virtualMachineSnapshotKind
+		})
+
+		It("Should allow a VirtualMachine source", func() {
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		It("Should reject a VirtualMachineSnapshot source", func() {
+			vmClone.Spec.Source.Kind = virtualMachineSnapshotKind
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("Should reject a target without a name", func() {
+			vmClone.Spec.Target.Name = ""
+			admitter.admitAndExpect(vmClone, false)
+		})
+	})
+
+	Context("target namespace", func() {
+		const targetNamespace = "target-namespace"
+		var allowedResources map[string]bool
+
+		BeforeEach(func() {
+			allowedResources = map[string]bool{"virtualmachines": true, "persistentvolumeclaims": true}
+			vmClone.Spec.TargetNamespace = pointer.String(targetNamespace)
+
+			k8sClient := k8sfake.NewSimpleClientset()
+			k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				Expect(sar.Spec.User).To(Equal("user"))
+				Expect(sar.Spec.ResourceAttributes.Namespace).To(Equal(targetNamespace))
+				Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("create"))
+				sar.Status.Allowed = allowedResources[sar.Spec.ResourceAttributes.Resource]
+				return true, sar, nil
+			})
+			virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+		})
+
+		admitWithUser := func(expectAllowed bool) {
+			ar := createCloneAdmissionReview(vmClone)
+			ar.Request.UserInfo.Username = "user"
+			Expect(admitter.Admit(ar).Allowed).To(Equal(expectAllowed))
+		}
+
+		It("Should allow a user allowed to create VMs and PVCs in the target namespace", func() {
+			admitWithUser(true)
+		})
+
+		It("Should not check access for the clone's namespace", func() {
+			vmClone.Spec.TargetNamespace = pointer.String(vmClone.Namespace)
+			allowedResources = nil
+			admitWithUser(true)
+		})
+
+		DescribeTable("Should reject a user not allowed to create", func(resource string) {
+			allowedResources[resource] = false
+			admitWithUser(false)
+		},
+			Entry("VMs in the target namespace", "virtualmachines"),
+			Entry("PVCs in the target namespace", "persistentvolumeclaims"),
+		)
+
+		It("Should reject a target without a name", func() {
+			vmClone.Spec.Target.Name = ""
+			admitWithUser(false)
+		})
+
+		It("Should reject a VirtualMachineSnapshot target", func() {
+			vmClone.Spec.Target.APIGroup = pointer.String("snapshot.kubevirt.io")
+			vmClone.Spec.Target.Kind = virtualMachineSnapshotKind
+			admitWithUser(false)
+		})
+	})
+
+	Context("replicas", func() {
+		DescribeTable("Should allow", func(setup func(*clonev1lpha1.VirtualMachineClone)) {
+			vmClone.Spec.Replicas = pointer.Int32(3)
+			setup(vmClone)
+			admitter.admitAndExpect(vmClone, true)
+		},
+			Entry("a named target", func(*clonev1lpha1.VirtualMachineClone) {}),
+			Entry("a target name pattern", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.Target = nil
+				vmClone.Spec.TargetNamePattern = "lab-{{ .Index }}-vm"
+			}),
+			Entry("MAC addresses", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.NewMacAddresses = map[string]string{"default": "02:00:00:00:00:01"}
+			}),
+		)
+
+		DescribeTable("Should reject", func(setup func(*clonev1lpha1.VirtualMachineClone)) {
+			vmClone.Spec.Replicas = pointer.Int32(3)
+			setup(vmClone)
+			admitter.admitAndExpect(vmClone, false)
+		},
+			Entry("less than one replica", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.Replicas = pointer.Int32(0)
+			}),
+			Entry("more than the maximum replicas", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.Replicas = pointer.Int32(maxCloneReplicas + 1)
+			}),
+			Entry("a target name pattern rendering invalid names", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.TargetNamePattern = "Lab_{{ .Index }}"
+			}),
+			Entry("a target name pattern rendering too long names", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.TargetNamePattern = strings.Repeat("a", 253) + "{{ .Index }}"
+			}),
+			Entry("a target without a name", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.Target.Name = ""
+			}),
+			Entry("a target name pattern without index", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.TargetNamePattern = "lab-vm"
+			}),
+			Entry("a VirtualMachineSnapshot target", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.Target.APIGroup = pointer.String("snapshot.kubevirt.io")
+				vmClone.Spec.Target.Kind = virtualMachineSnapshotKind
+			}),
+			Entry("an invalid MAC address", func(vmClone *clonev1lpha1.VirtualMachineClone) {
+				vmClone.Spec.NewMacAddresses = map[string]string{"default": "not-a-mac"}
+			}),
+		)
+	})
+
 	It("Should reject if snapshot feature gate is not enabled", func() {
 		disableFeatureGates()
 		admitter.admitAndExpect(vmClone, false)
@@ -97,6 +97,7 @@ func (admitter *VMExportAdmitter) Admit(ar *admissionv1.AdmissionReview) *admiss
 				},
 			}
 		}
+		causes = append(causes, admitter.validateBaseSnapshot(k8sfield.NewPath("spec", "baseSnapshot"), &vmExport.Spec)...)
 
 	case admissionv1.Update:
 		prevObj := &exportv1.VirtualMachineExport{}
@@ -211,3 +212,29 @@ func (admitter *VMExportAdmitter) validateVMApiGroup(field *k8sfield.Path, apigr
 
 	return []metav1.StatusCause{}
 }
+
+func (admitter *VMExportAdmitter) validateBaseSnapshot(field *k8sfield.Path, spec *exportv1.VirtualMachineExportSpec) []metav1.StatusCause {
+	if spec.BaseSnapshot == nil {
+		return []metav1.StatusCause{}
+	}
+	if spec.Source.Kind != vmSnapshotKind {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "base snapshot is only supported when exporting a VMSnapshot",
+				Field:   field.String(),
+			},
+		}
+	}
+	if *spec.BaseSnapshot == "" || *spec.BaseSnapshot == spec.Source.Name {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "base snapshot must be another VMSnapshot than the exported one",
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return []metav1.StatusCause{}
+}
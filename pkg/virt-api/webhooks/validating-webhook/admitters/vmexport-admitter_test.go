@@ -33,6 +33,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -256,6 +257,48 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 			Entry("virtual machine snapshot", "invalid", vmSnapshotKind),
 			Entry("virtual machine", "invalid", vmKind),
 		)
+
+		It("should allow a base snapshot when exporting a VMSnapshot", func() {
+			export := &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &snapshotApiGroup,
+						Kind:     vmSnapshotKind,
+						Name:     "test",
+					},
+					BaseSnapshot: pointer.P("base"),
+				},
+			}
+
+			ar := createExportAdmissionReview(export)
+			resp := createTestVMExportAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("it should reject a base snapshot", func(apiGroup, kind, baseSnapshot, errorString string) {
+			export := &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     kind,
+						Name:     "test",
+					},
+					BaseSnapshot: &baseSnapshot,
+				},
+			}
+
+			ar := createExportAdmissionReview(export)
+			resp := createTestVMExportAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.baseSnapshot"))
+			Expect(resp.Result.Details.Causes[0].Message).To(Equal(errorString))
+		},
+			Entry("when exporting a PVC", "", pvc, "base", "base snapshot is only supported when exporting a VMSnapshot"),
+			Entry("when exporting a VM", kubevirtApiGroup, vmKind, "base", "base snapshot is only supported when exporting a VMSnapshot"),
+			Entry("when blank", snapshotApiGroup, vmSnapshotKind, "", "base snapshot must be another VMSnapshot than the exported one"),
+			Entry("when it is the exported VMSnapshot", snapshotApiGroup, vmSnapshotKind, "test", "base snapshot must be another VMSnapshot than the exported one"),
+		)
 	})
 })
 
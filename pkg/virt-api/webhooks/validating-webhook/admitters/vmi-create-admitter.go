@@ -39,6 +39,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -96,7 +97,8 @@ const (
 var isValidExpression = regexp.MustCompile(`^[A-Za-z0-9_.+-]+$`).MatchString
 
 type VMICreateAdmitter struct {
-	ClusterConfig *virtconfig.ClusterConfig
+	ClusterConfig    *virtconfig.ClusterConfig
+	HookSidecarStore cache.Store
 }
 
 func (admitter *VMICreateAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -124,6 +126,7 @@ func (admitter *VMICreateAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 	causes = append(causes, validateVirtualMachineInstanceSpecVolumeDisks(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMandatoryFields(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, accountName)...)
+	causes = append(causes, validateHookSidecarNames(k8sfield.NewPath("metadata"), vmi, admitter.ClusterConfig, admitter.HookSidecarStore)...)
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHyperv(k8sfield.NewPath("spec").Child("domain").Child("features").Child("hyperv"), &vmi.Spec)...)
 	if webhooks.IsARM64(&vmi.Spec) {
 		// Check if there is any unsupported setting if the arch is Arm64
@@ -1255,6 +1258,16 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 		})
 	}
 
+	// Validate hook sidecar registry feature gate if set when the corresponding annotation is found
+	if annotations[hooks.HookSidecarNamesAnnotationName] != "" && !config.HookSidecarRegistryEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config, invalid entry %s",
+				virtconfig.HookSidecarRegistryGate, field.Child("annotations", hooks.HookSidecarNamesAnnotationName).String()),
+			Field: field.Child("annotations").String(),
+		})
+	}
+
 	threadCountStr, exists := metadata.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]
 	if !exists {
 		return causes
@@ -1280,6 +1293,43 @@ func ValidateVirtualMachineInstanceMetadata(field *k8sfield.Path, metadata *meta
 	return causes
 }
 
+func validateHookSidecarNames(field *k8sfield.Path, vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig, hookSidecarStore cache.Store) []metav1.StatusCause {
+	if hookSidecarStore == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	for _, name := range hooks.HookSidecarNames(vmi) {
+		if _, exists, err := hookSidecarStore.GetByKey(name); err != nil || !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: fmt.Sprintf(nameOfTypeNotFoundMessagePattern, "HookSidecar", name),
+				Field:   field.Child("annotations", hooks.HookSidecarNamesAnnotationName).String(),
+			})
+		}
+	}
+
+	// with the registry, only the images published by the cluster admin can be requested as plain sidecars
+	if !config.HookSidecarRegistryEnabled() {
+		return causes
+	}
+	hookSidecarList, err := hooks.UnmarshalHookSidecarList(vmi)
+	if err != nil {
+		return causes
+	}
+	registeredImages := hooks.RegisteredHookSidecarImages(hookSidecarStore)
+	for _, hookSidecar := range hookSidecarList {
+		if !registeredImages[hookSidecar.Image] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("hook sidecar image %s is not registered as a HookSidecar", hookSidecar.Image),
+				Field:   field.Child("annotations", hooks.HookSidecarListAnnotationName).String(),
+			})
+		}
+	}
+	return causes
+}
+
 // Copied from kubernetes/pkg/apis/core/validation/validation.go
 func validatePodDNSConfig(dnsConfig *k8sv1.PodDNSConfig, dnsPolicy *k8sv1.DNSPolicy, field *k8sfield.Path) []metav1.StatusCause {
 	var causes []metav1.StatusCause
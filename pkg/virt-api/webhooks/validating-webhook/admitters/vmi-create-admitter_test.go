@@ -42,10 +42,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
 	"k8s.io/utils/ptr"
 
 	v1 "kubevirt.io/api/core/v1"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
 
 	"kubevirt.io/kubevirt/pkg/hooks"
 	kubevirtpointer "kubevirt.io/kubevirt/pkg/pointer"
@@ -460,6 +462,10 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				map[string]string{hooks.HookSidecarListAnnotationName: "[{'image': 'fake-image'}]"},
 				fmt.Sprintf("invalid entry metadata.annotations.%s", hooks.HookSidecarListAnnotationName),
 			),
+			Entry("without HookSidecarRegistry feature gate enabled",
+				map[string]string{hooks.HookSidecarNamesAnnotationName: "smbios"},
+				fmt.Sprintf("invalid entry metadata.annotations.%s", hooks.HookSidecarNamesAnnotationName),
+			),
 		)
 
 		DescribeTable("should accept annotations which require feature gate enabled", func(annotations map[string]string, featureGate string) {
@@ -479,6 +485,49 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				map[string]string{hooks.HookSidecarListAnnotationName: "[{'image': 'fake-image'}]"},
 				virtconfig.SidecarGate,
 			),
+			Entry("with HookSidecarRegistry feature gate enabled",
+				map[string]string{hooks.HookSidecarNamesAnnotationName: "smbios"},
+				virtconfig.HookSidecarRegistryGate,
+			),
+		)
+
+		It("should reject references to hook sidecars which do not exist", func() {
+			hookSidecarStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			Expect(hookSidecarStore.Add(&hooksv1alpha1.HookSidecar{ObjectMeta: metav1.ObjectMeta{Name: "smbios"}})).To(Succeed())
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Annotations = map[string]string{hooks.HookSidecarNamesAnnotationName: "smbios,missing"}
+
+			causes := validateHookSidecarNames(k8sfield.NewPath("metadata"), vmi, config, hookSidecarStore)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotFound))
+			Expect(causes[0].Message).To(Equal("HookSidecar 'missing' not found."))
+			Expect(causes[0].Field).To(Equal("metadata.annotations." + hooks.HookSidecarNamesAnnotationName))
+		})
+
+		DescribeTable("should only accept sidecar images registered as hook sidecars", func(registryEnabled bool, image string, expectRejected bool) {
+			if registryEnabled {
+				enableFeatureGate(virtconfig.HookSidecarRegistryGate)
+			}
+			hookSidecarStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+			Expect(hookSidecarStore.Add(&hooksv1alpha1.HookSidecar{
+				ObjectMeta: metav1.ObjectMeta{Name: "smbios"},
+				Spec:       hooksv1alpha1.HookSidecarSpec{Image: "registry/smbios:v1"},
+			})).To(Succeed())
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Annotations = map[string]string{hooks.HookSidecarListAnnotationName: fmt.Sprintf(`[{"image": %q}]`, image)}
+
+			causes := validateHookSidecarNames(k8sfield.NewPath("metadata"), vmi, config, hookSidecarStore)
+			if !expectRejected {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal(fmt.Sprintf("hook sidecar image %s is not registered as a HookSidecar", image)))
+			Expect(causes[0].Field).To(Equal("metadata.annotations." + hooks.HookSidecarListAnnotationName))
+		},
+			Entry("registered image with the registry", true, "registry/smbios:v1", false),
+			Entry("unregistered image with the registry", true, "registry/other:v1", true),
+			Entry("unregistered image without the registry", false, "registry/other:v1", false),
 		)
 	})
 
@@ -22,6 +22,8 @@ package admitters
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"kubevirt.io/kubevirt/pkg/virt-config/deprecation"
 
@@ -30,8 +32,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	v1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
@@ -130,6 +134,25 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	causes = append(causes, validateVMPoolUpdateStrategy(field.Child("updateStrategy"), spec.UpdateStrategy)...)
+
+	switch spec.ScaleInPolicy {
+	case "",
+		poolv1.VirtualMachinePoolRandomScaleInPolicy,
+		poolv1.VirtualMachinePoolNewestScaleInPolicy,
+		poolv1.VirtualMachinePoolOldestScaleInPolicy,
+		poolv1.VirtualMachinePoolNotReadyScaleInPolicy,
+		poolv1.VirtualMachinePoolNodeSpreadScaleInPolicy:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported scale in policy %s", spec.ScaleInPolicy),
+			Field:   field.Child("scaleInPolicy").String(),
+		})
+	}
+
+	causes = append(causes, validateVMPoolStatefulPolicy(field, spec)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -149,3 +172,176 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateVMPoolUpdateStrategy(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolUpdateStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if strategy == nil {
+		return causes
+	}
+
+	switch strategy.Type {
+	case "", poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType:
+		if strategy.RollingUpdate != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("rollingUpdate is only supported with update strategy type %s", poolv1.VirtualMachinePoolRollingUpdateStrategyType),
+				Field:   field.Child("rollingUpdate").String(),
+			})
+		}
+		return causes
+	case poolv1.VirtualMachinePoolRollingUpdateStrategyType:
+	default:
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported update strategy type %s", strategy.Type),
+			Field:   field.Child("type").String(),
+		})
+	}
+
+	rollingUpdate := strategy.RollingUpdate
+	if rollingUpdate == nil {
+		return causes
+	}
+	field = field.Child("rollingUpdate")
+
+	maxUnavailableIsZero, unavailableCauses := validateVMPoolIntOrPercent(field.Child("maxUnavailable"), rollingUpdate.MaxUnavailable)
+	causes = append(causes, unavailableCauses...)
+	maxSurgeIsZero, surgeCauses := validateVMPoolIntOrPercent(field.Child("maxSurge"), rollingUpdate.MaxSurge)
+	causes = append(causes, surgeCauses...)
+
+	// maxSurge defaults to 0, maxUnavailable to 1
+	if rollingUpdate.MaxUnavailable != nil && maxUnavailableIsZero && (rollingUpdate.MaxSurge == nil || maxSurgeIsZero) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable may not be 0 when maxSurge is 0",
+			Field:   field.Child("maxUnavailable").String(),
+		})
+	}
+
+	if rollingUpdate.Partition != nil && *rollingUpdate.Partition < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "partition must be greater than or equal to 0",
+			Field:   field.Child("partition").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateVMPoolStatefulPolicy(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	policy := spec.StatefulPolicy
+	if policy == nil {
+		return causes
+	}
+	policyField := field.Child("statefulPolicy")
+
+	switch policy.ManagementPolicy {
+	case "", poolv1.VirtualMachinePoolOrderedReadyManagementPolicy:
+		if spec.ScaleInPolicy != "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("scaleInPolicy is not supported with management policy %s", poolv1.VirtualMachinePoolOrderedReadyManagementPolicy),
+				Field:   field.Child("scaleInPolicy").String(),
+			})
+		}
+	case poolv1.VirtualMachinePoolParallelManagementPolicy:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported management policy %s", policy.ManagementPolicy),
+			Field:   policyField.Child("managementPolicy").String(),
+		})
+	}
+
+	// the {{ .Index }} placeholder can not be replaced in cloud-init data shared through secrets
+	if spec.VirtualMachineTemplate != nil && spec.VirtualMachineTemplate.Spec.Template != nil {
+		volumesField := field.Child("virtualMachineTemplate", "spec", "template", "spec", "volumes")
+		for i, volume := range spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes {
+			if hasCloudInitSecretRef(volume) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "cloud-init data referenced from secrets is not supported with a stateful policy, use inline data instead",
+					Field:   volumesField.Index(i).String(),
+				})
+			}
+		}
+	}
+
+	if retention := policy.DataVolumeRetentionPolicy; retention != nil {
+		retentionField := policyField.Child("dataVolumeRetentionPolicy")
+		causes = append(causes, validateVMPoolRetentionPolicyType(retentionField.Child("whenDeleted"), retention.WhenDeleted)...)
+		causes = append(causes, validateVMPoolRetentionPolicyType(retentionField.Child("whenScaled"), retention.WhenScaled)...)
+	}
+
+	// surge vms would get new indexes and break the identity of the replaced vms
+	if spec.UpdateStrategy != nil && spec.UpdateStrategy.RollingUpdate != nil && spec.UpdateStrategy.RollingUpdate.MaxSurge != nil {
+		surgeField := field.Child("updateStrategy", "rollingUpdate", "maxSurge")
+		// invalid values are already reported by the update strategy validation
+		if isZero, invalid := validateVMPoolIntOrPercent(surgeField, spec.UpdateStrategy.RollingUpdate.MaxSurge); len(invalid) == 0 && !isZero {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxSurge is not supported with a stateful policy",
+				Field:   surgeField.String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func hasCloudInitSecretRef(volume v1.Volume) bool {
+	if noCloud := volume.CloudInitNoCloud; noCloud != nil {
+		return noCloud.UserDataSecretRef != nil || noCloud.NetworkDataSecretRef != nil
+	}
+	if configDrive := volume.CloudInitConfigDrive; configDrive != nil {
+		return configDrive.UserDataSecretRef != nil || configDrive.NetworkDataSecretRef != nil
+	}
+	return false
+}
+
+func validateVMPoolRetentionPolicyType(field *k8sfield.Path, policy poolv1.VirtualMachinePoolDataVolumeRetentionPolicyType) []metav1.StatusCause {
+	switch policy {
+	case "", poolv1.VirtualMachinePoolRetainDataVolumeRetentionPolicy, poolv1.VirtualMachinePoolDeleteDataVolumeRetentionPolicy:
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("unsupported DataVolume retention policy %s", policy),
+		Field:   field.String(),
+	}}
+}
+
+// validateVMPoolIntOrPercent validates a non-negative int or percentage value and reports whether it is zero.
+func validateVMPoolIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString) (bool, []metav1.StatusCause) {
+	if value == nil {
+		return false, nil
+	}
+
+	var intValue int
+	if value.Type == intstr.String {
+		percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%"))
+		if err != nil || !strings.HasSuffix(value.StrVal, "%") || percent > 100 {
+			return false, []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be an integer or a percentage between 0%% and 100%%", value.StrVal),
+				Field:   field.String(),
+			}}
+		}
+		intValue = percent
+	} else {
+		intValue = value.IntValue()
+	}
+
+	if intValue < 0 {
+		return false, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than or equal to 0",
+			Field:   field.String(),
+		}}
+	}
+	return intValue == 0, nil
+}
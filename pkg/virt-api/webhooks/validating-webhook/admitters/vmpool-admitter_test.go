@@ -25,13 +25,16 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -131,8 +134,9 @@ var _ = Describe("Validating Pool Admitter", func() {
 			"spec.selector",
 		}),
 	)
-	It("should accept valid vm spec", func() {
-		pool := &poolv1.VirtualMachinePool{
+
+	newValidPool := func() *poolv1.VirtualMachinePool {
+		return &poolv1.VirtualMachinePool{
 			Spec: poolv1.VirtualMachinePoolSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"match": "me"},
@@ -160,6 +164,9 @@ var _ = Describe("Validating Pool Admitter", func() {
 				},
 			},
 		}
+	}
+
+	admitPool := func(pool *poolv1.VirtualMachinePool) *admissionv1.AdmissionResponse {
 		poolBytes, _ := json.Marshal(&pool)
 
 		ar := &admissionv1.AdmissionReview{
@@ -171,7 +178,169 @@ var _ = Describe("Validating Pool Admitter", func() {
 			},
 		}
 
-		resp := poolAdmitter.Admit(ar)
+		return poolAdmitter.Admit(ar)
+	}
+
+	It("should accept valid vm spec", func() {
+		resp := admitPool(newValidPool())
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	DescribeTable("should accept valid update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = strategy
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("with opportunistic type", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+		}),
+		Entry("with rolling update type and defaults", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+		}),
+		Entry("with rolling update percentages and partition", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("25%")),
+				MaxSurge:       pointer.P(intstr.FromString("50%")),
+				Partition:      pointer.P(int32(2)),
+				PauseOnFailure: true,
+			},
+		}),
+		Entry("with zero maxUnavailable and non-zero maxSurge", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt(0)),
+				MaxSurge:       pointer.P(intstr.FromInt(1)),
+			},
+		}),
+	)
+
+	DescribeTable("should reject invalid update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy, causes []string) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = strategy
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(len(causes)))
+		for i, cause := range causes {
+			Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+		}
+	},
+		Entry("with unknown type", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: "Unknown",
+		}, []string{"spec.updateStrategy.type"}),
+		Entry("with rollingUpdate on opportunistic type", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type:          poolv1.VirtualMachinePoolOpportunisticUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{},
+		}, []string{"spec.updateStrategy.rollingUpdate"}),
+		Entry("with negative maxUnavailable", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromInt(-1)),
+			},
+		}, []string{"spec.updateStrategy.rollingUpdate.maxUnavailable"}),
+		Entry("with invalid maxSurge percentage", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxSurge: pointer.P(intstr.FromString("150%")),
+			},
+		}, []string{"spec.updateStrategy.rollingUpdate.maxSurge"}),
+		Entry("with zero maxUnavailable and zero maxSurge", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: pointer.P(intstr.FromString("0%")),
+			},
+		}, []string{"spec.updateStrategy.rollingUpdate.maxUnavailable"}),
+		Entry("with negative partition", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				Partition: pointer.P(int32(-1)),
+			},
+		}, []string{"spec.updateStrategy.rollingUpdate.partition"}),
+	)
+
+	DescribeTable("should validate the scale in policy", func(policy poolv1.VirtualMachinePoolScaleInPolicy, allowed bool) {
+		pool := newValidPool()
+		pool.Spec.ScaleInPolicy = policy
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(Equal(allowed))
+		if !allowed {
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.scaleInPolicy"))
+		}
+	},
+		Entry("accept an empty policy", poolv1.VirtualMachinePoolScaleInPolicy(""), true),
+		Entry("accept Random", poolv1.VirtualMachinePoolRandomScaleInPolicy, true),
+		Entry("accept Newest", poolv1.VirtualMachinePoolNewestScaleInPolicy, true),
+		Entry("accept Oldest", poolv1.VirtualMachinePoolOldestScaleInPolicy, true),
+		Entry("accept NotReady", poolv1.VirtualMachinePoolNotReadyScaleInPolicy, true),
+		Entry("accept NodeSpread", poolv1.VirtualMachinePoolNodeSpreadScaleInPolicy, true),
+		Entry("reject an unknown policy", poolv1.VirtualMachinePoolScaleInPolicy("Unknown"), false),
+	)
+
+	DescribeTable("should validate the stateful policy", func(mutate func(spec *poolv1.VirtualMachinePoolSpec), causes []string) {
+		pool := newValidPool()
+		pool.Spec.StatefulPolicy = &poolv1.VirtualMachinePoolStatefulPolicy{}
+		mutate(&pool.Spec)
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(Equal(len(causes) == 0))
+		if len(causes) > 0 {
+			Expect(resp.Result.Details.Causes).To(HaveLen(len(causes)))
+			for i, cause := range causes {
+				Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+			}
+		}
+	},
+		Entry("accept the defaults", func(spec *poolv1.VirtualMachinePoolSpec) {}, nil),
+		Entry("accept parallel management with retention policies and a scale in policy", func(spec *poolv1.VirtualMachinePoolSpec) {
+			spec.ScaleInPolicy = poolv1.VirtualMachinePoolNewestScaleInPolicy
+			spec.StatefulPolicy.ManagementPolicy = poolv1.VirtualMachinePoolParallelManagementPolicy
+			spec.StatefulPolicy.DataVolumeRetentionPolicy = &poolv1.VirtualMachinePoolDataVolumeRetentionPolicy{
+				WhenDeleted: poolv1.VirtualMachinePoolDeleteDataVolumeRetentionPolicy,
+				WhenScaled:  poolv1.VirtualMachinePoolRetainDataVolumeRetentionPolicy,
+			}
+		}, nil),
+		Entry("reject an unknown management policy", func(spec *poolv1.VirtualMachinePoolSpec) {
+			spec.StatefulPolicy.ManagementPolicy = "Unknown"
+		}, []string{"spec.statefulPolicy.managementPolicy"}),
+		Entry("reject a scale in policy with ordered management", func(spec *poolv1.VirtualMachinePoolSpec) {
+			spec.ScaleInPolicy = poolv1.VirtualMachinePoolNewestScaleInPolicy
+		}, []string{"spec.scaleInPolicy"}),
+		Entry("reject unknown retention policies", func(spec *poolv1.VirtualMachinePoolSpec) {
+			spec.StatefulPolicy.DataVolumeRetentionPolicy = &poolv1.VirtualMachinePoolDataVolumeRetentionPolicy{
+				WhenDeleted: "Unknown",
+				WhenScaled:  "Unknown",
+			}
+		}, []string{
+			"spec.statefulPolicy.dataVolumeRetentionPolicy.whenDeleted",
+			"spec.statefulPolicy.dataVolumeRetentionPolicy.whenScaled",
+		}),
+		Entry("reject maxSurge", func(spec *poolv1.VirtualMachinePoolSpec) {
+			spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+				Type: poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+				RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxSurge: pointer.P(intstr.FromInt(1)),
+				},
+			}
+		}, []string{"spec.updateStrategy.rollingUpdate.maxSurge"}),
+		Entry("accept inline cloud-init data", func(spec *poolv1.VirtualMachinePoolSpec) {
+			addCloudInitVolume(spec, &v1.CloudInitNoCloudSource{UserDataBase64: "aG9zdG5hbWU6IHt7IC5JbmRleCB9fQ=="})
+		}, nil),
+		Entry("reject cloud-init data referenced from a secret", func(spec *poolv1.VirtualMachinePoolSpec) {
+			addCloudInitVolume(spec, &v1.CloudInitNoCloudSource{UserDataSecretRef: &k8sv1.LocalObjectReference{Name: "userdata"}})
+		}, []string{"spec.virtualMachineTemplate.spec.template.spec.volumes[1]"}),
+	)
 })
+
+func addCloudInitVolume(spec *poolv1.VirtualMachinePoolSpec, source *v1.CloudInitNoCloudSource) {
+	templateSpec := &spec.VirtualMachineTemplate.Spec.Template.Spec
+	templateSpec.Domain.Devices.Disks = append(templateSpec.Domain.Devices.Disks, v1.Disk{Name: "cloudinit"})
+	templateSpec.Volumes = append(templateSpec.Volumes, v1.Volume{
+		Name:         "cloudinit",
+		VolumeSource: v1.VolumeSource{CloudInitNoCloud: source},
+	})
+}
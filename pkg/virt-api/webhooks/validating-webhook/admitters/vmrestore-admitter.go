@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -102,6 +103,7 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 					if err != nil {
 						return webhookutils.ToAdmissionResponseError(err)
 					}
+					causes = append(causes, validateRestoreMode(k8sfield.NewPath("spec"), vmRestore, targetVMExists)...)
 				default:
 					causes = []metav1.StatusCause{
 						{
@@ -128,6 +130,7 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 			vmRestore.Spec.VirtualMachineSnapshotName,
 			targetUID,
 			targetVMExists,
+			vmRestore.Spec.VolumeNames,
 		)
 		if err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
@@ -199,11 +202,21 @@ func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, vmRest
 		return nil, nil, false, err
 	}
 
+	if vmRestore.Spec.Mode != nil && *vmRestore.Spec.Mode == snapshotv1.RestoreModeVolumesOnly {
+		// the target VM is left untouched when only the volumes are restored
+		return causes, nil, true, nil
+	}
+
 	rs, err := vm.RunStrategy()
 	if err != nil {
 		return nil, nil, true, err
 	}
 
+	if vmRestore.Spec.Mode != nil && *vmRestore.Spec.Mode == snapshotv1.RestoreModeOnline {
+		causes = append(causes, admitter.validateOnlineRestore(field, vmRestore, vm, rs)...)
+		return causes, &vm.UID, true, nil
+	}
+
 	if rs != v1.RunStrategyHalted {
 		var cause metav1.StatusCause
 		targetField := field.Child("target")
@@ -226,6 +239,131 @@ func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, vmRest
 	return causes, &vm.UID, true, nil
 }
 
+func validateRestoreMode(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore, targetVMExists bool) (causes []metav1.StatusCause) {
+	mode := snapshotv1.RestoreModeFull
+	if vmRestore.Spec.Mode != nil {
+		mode = *vmRestore.Spec.Mode
+	}
+
+	switch mode {
+	case snapshotv1.RestoreModeFull:
+	case snapshotv1.RestoreModeSpecOnly:
+		if len(vmRestore.Spec.VolumeNames) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volumeNames can not be set with %s mode", mode),
+				Field:   field.Child("volumeNames").String(),
+			})
+		}
+	case snapshotv1.RestoreModeOnline:
+		if len(vmRestore.Spec.VolumeNames) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("volumeNames are required with %s mode", mode),
+				Field:   field.Child("volumeNames").String(),
+			})
+		}
+		if len(vmRestore.Spec.Patches) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("patches can not be set with %s mode", mode),
+				Field:   field.Child("patches").String(),
+			})
+		}
+	case snapshotv1.RestoreModeVolumesOnly:
+		if len(vmRestore.Spec.Patches) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("patches can not be set with %s mode", mode),
+				Field:   field.Child("patches").String(),
+			})
+		}
+		return causes
+	default:
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("invalid restore mode %q", mode),
+				Field:   field.Child("mode").String(),
+			},
+		}
+	}
+
+	partialRestore := mode == snapshotv1.RestoreModeSpecOnly || len(vmRestore.Spec.VolumeNames) > 0
+	if partialRestore && !targetVMExists {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VirtualMachine %q must exist for a partial restore", vmRestore.Spec.Target.Name),
+			Field:   field.Child("target").String(),
+		})
+	}
+
+	return causes
+}
+
+// validateOnlineRestore checks that the selected volumes of the running VM can be hot swapped
+func (admitter *VMRestoreAdmitter) validateOnlineRestore(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore, vm *v1.VirtualMachine, rs v1.VirtualMachineRunStrategy) (causes []metav1.StatusCause) {
+	if !admitter.Config.HotplugVolumesEnabled() {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s mode requires the HotplugVolumes feature gate", snapshotv1.RestoreModeOnline),
+				Field:   field.Child("mode").String(),
+			},
+		}
+	}
+
+	if rs == v1.RunStrategyHalted {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VirtualMachine %q has to be running for %s mode", vm.Name, snapshotv1.RestoreModeOnline),
+			Field:   field.Child("target").String(),
+		})
+	}
+
+	for i, volumeName := range vmRestore.Spec.VolumeNames {
+		if !onlineRestoreVolumeSupported(vm, volumeName) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %q has to be a hotpluggable data volume of VirtualMachine %q", volumeName, vm.Name),
+				Field:   field.Child("volumeNames").Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func onlineRestoreVolumeSupported(vm *v1.VirtualMachine, volumeName string) bool {
+	if vm.Spec.Template == nil {
+		return false
+	}
+
+	hotpluggable := false
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.Name != volumeName {
+			continue
+		}
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			hotpluggable = volume.PersistentVolumeClaim.Hotpluggable
+		case volume.DataVolume != nil:
+			hotpluggable = volume.DataVolume.Hotpluggable
+		}
+	}
+	if !hotpluggable {
+		return false
+	}
+
+	// the boot disk can not be unplugged from the guest
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		if disk.Name == volumeName {
+			return disk.BootOrder == nil
+		}
+	}
+	return false
+}
+
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
 	// Validate patches are either on labels/annotations or on elements under "/spec/" path only
 	for _, patch := range patches {
@@ -263,7 +401,7 @@ func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sf
 	return causes
 }
 
-func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namespace, name string, targetUID *types.UID, targetVMExists bool) ([]metav1.StatusCause, error) {
+func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namespace, name string, targetUID *types.UID, targetVMExists bool, volumeNames []string) ([]metav1.StatusCause, error) {
 	snapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(context.Background(), name, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		return []metav1.StatusCause{
@@ -309,5 +447,17 @@ func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namesp
 		causes = append(causes, cause)
 	}
 
+	if snapshot.Status != nil && snapshot.Status.SnapshotVolumes != nil {
+		for _, volumeName := range volumeNames {
+			if !slices.Contains(snapshot.Status.SnapshotVolumes.IncludedVolumes, volumeName) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("volume %q is not included in VirtualMachineSnapshot %q", volumeName, name),
+					Field:   k8sfield.NewPath("spec", "volumeNames").String(),
+				})
+			}
+		}
+	}
+
 	return causes, nil
 }
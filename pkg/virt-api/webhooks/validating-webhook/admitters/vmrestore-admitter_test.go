@@ -42,6 +42,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -611,6 +612,182 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				})
 			})
 
+			Context("when using restore modes", func() {
+
+				var restore *snapshotv1.VirtualMachineRestore
+
+				BeforeEach(func() {
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+						},
+					}
+					vm.Spec.Running = &f
+				})
+
+				DescribeTable("should accept", func(mode snapshotv1.VirtualMachineRestoreMode, volumeNames ...string) {
+					restore.Spec.Mode = &mode
+					restore.Spec.VolumeNames = volumeNames
+					snapshotWithVolumes := snapshot.DeepCopy()
+					snapshotWithVolumes.Status.SnapshotVolumes = &snapshotv1.SnapshotVolumesLists{
+						IncludedVolumes: []string{"disk1", "disk2"},
+					}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes).Admit(ar)
+					Expect(resp.Allowed).To(BeTrue())
+				},
+					Entry("selected volumes", snapshotv1.RestoreModeFull, "disk1"),
+					Entry("spec only mode", snapshotv1.RestoreModeSpecOnly),
+					Entry("volumes only mode with selected volumes", snapshotv1.RestoreModeVolumesOnly, "disk2"),
+				)
+
+				It("should accept volumes only mode when the VM is running", func() {
+					restore.Spec.Mode = pointer.P(snapshotv1.RestoreModeVolumesOnly)
+					vm.Spec.Running = &t
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				DescribeTable("should reject", func(update func(*snapshotv1.VirtualMachineRestore), field, message string) {
+					update(restore)
+					snapshotWithVolumes := snapshot.DeepCopy()
+					snapshotWithVolumes.Status.SnapshotVolumes = &snapshotv1.SnapshotVolumesLists{
+						IncludedVolumes: []string{"disk1"},
+					}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes).Admit(ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+					Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(message))
+				},
+					Entry("an invalid mode", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Mode = pointer.P(snapshotv1.VirtualMachineRestoreMode("Invalid"))
+					}, "spec.mode", "invalid restore mode"),
+					Entry("volume names with spec only mode", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Mode = pointer.P(snapshotv1.RestoreModeSpecOnly)
+						r.Spec.VolumeNames = []string{"disk1"}
+					}, "spec.volumeNames", "volumeNames can not be set with SpecOnly mode"),
+					Entry("patches with volumes only mode", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Mode = pointer.P(snapshotv1.RestoreModeVolumesOnly)
+						r.Spec.Patches = []string{`{"op": "replace", "path": "/spec/running", "value": true}`}
+					}, "spec.patches", "patches can not be set with VolumesOnly mode"),
+					Entry("a volume which is not in the snapshot", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.VolumeNames = []string{"disk2"}
+					}, "spec.volumeNames", `volume "disk2" is not included`),
+					Entry("a partial restore of a VM which does not exist", func(r *snapshotv1.VirtualMachineRestore) {
+						r.Spec.Target.Name = "new-vm"
+						r.Spec.Mode = pointer.P(snapshotv1.RestoreModeSpecOnly)
+					}, "spec.target", `VirtualMachine "new-vm" must exist for a partial restore`),
+				)
+
+				Context("with online mode", func() {
+					var snapshotWithVolumes *snapshotv1.VirtualMachineSnapshot
+
+					BeforeEach(func() {
+						restore.Spec.Mode = pointer.P(snapshotv1.RestoreModeOnline)
+						restore.Spec.VolumeNames = []string{"disk2"}
+						snapshotWithVolumes = snapshot.DeepCopy()
+						snapshotWithVolumes.Status.SnapshotVolumes = &snapshotv1.SnapshotVolumesLists{
+							IncludedVolumes: []string{"disk1", "disk2"},
+						}
+						vm.Spec.Running = &t
+						vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
+							Spec: v1.VirtualMachineInstanceSpec{
+								Domain: v1.DomainSpec{
+									Devices: v1.Devices{
+										Disks: []v1.Disk{
+											{Name: "disk1", BootOrder: pointer.P(uint(1))},
+											{Name: "disk2"},
+										},
+									},
+								},
+								Volumes: []v1.Volume{
+									{
+										Name: "disk1",
+										VolumeSource: v1.VolumeSource{
+											DataVolume: &v1.DataVolumeSource{Name: "dv1", Hotpluggable: true},
+										},
+									},
+									{
+										Name: "disk2",
+										VolumeSource: v1.VolumeSource{
+											DataVolume: &v1.DataVolumeSource{Name: "dv2", Hotpluggable: true},
+										},
+									},
+								},
+							},
+						}
+						testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+							Spec: v1.KubeVirtSpec{
+								Configuration: v1.KubeVirtConfiguration{
+									DeveloperConfiguration: &v1.DeveloperConfiguration{
+										FeatureGates: []string{"Snapshot", "HotplugVolumes"},
+									},
+								},
+							},
+						})
+					})
+
+					It("should accept a hotpluggable data volume of a running VM", func() {
+						ar := createRestoreAdmissionReview(restore)
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes).Admit(ar)
+						Expect(resp.Allowed).To(BeTrue())
+					})
+
+					It("should reject without the HotplugVolumes feature gate", func() {
+						enableFeatureGate("Snapshot")
+
+						ar := createRestoreAdmissionReview(restore)
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes).Admit(ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.mode"))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("requires the HotplugVolumes feature gate"))
+					})
+
+					DescribeTable("should reject", func(update func(*snapshotv1.VirtualMachineRestore, *v1.VirtualMachine), field, message string) {
+						update(restore, vm)
+
+						ar := createRestoreAdmissionReview(restore)
+						resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes).Admit(ar)
+						Expect(resp.Allowed).To(BeFalse())
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(message))
+					},
+						Entry("a stopped VM", func(_ *snapshotv1.VirtualMachineRestore, vm *v1.VirtualMachine) {
+							vm.Spec.Running = &f
+						}, "spec.target", "has to be running for Online mode"),
+						Entry("missing volume names", func(r *snapshotv1.VirtualMachineRestore, _ *v1.VirtualMachine) {
+							r.Spec.VolumeNames = nil
+						}, "spec.volumeNames", "volumeNames are required with Online mode"),
+						Entry("patches", func(r *snapshotv1.VirtualMachineRestore, _ *v1.VirtualMachine) {
+							r.Spec.Patches = []string{`{"op": "replace", "path": "/spec/running", "value": false}`}
+						}, "spec.patches", "patches can not be set with Online mode"),
+						Entry("the boot disk", func(r *snapshotv1.VirtualMachineRestore, _ *v1.VirtualMachine) {
+							r.Spec.VolumeNames = []string{"disk1"}
+						}, "spec.volumeNames[0]", `volume "disk1" has to be a hotpluggable data volume`),
+						Entry("a volume which is not hotpluggable", func(_ *snapshotv1.VirtualMachineRestore, vm *v1.VirtualMachine) {
+							vm.Spec.Template.Spec.Volumes[1].DataVolume.Hotpluggable = false
+						}, "spec.volumeNames[0]", `volume "disk2" has to be a hotpluggable data volume`),
+					)
+				})
+			})
+
 		})
 	})
 })
@@ -29,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -76,6 +77,24 @@ func (p *authProxy) CreateSar(sar *authv1.SubjectAccessReview) (*authv1.SubjectA
 	return p.client.AuthorizationV1().SubjectAccessReviews().Create(context.Background(), sar, metav1.CreateOptions{})
 }
 
+// newUserSubjectAccessReview returns a review checking whether the requesting user is allowed to access the resource
+func newUserSubjectAccessReview(userInfo authenticationv1.UserInfo, resourceAttributes authv1.ResourceAttributes) *authv1.SubjectAccessReview {
+	extra := map[string]authv1.ExtraValue{}
+	for key, value := range userInfo.Extra {
+		extra[key] = authv1.ExtraValue(value)
+	}
+
+	return &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:               userInfo.Username,
+			Groups:             userInfo.Groups,
+			UID:                userInfo.UID,
+			Extra:              extra,
+			ResourceAttributes: &resourceAttributes,
+		},
+	}
+}
+
 func (p *authProxy) GetNamespace(name string) (*corev1.Namespace, error) {
 	obj, exists, err := p.namespaceInformer.GetStore().GetByKey(name)
 	if err != nil {
@@ -720,7 +739,7 @@ func validateRestoreStatus(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachi
 
 	if !equality.Semantic.DeepEqual(oldVM.Spec, vm.Spec) {
 		strategy, _ := vm.RunStrategy()
-		if strategy != v1.RunStrategyHalted {
+		if strategy != v1.RunStrategyHalted && !onlyVolumesChanged(oldVM, vm) {
 			return []metav1.StatusCause{{
 				Type:    metav1.CauseTypeFieldValueNotSupported,
 				Message: fmt.Sprintf("Cannot start VM until restore %q completes", *vm.Status.RestoreInProgress),
@@ -732,6 +751,24 @@ func validateRestoreStatus(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachi
 	return nil
 }
 
+// onlyVolumesChanged returns whether only the volumes and disks of a running VM changed,
+// an online restore swaps them through hotplug
+func onlyVolumesChanged(oldVM, vm *v1.VirtualMachine) bool {
+	if oldVM.Spec.Template == nil || vm.Spec.Template == nil {
+		return false
+	}
+	oldStrategy, _ := oldVM.RunStrategy()
+	if oldStrategy == v1.RunStrategyHalted {
+		return false
+	}
+
+	oldSpec := oldVM.Spec.DeepCopy()
+	oldSpec.Template.Spec.Volumes = vm.Spec.Template.Spec.Volumes
+	oldSpec.Template.Spec.Domain.Devices.Disks = vm.Spec.Template.Spec.Domain.Devices.Disks
+
+	return equality.Semantic.DeepEqual(*oldSpec, vm.Spec)
+}
+
 func validateSnapshotStatus(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
 	if ar.Operation != admissionv1.Update || vm.Status.SnapshotInProgress == nil {
 		return nil
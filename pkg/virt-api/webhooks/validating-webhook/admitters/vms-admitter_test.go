@@ -1701,6 +1701,76 @@ var _ = Describe("Validating VM Admitter", func() {
 		}, false),
 	)
 
+	DescribeTable("when an online restore is in progress, should", func(mutateFn func(*v1.VirtualMachine) bool) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}}
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "disk0",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc"},
+				},
+			},
+		}}
+		vm := &v1.VirtualMachine{
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy: pointer.P(v1.RunStrategyAlways),
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: vmi.Spec,
+				},
+			},
+			Status: v1.VirtualMachineStatus{
+				RestoreInProgress: pointer.P("testrestore"),
+			},
+		}
+		oldObjectBytes, _ := json.Marshal(vm)
+
+		allow := mutateFn(vm)
+		objectBytes, _ := json.Marshal(vm)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Resource:  webhooks.VirtualMachineGroupVersionResource,
+				OldObject: runtime.RawExtension{
+					Raw: oldObjectBytes,
+				},
+				Object: runtime.RawExtension{
+					Raw: objectBytes,
+				},
+			},
+		}
+
+		resp := vmsAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(Equal(allow))
+
+		if !allow {
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		}
+	},
+		Entry("accept unplugging a volume", func(vm *v1.VirtualMachine) bool {
+			vm.Spec.Template.Spec.Volumes = nil
+			vm.Spec.Template.Spec.Domain.Devices.Disks = nil
+			return true
+		}),
+		Entry("accept plugging the restored volume", func(vm *v1.VirtualMachine) bool {
+			vm.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = "restore-pvc"
+			vm.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.Hotpluggable = true
+			return true
+		}),
+		Entry("reject update to other fields of the spec", func(vm *v1.VirtualMachine) bool {
+			vm.Spec.Template.Spec.Hostname = "foo"
+			return false
+		}),
+		Entry("reject update of runStrategy", func(vm *v1.VirtualMachine) bool {
+			vm.Spec.RunStrategy = pointer.P(v1.RunStrategyManual)
+			vm.Spec.Template.Spec.Volumes = nil
+			vm.Spec.Template.Spec.Domain.Devices.Disks = nil
+			return false
+		}),
+	)
+
 	Context("Instancetype", func() {
 		var (
 			vm *v1.VirtualMachine
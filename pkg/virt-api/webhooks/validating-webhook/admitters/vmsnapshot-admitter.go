@@ -23,20 +23,26 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
 
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -117,6 +123,17 @@ func (admitter *VMSnapshotAdmitter) Admit(ar *admissionv1.AdmissionReview) *admi
 			}
 		}
 
+		if vmSnapshot.Spec.Hooks != nil {
+			hooksField := k8sfield.NewPath("spec", "hooks")
+			causes = append(causes, validateSnapshotHooks(hooksField, vmSnapshot.Spec.Hooks)...)
+
+			hookCauses, err := admitter.validateSnapshotHooksAccess(hooksField, ar.Request.Namespace, vmSnapshot, ar.Request.UserInfo)
+			if err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
+			}
+			causes = append(causes, hookCauses...)
+		}
+
 	case admissionv1.Update:
 		prevObj := &snapshotv1.VirtualMachineSnapshot{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
@@ -175,3 +192,98 @@ func (admitter *VMSnapshotAdmitter) validateCreateVM(field *k8sfield.Path, names
 
 	return []metav1.StatusCause{}, nil
 }
+
+func validateSnapshotHooks(field *k8sfield.Path, hooks *snapshotv1.SnapshotHooks) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	causes = append(causes, validateSnapshotHookList(field.Child("preFreeze"), hooks.PreFreeze)...)
+	causes = append(causes, validateSnapshotHookList(field.Child("postThaw"), hooks.PostThaw)...)
+	return causes
+}
+
+func validateSnapshotHookList(field *k8sfield.Path, hooks []snapshotv1.SnapshotHook) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	names := sets.New[string]()
+
+	for i, hook := range hooks {
+		hookField := field.Index(i)
+
+		if hook.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "hook name is required",
+				Field:   hookField.Child("name").String(),
+			})
+		} else if names.Has(hook.Name) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("duplicate hook name %q", hook.Name),
+				Field:   hookField.Child("name").String(),
+			})
+		}
+		names.Insert(hook.Name)
+
+		if len(hook.Command) == 0 || hook.Command[0] == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "hook command is required",
+				Field:   hookField.Child("command").String(),
+			})
+		}
+
+		if hook.Timeout != nil && hook.Timeout.Duration < time.Second {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "hook timeout must be at least 1s",
+				Field:   hookField.Child("timeout").String(),
+			})
+		} else if hook.Timeout != nil && hook.Timeout.Duration > snapshot.MaxSnapshotHookTimeout {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("hook timeout must not exceed %s", snapshot.MaxSnapshotHookTimeout),
+				Field:   hookField.Child("timeout").String(),
+			})
+		}
+
+		if hook.FailurePolicy != nil &&
+			*hook.FailurePolicy != snapshotv1.SnapshotHookFailurePolicyAbort &&
+			*hook.FailurePolicy != snapshotv1.SnapshotHookFailurePolicyContinue {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("invalid hook failure policy %q", *hook.FailurePolicy),
+				Field:   hookField.Child("failurePolicy").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateSnapshotHooksAccess rejects hooks unless the user is allowed to run commands in the guest of the source,
+// the snapshot controller runs them on behalf of the user
+func (admitter *VMSnapshotAdmitter) validateSnapshotHooksAccess(field *k8sfield.Path, namespace string, vmSnapshot *snapshotv1.VirtualMachineSnapshot, userInfo authenticationv1.UserInfo) ([]metav1.StatusCause, error) {
+	if len(vmSnapshot.Spec.Hooks.PreFreeze) == 0 && len(vmSnapshot.Spec.Hooks.PostThaw) == 0 {
+		return nil, nil
+	}
+
+	proxy := &authProxy{client: admitter.Client}
+	response, err := proxy.CreateSar(newUserSubjectAccessReview(userInfo, authv1.ResourceAttributes{
+		Namespace:   namespace,
+		Name:        vmSnapshot.Spec.Source.Name,
+		Verb:        "update",
+		Group:       v1.SubresourceGroupName,
+		Resource:    "virtualmachineinstances",
+		Subresource: "guestexec",
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if response.Status.Allowed {
+		return nil, nil
+	}
+
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("user %s is not allowed to run commands in the guest of %s", userInfo.Username, vmSnapshot.Spec.Source.Name),
+		Field:   field.String(),
+	}}, nil
+}
@@ -22,6 +22,7 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"k8s.io/utils/pointer"
 
@@ -30,16 +31,20 @@ import (
 	. "github.com/onsi/gomega"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	v1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
+	virtpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -298,6 +303,93 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("needs backend storage"))
 			})
 
+			It("should accept valid hooks", func() {
+				snapshot := &snapshotv1.VirtualMachineSnapshot{
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						Hooks: &snapshotv1.SnapshotHooks{
+							PreFreeze: []snapshotv1.SnapshotHook{
+								{Name: "flush", Command: []string{"/usr/bin/flush", "--all"}, Timeout: &metav1.Duration{Duration: time.Minute}},
+							},
+							PostThaw: []snapshotv1.SnapshotHook{
+								{Name: "flush", Command: []string{"/usr/bin/resume"}, FailurePolicy: virtpointer.P(snapshotv1.SnapshotHookFailurePolicyContinue)},
+							},
+						},
+					},
+				}
+
+				ar := createSnapshotAdmissionReview(snapshot)
+				resp := createTestVMSnapshotAdmitter(config, vm).Admit(ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+
+			It("should reject hooks when the user is not allowed to run commands in the guest", func() {
+				snapshot := &snapshotv1.VirtualMachineSnapshot{
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						Hooks: &snapshotv1.SnapshotHooks{
+							PreFreeze: []snapshotv1.SnapshotHook{
+								{Name: "flush", Command: []string{"/usr/bin/flush"}},
+							},
+						},
+					},
+				}
+
+				ar := createSnapshotAdmissionReview(snapshot)
+				ar.Request.UserInfo.Username = unprivilegedSnapshotUser
+				resp := createTestVMSnapshotAdmitter(config, vm).Admit(ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.hooks"))
+				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("not allowed to run commands in the guest"))
+			})
+
+			DescribeTable("should reject invalid hooks", func(hook snapshotv1.SnapshotHook, field, message string) {
+				snapshot := &snapshotv1.VirtualMachineSnapshot{
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     vmName,
+						},
+						Hooks: &snapshotv1.SnapshotHooks{
+							PreFreeze: []snapshotv1.SnapshotHook{
+								{Name: "flush", Command: []string{"/usr/bin/flush"}},
+								hook,
+							},
+						},
+					},
+				}
+
+				ar := createSnapshotAdmissionReview(snapshot)
+				resp := createTestVMSnapshotAdmitter(config, vm).Admit(ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(message))
+			},
+				Entry("without name", snapshotv1.SnapshotHook{Command: []string{"/bin/true"}},
+					"spec.hooks.preFreeze[1].name", "hook name is required"),
+				Entry("with a duplicate name", snapshotv1.SnapshotHook{Name: "flush", Command: []string{"/bin/true"}},
+					"spec.hooks.preFreeze[1].name", "duplicate hook name"),
+				Entry("without command", snapshotv1.SnapshotHook{Name: "lock"},
+					"spec.hooks.preFreeze[1].command", "hook command is required"),
+				Entry("with a too short timeout", snapshotv1.SnapshotHook{Name: "lock", Command: []string{"/bin/true"}, Timeout: &metav1.Duration{Duration: time.Millisecond}},
+					"spec.hooks.preFreeze[1].timeout", "hook timeout must be at least 1s"),
+				Entry("with a too long timeout", snapshotv1.SnapshotHook{Name: "lock", Command: []string{"/bin/true"}, Timeout: &metav1.Duration{Duration: time.Hour}},
+					"spec.hooks.preFreeze[1].timeout", "hook timeout must not exceed 5m0s"),
+				Entry("with an invalid failure policy", snapshotv1.SnapshotHook{Name: "lock", Command: []string{"/bin/true"}, FailurePolicy: virtpointer.P(snapshotv1.SnapshotHookFailurePolicy("Retry"))},
+					"spec.hooks.preFreeze[1].failurePolicy", "invalid hook failure policy"),
+			)
+
 			It("should accept when VM is not running", func() {
 				snapshot := &snapshotv1.VirtualMachineSnapshot{
 					Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -364,11 +456,23 @@ func createSnapshotUpdateAdmissionReview(old, current *snapshotv1.VirtualMachine
 	return ar
 }
 
+const unprivilegedSnapshotUser = "unprivileged"
+
 func createTestVMSnapshotAdmitter(config *virtconfig.ClusterConfig, vm *v1.VirtualMachine) *VMSnapshotAdmitter {
 	ctrl := gomock.NewController(GinkgoT())
 	virtClient := kubecli.NewMockKubevirtClient(ctrl)
 	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
 	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
+	k8sClient := k8sfake.NewSimpleClientset()
+	k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+		Expect(sar.Spec.ResourceAttributes.Resource).To(Equal("virtualmachineinstances"))
+		Expect(sar.Spec.ResourceAttributes.Subresource).To(Equal("guestexec"))
+		Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("update"))
+		sar.Status.Allowed = sar.Spec.User != unprivilegedSnapshotUser
+		return true, sar, nil
+	})
+	virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
 	if vm == nil {
 		err := errors.NewNotFound(schema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, "foo")
 		vmInterface.EXPECT().Get(context.Background(), gomock.Any(), gomock.Any()).Return(nil, err).AnyTimes()
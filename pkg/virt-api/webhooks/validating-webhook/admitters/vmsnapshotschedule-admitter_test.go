@@ -0,0 +1,145 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+				Retention: &snapshotv1.SnapshotRetentionPolicy{
+					Last:   pointer.P(int32(3)),
+					Daily:  pointer.P(int32(7)),
+					MaxAge: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+				},
+			},
+		}
+	}
+
+	It("should reject anything without feature gate enabled", func() {
+		resp := NewVMSnapshotScheduleAdmitter(config).Admit(createSnapshotScheduleAdmissionReview(newSchedule()))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+			DeferCleanup(testutils.UpdateFakeKubeVirtClusterConfig, kvStore, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a valid schedule", func() {
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(createSnapshotScheduleAdmissionReview(newSchedule()))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(update func(*snapshotv1.VirtualMachineSnapshotSchedule), field, message string) {
+			schedule := newSchedule()
+			update(schedule)
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(createSnapshotScheduleAdmissionReview(schedule))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+			Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(message))
+		},
+			Entry("an invalid schedule", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "0 25 * * *"
+			}, "spec.schedule", "invalid schedule"),
+			Entry("an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "backup", Operator: "Unknown"}}
+			}, "spec.selector", "invalid selector"),
+			Entry("a negative failure deadline", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.FailureDeadline = &metav1.Duration{Duration: -time.Minute}
+			}, "spec.failureDeadline", "failureDeadline must not be negative"),
+			Entry("a negative retention count", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention.Weekly = pointer.P(int32(-1))
+			}, "spec.retention.weekly", "weekly must not be negative"),
+			Entry("a zero last count", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention.Last = pointer.P(int32(0))
+			}, "spec.retention.last", "last must be at least 1"),
+			Entry("a zero max age", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention.MaxAge = &metav1.Duration{}
+			}, "spec.retention.maxAge", "maxAge must be positive"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(schedule *snapshotv1.VirtualMachineSnapshotSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
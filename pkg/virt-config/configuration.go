@@ -163,6 +163,7 @@ func defaultClusterConfig(cpuArch string) *v1.KubeVirtConfiguration {
 	nodeDrainTaintDefaultKey := NodeDrainTaintDefaultKey
 	allowAutoConverge := MigrationAllowAutoConverge
 	allowPostCopy := MigrationAllowPostCopy
+	allowAdaptiveTuning := MigrationAllowAdaptiveTuning
 	defaultUnsafeMigrationOverride := DefaultUnsafeMigrationOverride
 	progressTimeout := MigrationProgressTimeout
 	completionTimeoutPerGiB := MigrationCompletionTimeoutPerGiB
@@ -210,6 +211,7 @@ func defaultClusterConfig(cpuArch string) *v1.KubeVirtConfiguration {
 			UnsafeMigrationOverride:           &defaultUnsafeMigrationOverride,
 			AllowAutoConverge:                 &allowAutoConverge,
 			AllowPostCopy:                     &allowPostCopy,
+			AllowAdaptiveTuning:               &allowAdaptiveTuning,
 		},
 		CPURequest: &cpuRequestDefault,
 		NetworkConfiguration: &v1.NetworkConfiguration{
@@ -93,6 +93,10 @@ const (
 	// This feature requires following Kubernetes feature gate "ServiceAccountTokenPodNodeInfo". The feature gate is available
 	// in Kubernetes 1.30 as Beta.
 	NodeRestrictionGate = "NodeRestriction"
+	// HookSidecarRegistryGate enables injecting the hook sidecars published by the cluster admin as HookSidecar objects
+	HookSidecarRegistryGate = "HookSidecarRegistry"
+	// DecentralizedLiveMigrationGate enables live migrating VMIs between clusters through the migration endpoint of virt-handler
+	DecentralizedLiveMigrationGate = "DecentralizedLiveMigration"
 )
 
 func (config *ClusterConfig) isFeatureGateEnabled(featureGate string) bool {
@@ -268,3 +272,11 @@ func (config *ClusterConfig) VolumeMigrationEnabled() bool {
 func (config *ClusterConfig) NodeRestrictionEnabled() bool {
 	return config.isFeatureGateEnabled(NodeRestrictionGate)
 }
+
+func (config *ClusterConfig) HookSidecarRegistryEnabled() bool {
+	return config.isFeatureGateEnabled(HookSidecarRegistryGate)
+}
+
+func (config *ClusterConfig) DecentralizedLiveMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(DecentralizedLiveMigrationGate)
+}
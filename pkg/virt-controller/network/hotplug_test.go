@@ -25,6 +25,7 @@ import (
 	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -201,7 +202,18 @@ var _ = Describe("Network interface hot{un}plug", func() {
 				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
 			),
 			!ordinal),
-		Entry("when an interface has to be hotplugged but it has no SRIOV or bridge binding",
+		Entry("when a binding plugin interface has to be hotplugged",
+			libvmi.New(
+				libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: "vendor-binding"}}),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(),
+			libvmi.New(
+				libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: "vendor-binding"}}),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when an interface has to be hotplugged but it has no SRIOV, bridge or plugin binding",
 			libvmi.New(
 				libvmi.WithInterface(v1.Interface{Name: testNetworkName1, InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}}),
 				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
@@ -263,6 +275,76 @@ var _ = Describe("Network interface hot{un}plug", func() {
 				libvmi.WithNetwork(&v1.Network{Name: testNetworkName2}),
 			),
 			!ordinal),
+		Entry("when the link of an interface has to be set down",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when the link of an interface has to be set up",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateUp)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateDown)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateUp)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when the link of an interface which is hotunplugged has to be set up",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithState(testNetworkName1, v1.InterfaceStateUp)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeAbsentInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when the bandwidth of an interface has to be updated",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithBandwidth(testNetworkName1, "20Mi")),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithBandwidth(testNetworkName1, "10Mi")),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithBandwidth(testNetworkName1, "20Mi")),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
+		Entry("when the bandwidth of an interface has to be removed",
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterfaceWithBandwidth(testNetworkName1, "10Mi")),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			libvmi.New(
+				libvmi.WithInterface(bridgeInterface(testNetworkName1)),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			),
+			!ordinal),
 	)
 
 	DescribeTable("spec interfaces",
@@ -322,8 +404,20 @@ func sriovInterface(name string) v1.Interface {
 }
 
 func bridgeAbsentInterface(name string) v1.Interface {
+	return bridgeInterfaceWithState(name, v1.InterfaceStateAbsent)
+}
+
+func bridgeInterfaceWithState(name string, state v1.InterfaceState) v1.Interface {
+	iface := bridgeInterface(name)
+	iface.State = state
+	return iface
+}
+
+func bridgeInterfaceWithBandwidth(name, inboundAverage string) v1.Interface {
 	iface := bridgeInterface(name)
-	iface.State = v1.InterfaceStateAbsent
+	iface.Bandwidth = &v1.InterfaceBandwidth{
+		Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse(inboundAverage)},
+	}
 	return iface
 }
 
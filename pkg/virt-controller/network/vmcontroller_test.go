@@ -157,6 +157,44 @@ var _ = Describe("VM Network Controller", func() {
 		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces))
 	})
 
+	It("sync succeeds to hotplug new binding plugin interface", func() {
+		clientset := fake.NewSimpleClientset()
+		c := network.NewVMNetController(
+			clientset,
+			stubClusterConfig{netHotplugEnabled: true},
+			stubPodGetter{pod: &k8sv1.Pod{}},
+		)
+		vmi := libvmi.New(
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(v1.DefaultPodNetwork()),
+		)
+		vm := libvmi.NewVirtualMachine(vmi.DeepCopy())
+
+		const netName = "foonet"
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces = append(
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces,
+			v1.Interface{Name: netName, Binding: &v1.PluginBinding{Name: "myplugin"}},
+		)
+		vm.Spec.Template.Spec.Networks = append(
+			vm.Spec.Template.Spec.Networks,
+			*libvmi.MultusNetwork(netName, netName+"-nad"),
+		)
+
+		// Simulate the existence of the VMI on the server (to allow the Sync to patch it).
+		_, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, k8smetav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		updatedVM, err := c.Sync(vm, vmi)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Assert that the hotplug reached the VMI
+		updatedVMI, err := clientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(updatedVMI.Spec.Networks).To(Equal(updatedVM.Spec.Template.Spec.Networks))
+		Expect(updatedVMI.Spec.Domain.Devices.Interfaces).To(Equal(updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces))
+	})
+
 	It("sync succeeds to clear hotunplug interfaces", func() {
 		clientset := fake.NewSimpleClientset()
 		c := network.NewVMNetController(
@@ -208,6 +208,15 @@ func containerPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort
 					port.Protocol = "TCP"
 				}
 
+				// Container ports are informational: only the first port of a range is declared,
+				// and a port of all protocols is declared for TCP and UDP. The name must be unique in the pod.
+				if port.Protocol == "ALL" {
+					ports = append(ports,
+						k8sv1.ContainerPort{Protocol: k8sv1.ProtocolTCP, Name: port.Name, ContainerPort: port.Port},
+						k8sv1.ContainerPort{Protocol: k8sv1.ProtocolUDP, ContainerPort: port.Port},
+					)
+					continue
+				}
 				ports = append(ports, k8sv1.ContainerPort{Protocol: k8sv1.Protocol(port.Protocol), Name: port.Name, ContainerPort: port.Port})
 			}
 		}
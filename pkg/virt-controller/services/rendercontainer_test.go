@@ -214,6 +214,22 @@ var _ = Describe("Container spec renderer", func() {
 		})
 	})
 
+	Context("vmi with a port of all protocols and a ports range in its spec", func() {
+		It("the container should feature TCP and UDP ports for all protocols and the first port of the range", func() {
+			specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithPorts(
+				vmiWithInterfaceWithPortAllowList("not-relevant",
+					v1.Port{Name: "dns", Protocol: "ALL", Port: 53},
+					v1.Port{Name: "range", Protocol: "UDP", Port: 5000, EndPort: 5010},
+				)))
+
+			Expect(specRenderer.Render(exampleCommand).Ports).To(Equal([]k8sv1.ContainerPort{
+				{Name: "dns", Protocol: k8sv1.ProtocolTCP, ContainerPort: 53},
+				{Protocol: k8sv1.ProtocolUDP, ContainerPort: 53},
+				{Name: "range", Protocol: k8sv1.ProtocolUDP, ContainerPort: 5000},
+			}))
+		})
+	})
+
 	Context("container command and arguments", func() {
 		DescribeTable("", func(args ...string) {
 			specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithArgs(args))
@@ -42,6 +42,7 @@ import (
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -95,8 +96,10 @@ var _ = Describe("Application", func() {
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		hookSidecarInformer, _ := testutils.NewFakeInformerFor(&hooksv1alpha1.HookSidecar{})
 		crInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
 		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		objectTransferInformer, _ := testutils.NewFakeInformerFor(&cdiv1.ObjectTransfer{})
 		dataSourceInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataSource{})
 		storageProfileInformer, _ := testutils.NewFakeInformerFor(&cdiv1.StorageProfile{})
 		cdiInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
@@ -105,6 +108,7 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
@@ -166,10 +170,12 @@ var _ = Describe("Application", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			namespaceInformer,
 			recorder,
 			virtClient,
 			config,
 		)
+		app.migrationPolicyController, _ = NewMigrationPolicyController(virtClient, migrationPolicyInformer, vmiInformer, namespaceInformer)
 		app.snapshotController = &snapshot.VMSnapshotController{
 			Client:                    virtClient,
 			VMSnapshotInformer:        vmSnapshotInformer,
@@ -198,6 +204,14 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			TemplateService:             services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -227,6 +241,7 @@ var _ = Describe("Application", func() {
 		app.nodeInformer = nodeInformer
 		app.resourceQuotaInformer = resourceQuotaInformer
 		app.namespaceInformer = namespaceInformer
+		app.hookSidecarInformer = hookSidecarInformer
 		app.vmCloneController, _ = clone.NewVmCloneController(
 			virtClient,
 			cloneInformer,
@@ -235,6 +250,8 @@ var _ = Describe("Application", func() {
 			vmInformer,
 			vmSnapshotContentInformer,
 			pvcInformer,
+			dataVolumeInformer,
+			objectTransferInformer,
 			recorder,
 		)
 
@@ -255,6 +272,7 @@ var _ = Describe("Application", func() {
 		go nodeInformer.Run(ctx.Done())
 		go resourceQuotaInformer.Run(ctx.Done())
 		go namespaceInformer.Run(ctx.Done())
+		go hookSidecarInformer.Run(ctx.Done())
 		time.Sleep(time.Second)
 
 		By("Checking prometheus metric")
@@ -31,6 +31,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,6 +40,9 @@ import (
 	k6tv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 )
 
 type cloneSourceType string
@@ -51,19 +55,23 @@ const (
 type cloneTargetType string
 
 const (
-	targetTypeVM cloneTargetType = "VirtualMachine"
-	defaultType  cloneTargetType = targetTypeVM
+	targetTypeVM       cloneTargetType = "VirtualMachine"
+	targetTypeSnapshot cloneTargetType = "VirtualMachineSnapshot"
+	defaultType        cloneTargetType = targetTypeVM
 )
 
 type syncInfoType struct {
-	err             error
-	snapshotName    string
-	snapshotReady   bool
-	restoreName     string
-	restoreReady    bool
-	targetVMName    string
-	targetVMCreated bool
-	pvcBound        bool
+	err                 error
+	snapshotName        string
+	snapshotReady       bool
+	restoreName         string
+	restoreReady        bool
+	transferred         bool
+	targetVMName        string
+	targetVMCreated     bool
+	targetSnapshotReady bool
+	pvcBound            bool
+	replicas            []clonev1alpha1.VirtualMachineCloneReplicaStatus
 
 	isCloneFailing bool
 	failEvent      Event
@@ -97,14 +105,15 @@ func (ctrl *VMCloneController) execute(key string) error {
 	}
 
 	if vmClone.Status.Phase == clonev1alpha1.Succeeded {
-		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", vmClone.Namespace, *vmClone.Status.TargetName))
+		targetNames := getTargetNames(vmClone)
+		targetExists, err := ctrl.anyTargetExists(vmClone, targetNames)
 		if err != nil {
 			return err
 		}
 
-		if !vmExists {
+		if !targetExists {
 			if vmClone.DeletionTimestamp == nil {
-				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", vmClone.Namespace, *vmClone.Status.TargetName)
+				logger.V(3).Infof("Deleting vm clone for deleted targets %v in namespace %s", targetNames, getTargetNamespace(vmClone))
 				return ctrl.client.VirtualMachineClone(vmClone.Namespace).Delete(context.Background(), vmClone.Name, v1.DeleteOptions{})
 			}
 			// nothing to process for a vm clone that's being deleted
@@ -135,8 +144,14 @@ func (ctrl *VMCloneController) sync(vmClone *clonev1alpha1.VirtualMachineClone)
 		return syncInfoType{}, err
 	}
 
-	if ctrl.getTargetType(cloneInfo.vmClone) == targetTypeVM {
+	switch ctrl.getTargetType(cloneInfo.vmClone) {
+	case targetTypeVM:
+		if isBulkClone(cloneInfo.vmClone) {
+			return ctrl.syncTargetVMReplicas(cloneInfo), nil
+		}
 		return ctrl.syncTargetVM(cloneInfo), nil
+	case targetTypeSnapshot:
+		return ctrl.syncTargetSnapshot(cloneInfo), nil
 	}
 	return syncInfoType{err: fmt.Errorf("target type is unknown: %s", ctrl.getTargetType(cloneInfo.vmClone))}, nil
 }
@@ -189,7 +204,7 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 
 		if vmCloneInfo.sourceType == sourceTypeVM {
 			if vmClone.Status.SnapshotName == nil {
-				_, syncInfo = ctrl.createSnapshotFromVm(vmClone, vmCloneInfo.sourceVm, syncInfo)
+				_, syncInfo = ctrl.createSnapshotFromVm(vmClone, generateSnapshot(vmClone, vmCloneInfo.sourceVm), syncInfo)
 				return syncInfo
 			}
 		}
@@ -228,6 +243,17 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 
 		fallthrough
 
+	case clonev1alpha1.TransferInProgress:
+
+		if isCrossNamespaceClone(vmClone) {
+			syncInfo = ctrl.transferTargetVM(vmCloneInfo, syncInfo)
+			if syncInfo.isFailingOrError() || !syncInfo.transferred {
+				return syncInfo
+			}
+		}
+
+		fallthrough
+
 	case clonev1alpha1.CreatingTargetVM:
 
 		syncInfo = ctrl.verifyVmReady(vmClone, syncInfo)
@@ -240,12 +266,19 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 	case clonev1alpha1.Succeeded:
 
 		if vmClone.Status.RestoreName != nil {
-			syncInfo = ctrl.verifyPVCBound(vmClone, syncInfo)
+			syncInfo = ctrl.verifyPVCBound(vmClone, *vmClone.Status.RestoreName, syncInfo)
 			if syncInfo.isFailingOrError() || !syncInfo.pvcBound {
 				return syncInfo
 			}
 
-			syncInfo = ctrl.cleanupRestore(vmClone, syncInfo)
+			if isCrossNamespaceClone(vmClone) {
+				syncInfo = ctrl.cleanupTransfers(vmClone, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+
+			syncInfo = ctrl.cleanupRestore(vmClone, *vmClone.Status.RestoreName, syncInfo)
 			if syncInfo.isFailingOrError() {
 				return syncInfo
 			}
@@ -265,6 +298,34 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 	return syncInfo
 }
 
+// syncTargetSnapshot clones a VirtualMachine into a VirtualMachineSnapshot. Once the snapshot is ready
+// the clone succeeds, leaving the snapshot behind.
+func (ctrl *VMCloneController) syncTargetSnapshot(vmCloneInfo *vmCloneInfo) syncInfoType {
+	vmClone := vmCloneInfo.vmClone
+	syncInfo := syncInfoType{}
+
+	switch vmClone.Status.Phase {
+	case clonev1alpha1.PhaseUnset, clonev1alpha1.SnapshotInProgress:
+
+		if vmClone.Status.SnapshotName == nil {
+			_, syncInfo = ctrl.createSnapshotFromVm(vmClone, generateTargetSnapshot(vmClone, vmCloneInfo.sourceVm), syncInfo)
+			return syncInfo
+		}
+
+		_, syncInfo = ctrl.verifySnapshotReady(vmClone, *vmClone.Status.SnapshotName, vmClone.Namespace, syncInfo)
+		if syncInfo.isFailingOrError() || !syncInfo.snapshotReady {
+			return syncInfo
+		}
+
+		syncInfo.targetSnapshotReady = true
+
+	default:
+		log.Log.Object(vmClone).Infof("clone %s is in phase %s - nothing to do", vmClone.Name, string(vmClone.Status.Phase))
+	}
+
+	return syncInfo
+}
+
 func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) error {
 	vmClone := origClone.DeepCopy()
 
@@ -288,6 +349,10 @@ func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMach
 		newReadyCondition(corev1.ConditionFalse, "Still processing"),
 	)
 
+	if syncInfo.replicas != nil {
+		vmClone.Status.Replicas = syncInfo.replicas
+	}
+
 	if isInPhase(vmClone, clonev1alpha1.PhaseUnset) {
 		assignPhase(clonev1alpha1.SnapshotInProgress)
 	}
@@ -296,7 +361,10 @@ func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMach
 			vmClone.Status.SnapshotName = pointer.String(snapshotName)
 		}
 
-		if syncInfo.snapshotReady {
+		if syncInfo.targetSnapshotReady {
+			vmClone.Status.TargetName = pointer.String(*vmClone.Status.SnapshotName)
+			assignPhase(clonev1alpha1.Succeeded)
+		} else if syncInfo.snapshotReady {
 			assignPhase(clonev1alpha1.RestoreInProgress)
 		}
 	}
@@ -306,6 +374,19 @@ func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMach
 		}
 
 		if syncInfo.restoreReady {
+			if isCrossNamespaceClone(vmClone) {
+				assignPhase(clonev1alpha1.TransferInProgress)
+			} else {
+				assignPhase(clonev1alpha1.CreatingTargetVM)
+			}
+		}
+	}
+	if isInPhase(vmClone, clonev1alpha1.TransferInProgress) {
+		if targetVMName := syncInfo.targetVMName; targetVMName != "" {
+			vmClone.Status.TargetName = pointer.String(targetVMName)
+		}
+
+		if syncInfo.transferred {
 			assignPhase(clonev1alpha1.CreatingTargetVM)
 		}
 	}
@@ -329,6 +410,9 @@ func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMach
 	if syncInfo.pvcBound {
 		vmClone.Status.SnapshotName = nil
 		vmClone.Status.RestoreName = nil
+		for i := range vmClone.Status.Replicas {
+			vmClone.Status.Replicas[i].RestoreName = nil
+		}
 	}
 
 	if !equality.Semantic.DeepEqual(vmClone.Status, origClone.Status) {
@@ -344,8 +428,7 @@ func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMach
 	return nil
 }
 
-func (ctrl *VMCloneController) createSnapshotFromVm(vmClone *clonev1alpha1.VirtualMachineClone, vm *k6tv1.VirtualMachine, syncInfo syncInfoType) (*snapshotv1.VirtualMachineSnapshot, syncInfoType) {
-	snapshot := generateSnapshot(vmClone, vm)
+func (ctrl *VMCloneController) createSnapshotFromVm(vmClone *clonev1alpha1.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, syncInfo syncInfoType) (*snapshotv1.VirtualMachineSnapshot, syncInfoType) {
 	log.Log.Object(vmClone).Infof("creating snapshot %s for clone %s", snapshot.Name, vmClone.Name)
 
 	createdSnapshot, err := ctrl.client.VirtualMachineSnapshot(snapshot.Namespace).Create(context.Background(), snapshot, v1.CreateOptions{})
@@ -417,7 +500,20 @@ func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clonev1alpha1.Virtua
 		syncInfo.setError(retErr)
 		return syncInfo
 	}
-	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
+	targetInfo := vmClone.Spec.Target
+	if isCrossNamespaceClone(vmClone) {
+		// The VM is restored halted next to the snapshot and moved into the target namespace afterwards
+		targetInfo = targetInfo.DeepCopy()
+		targetInfo.Name = generateIntermediateVMName(vmClone.UID)
+		patches, err = addHaltPatches(patches, vm)
+		if err != nil {
+			retErr := fmt.Errorf("error generating patches for clone %s: %v", vmClone.Name, err)
+			ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(RestoreCreationFailed), retErr.Error())
+			syncInfo.setError(retErr)
+			return syncInfo
+		}
+	}
+	restore := generateRestore(targetInfo, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
 	log.Log.Object(vmClone).Infof("creating restore %s for clone %s", restore.Name, vmClone.Name)
 	createdRestore, err := ctrl.client.VirtualMachineRestore(restore.Namespace).Create(context.Background(), restore, v1.CreateOptions{})
 	if err != nil {
@@ -466,7 +562,7 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clonev1alpha1.Virtual
 func (ctrl *VMCloneController) verifyVmReady(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	targetVMInfo := vmClone.Spec.Target
 
-	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, getTargetNamespace(vmClone)))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
 		return syncInfo
@@ -481,19 +577,19 @@ func (ctrl *VMCloneController) verifyVmReady(vmClone *clonev1alpha1.VirtualMachi
 	return syncInfo
 }
 
-func (ctrl *VMCloneController) verifyPVCBound(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
-	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, vmClone.Namespace))
+func (ctrl *VMCloneController) verifyPVCBound(vmClone *clonev1alpha1.VirtualMachineClone, restoreName string, syncInfo syncInfoType) syncInfoType {
+	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(restoreName, vmClone.Namespace))
 	if !exists {
-		syncInfo.setError(fmt.Errorf("restore %s is not created yet for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
+		syncInfo.setError(fmt.Errorf("restore %s is not created yet for clone %s", restoreName, vmClone.Name))
 		return syncInfo
 	} else if err != nil {
-		syncInfo.setError(fmt.Errorf("error getting restore %s from cache for clone %s: %v", *vmClone.Status.SnapshotName, vmClone.Name, err))
+		syncInfo.setError(fmt.Errorf("error getting restore %s from cache for clone %s: %v", restoreName, vmClone.Name, err))
 		return syncInfo
 	}
 
 	restore := obj.(*snapshotv1.VirtualMachineRestore)
 	for _, volumeRestore := range restore.Status.Restores {
-		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(volumeRestore.PersistentVolumeClaimName, vmClone.Namespace))
+		obj, exists, err = ctrl.pvcStore.GetByKey(getKey(volumeRestore.PersistentVolumeClaimName, getTargetNamespace(vmClone)))
 		if !exists {
 			syncInfo.setError(fmt.Errorf("PVC %s is not created yet for clone %s", volumeRestore.PersistentVolumeClaimName, vmClone.Name))
 			return syncInfo
@@ -516,6 +612,197 @@ func (ctrl *VMCloneController) verifyPVCBound(vmClone *clonev1alpha1.VirtualMach
 
 }
 
+// transferTargetVM moves the VM restored in the clone's namespace into the target namespace. The target VM
+// is created halted out of the restored VM, which is then deleted while orphaning its volumes. The volumes
+// are moved using CDI ObjectTransfers and, once all of them are moved, the target VM gets the run strategy
+// of the source.
+func (ctrl *VMCloneController) transferTargetVM(vmCloneInfo *vmCloneInfo, syncInfo syncInfoType) syncInfoType {
+	vmClone := vmCloneInfo.vmClone
+	targetNamespace := getTargetNamespace(vmClone)
+	targetName := vmClone.Spec.Target.Name
+	intermediateName := generateIntermediateVMName(vmClone.UID)
+	syncInfo.targetVMName = targetName
+
+	intermediateObj, intermediateExists, err := ctrl.vmStore.GetByKey(getKey(intermediateName, vmClone.Namespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", intermediateName, vmClone.Name, err))
+		return syncInfo
+	}
+
+	targetObj, targetExists, err := ctrl.vmStore.GetByKey(getKey(targetName, targetNamespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", targetName, vmClone.Name, err))
+		return syncInfo
+	}
+
+	if !targetExists {
+		if !intermediateExists {
+			syncInfo.setError(fmt.Errorf("restored VM %s is not created yet for clone %s", intermediateName, vmClone.Name))
+			return syncInfo
+		}
+
+		targetVM := generateTransferTargetVM(intermediateObj.(*k6tv1.VirtualMachine), targetName, targetNamespace)
+		_, err = ctrl.client.VirtualMachine(targetNamespace).Create(context.Background(), targetVM, v1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			syncInfo.setError(fmt.Errorf("failed creating VM %s/%s for clone %s: %v", targetNamespace, targetName, vmClone.Name, err))
+			return syncInfo
+		}
+		syncInfo.setError(fmt.Errorf("target VM %s/%s was just created for clone %s, waiting for it to be observed", targetNamespace, targetName, vmClone.Name))
+		return syncInfo
+	}
+
+	if intermediateExists {
+		log.Log.Object(vmClone).Infof("deleting restored VM %s of clone %s, orphaning its volumes", intermediateName, vmClone.Name)
+		orphan := v1.DeletePropagationOrphan
+		err = ctrl.client.VirtualMachine(vmClone.Namespace).Delete(context.Background(), intermediateName, v1.DeleteOptions{PropagationPolicy: &orphan})
+		if err != nil && !errors.IsNotFound(err) {
+			syncInfo.setError(fmt.Errorf("failed deleting restored VM %s for clone %s: %v", intermediateName, vmClone.Name, err))
+			return syncInfo
+		}
+		syncInfo.setError(fmt.Errorf("restored VM %s of clone %s is being deleted", intermediateName, vmClone.Name))
+		return syncInfo
+	}
+
+	restore, syncInfo := ctrl.getRestore(vmClone, syncInfo)
+	if syncInfo.isFailingOrError() {
+		return syncInfo
+	}
+
+	var pendingTransfers []string
+	for _, volumeRestore := range restore.Status.Restores {
+		transfer, err := ctrl.getOrCreateTransfer(vmClone, volumeRestore.PersistentVolumeClaimName)
+		if err != nil {
+			syncInfo.setError(fmt.Errorf("failed transferring volume %s for clone %s: %v", volumeRestore.PersistentVolumeClaimName, vmClone.Name, err))
+			return syncInfo
+		}
+
+		switch transfer.Status.Phase {
+		case cdiv1.ObjectTransferComplete:
+		case cdiv1.ObjectTransferError:
+			syncInfo.isCloneFailing = true
+			syncInfo.failEvent = VolumeTransferFailed
+			syncInfo.failReason = fmt.Sprintf("transfer %s of volume %s to namespace %s failed", transfer.Name, volumeRestore.PersistentVolumeClaimName, targetNamespace)
+			return syncInfo
+		default:
+			pendingTransfers = append(pendingTransfers, transfer.Name)
+		}
+	}
+
+	if len(pendingTransfers) > 0 {
+		syncInfo.setError(fmt.Errorf("volume transfers %v are not complete yet for clone %s", pendingTransfers, vmClone.Name))
+		return syncInfo
+	}
+
+	syncInfo = ctrl.startTransferredVM(vmCloneInfo, targetObj.(*k6tv1.VirtualMachine), syncInfo)
+	if syncInfo.isFailingOrError() {
+		return syncInfo
+	}
+
+	ctrl.logAndRecord(vmClone, VolumesTransferred, fmt.Sprintf("volumes of clone %s were transferred to namespace %s", vmClone.Name, targetNamespace))
+	syncInfo.transferred = true
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) getRestore(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) (*snapshotv1.VirtualMachineRestore, syncInfoType) {
+	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(*vmClone.Status.RestoreName, vmClone.Namespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting restore %s from cache for clone %s: %v", *vmClone.Status.RestoreName, vmClone.Name, err))
+		return nil, syncInfo
+	} else if !exists {
+		syncInfo.setError(fmt.Errorf("restore %s does not exist for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
+		return nil, syncInfo
+	}
+
+	return obj.(*snapshotv1.VirtualMachineRestore), syncInfo
+}
+
+func (ctrl *VMCloneController) getOrCreateTransfer(vmClone *clonev1alpha1.VirtualMachineClone, claimName string) (*cdiv1.ObjectTransfer, error) {
+	transferName := generateTransferName(vmClone.UID, claimName)
+	obj, exists, err := ctrl.objectTransferStore.GetByKey(transferName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting transfer %s from cache: %v", transferName, err)
+	} else if exists {
+		return obj.(*cdiv1.ObjectTransfer), nil
+	}
+
+	// A volume backed by a DataVolume is transferred along with it, so that CDI does not recreate the claim
+	kind := "PersistentVolumeClaim"
+	_, exists, err = ctrl.dataVolumeStore.GetByKey(getKey(claimName, vmClone.Namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error getting DataVolume %s from cache: %v", claimName, err)
+	} else if exists {
+		kind = "DataVolume"
+	}
+
+	transfer, err := ctrl.client.CdiClient().CdiV1beta1().ObjectTransfers().Create(context.Background(), generateObjectTransfer(vmClone, kind, claimName), v1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("transfer %s was just created, waiting for it to be observed", transferName)
+	} else if err != nil {
+		return nil, err
+	}
+	ctrl.logAndRecord(vmClone, VolumeTransferCreated, fmt.Sprintf("created transfer %s of volume %s for clone %s", transfer.Name, claimName, vmClone.Name))
+
+	return transfer, nil
+}
+
+// startTransferredVM sets the run strategy of the source VM on the halted target VM
+func (ctrl *VMCloneController) startTransferredVM(vmCloneInfo *vmCloneInfo, targetVM *k6tv1.VirtualMachine, syncInfo syncInfoType) syncInfoType {
+	vmClone := vmCloneInfo.vmClone
+
+	snapshot, syncInfo := ctrl.getSnapshot(vmCloneInfo.snapshotName, vmClone.Namespace, syncInfo)
+	if syncInfo.isFailingOrError() {
+		return syncInfo
+	}
+
+	sourceVM, err := ctrl.getVmFromSnapshot(snapshot)
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot get VM manifest from snapshot: %v", err))
+		return syncInfo
+	}
+
+	runStrategy, err := sourceVM.RunStrategy()
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot get run strategy of VM %s: %v", sourceVM.Name, err))
+		return syncInfo
+	}
+
+	if targetVM.Spec.RunStrategy != nil && *targetVM.Spec.RunStrategy == runStrategy {
+		return syncInfo
+	}
+
+	patchBytes, err := patch.New(patch.WithAdd("/spec/runStrategy", runStrategy)).GeneratePayload()
+	if err != nil {
+		syncInfo.setError(err)
+		return syncInfo
+	}
+
+	_, err = ctrl.client.VirtualMachine(targetVM.Namespace).Patch(context.Background(), targetVM.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("failed setting run strategy of VM %s/%s for clone %s: %v", targetVM.Namespace, targetVM.Name, vmClone.Name, err))
+	}
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) cleanupTransfers(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	restore, syncInfo := ctrl.getRestore(vmClone, syncInfo)
+	if syncInfo.isFailingOrError() {
+		return syncInfo
+	}
+
+	for _, volumeRestore := range restore.Status.Restores {
+		transferName := generateTransferName(vmClone.UID, volumeRestore.PersistentVolumeClaimName)
+		err := ctrl.client.CdiClient().CdiV1beta1().ObjectTransfers().Delete(context.Background(), transferName, v1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			syncInfo.setError(fmt.Errorf("cannot clean up transfer %s for clone %s", transferName, vmClone.Name))
+			return syncInfo
+		}
+	}
+
+	return syncInfo
+}
+
 func (ctrl *VMCloneController) cleanupSnapshot(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	err := ctrl.client.VirtualMachineSnapshot(vmClone.Namespace).Delete(context.Background(), *vmClone.Status.SnapshotName, v1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
@@ -526,10 +813,10 @@ func (ctrl *VMCloneController) cleanupSnapshot(vmClone *clonev1alpha1.VirtualMac
 	return syncInfo
 }
 
-func (ctrl *VMCloneController) cleanupRestore(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
-	err := ctrl.client.VirtualMachineRestore(vmClone.Namespace).Delete(context.Background(), *vmClone.Status.RestoreName, v1.DeleteOptions{})
+func (ctrl *VMCloneController) cleanupRestore(vmClone *clonev1alpha1.VirtualMachineClone, restoreName string, syncInfo syncInfoType) syncInfoType {
+	err := ctrl.client.VirtualMachineRestore(vmClone.Namespace).Delete(context.Background(), restoreName, v1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
-		syncInfo.setError(fmt.Errorf("cannot clean up restore %s for clone %s", *vmClone.Status.RestoreName, vmClone.Name))
+		syncInfo.setError(fmt.Errorf("cannot clean up restore %s for clone %s", restoreName, vmClone.Name))
 		return syncInfo
 	}
 
@@ -541,6 +828,36 @@ func (ctrl *VMCloneController) logAndRecord(vmClone *clonev1alpha1.VirtualMachin
 	log.Log.Object(vmClone).Infof(msg)
 }
 
+// getTargetNames returns the names of the targets of a succeeded clone
+func getTargetNames(vmClone *clonev1alpha1.VirtualMachineClone) []string {
+	var targetNames []string
+	if vmClone.Status.TargetName != nil {
+		targetNames = append(targetNames, *vmClone.Status.TargetName)
+	}
+	for _, replica := range vmClone.Status.Replicas {
+		if replica.TargetName != nil {
+			targetNames = append(targetNames, *replica.TargetName)
+		}
+	}
+	return targetNames
+}
+
+func (ctrl *VMCloneController) anyTargetExists(vmClone *clonev1alpha1.VirtualMachineClone, targetNames []string) (bool, error) {
+	targetStore := ctrl.vmStore
+	if ctrl.getTargetType(vmClone) == targetTypeSnapshot {
+		targetStore = ctrl.snapshotStore
+	}
+
+	for _, targetName := range targetNames {
+		_, exists, err := targetStore.GetByKey(getKey(targetName, getTargetNamespace(vmClone)))
+		if err != nil || exists {
+			return exists, err
+		}
+	}
+
+	return false, nil
+}
+
 func (ctrl *VMCloneController) getTargetType(vmClone *clonev1alpha1.VirtualMachineClone) cloneTargetType {
 	if vmClone.Spec.Target != nil {
 		return cloneTargetType(vmClone.Spec.Target.Kind)
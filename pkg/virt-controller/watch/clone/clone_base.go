@@ -34,10 +34,13 @@ const (
 	RestoreCreationFailed Event = "RestoreCreationFailed"
 	RestoreReady          Event = "RestoreReady"
 	TargetVMCreated       Event = "TargetVMCreated"
+	VolumeTransferCreated Event = "VolumeTransferCreated"
+	VolumesTransferred    Event = "VolumesTransferred"
 	PVCBound              Event = "PVCBound"
 
-	SnapshotDeleted    Event = "SnapshotDeleted"
-	SourceDoesNotExist Event = "SourceDoesNotExist"
+	SnapshotDeleted      Event = "SnapshotDeleted"
+	SourceDoesNotExist   Event = "SourceDoesNotExist"
+	VolumeTransferFailed Event = "VolumeTransferFailed"
 )
 
 type VMCloneController struct {
@@ -48,6 +51,8 @@ type VMCloneController struct {
 	vmStore              cache.Store
 	snapshotContentStore cache.Store
 	pvcStore             cache.Store
+	dataVolumeStore      cache.Store
+	objectTransferStore  cache.Store
 	recorder             record.EventRecorder
 
 	vmCloneQueue       workqueue.RateLimitingInterface
@@ -56,7 +61,7 @@ type VMCloneController struct {
 	hasSynced          func() bool
 }
 
-func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
+func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer, dataVolumeInformer, objectTransferInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
 	ctrl := VMCloneController{
 		client:               client,
 		vmCloneIndexer:       vmCloneInformer.GetIndexer(),
@@ -65,6 +70,8 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		vmStore:              vmInformer.GetStore(),
 		snapshotContentStore: snapshotContentInformer.GetStore(),
 		pvcStore:             pvcInformer.GetStore(),
+		dataVolumeStore:      dataVolumeInformer.GetStore(),
+		objectTransferStore:  objectTransferInformer.GetStore(),
 		recorder:             recorder,
 		vmCloneQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-vmclone"),
 		vmStatusUpdater:      status.NewVMStatusUpdater(client),
@@ -73,7 +80,8 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 
 	ctrl.hasSynced = func() bool {
 		return vmCloneInformer.HasSynced() && snapshotInformer.HasSynced() && restoreInformer.HasSynced() &&
-			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced()
+			vmInformer.HasSynced() && snapshotInformer.HasSynced() && pvcInformer.HasSynced() &&
+			dataVolumeInformer.HasSynced() && objectTransferInformer.HasSynced()
 	}
 
 	_, err := vmCloneInformer.AddEventHandler(
@@ -346,26 +354,17 @@ func (ctrl *VMCloneController) runWorker() {
 	}
 }
 
-// takes a namespace and returns all vm clone with the specified target vm name
+// takes a namespace and returns all vm clone with the specified target vm name, including
+// clones from other namespaces that target the namespace
 func (ctrl *VMCloneController) listVmCloneMatchingVM(namespace, name string) ([]*clonev1alpha1.VirtualMachineClone, error) {
-	return ctrl.filterVmClone(namespace, func(vmClone *clonev1alpha1.VirtualMachineClone) bool {
-		return vmClone.Spec.Target.Name == name
-	})
-}
-
-func (ctrl *VMCloneController) filterVmClone(namespace string, filter func(*clonev1alpha1.VirtualMachineClone) bool) ([]*clonev1alpha1.VirtualMachineClone, error) {
-	objs, err := ctrl.vmCloneIndexer.ByIndex(cache.NamespaceIndex, namespace)
+	objs, err := ctrl.vmCloneIndexer.ByIndex("vmTarget", getKey(name, namespace))
 	if err != nil {
 		return nil, err
 	}
 
 	var vmClones []*clonev1alpha1.VirtualMachineClone
 	for _, obj := range objs {
-		vmClone := obj.(*clonev1alpha1.VirtualMachineClone)
-
-		if filter(vmClone) {
-			vmClones = append(vmClones, vmClone)
-		}
+		vmClones = append(vmClones, obj.(*clonev1alpha1.VirtualMachineClone))
 	}
 	return vmClones, nil
 }
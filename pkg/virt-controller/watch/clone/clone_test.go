@@ -39,13 +39,16 @@ import (
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	virtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
 	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -65,10 +68,12 @@ var _ = Describe("Clone", func() {
 		recorder   *record.FakeRecorder
 		mockQueue  *testutils.MockWorkQueue
 
-		client    *kubevirtfake.Clientset
-		k8sClient *k8sfake.Clientset
-		sourceVM  *virtv1.VirtualMachine
-		vmClone   *clonev1alpha1.VirtualMachineClone
+		virtClient *kubecli.MockKubevirtClient
+		client     *kubevirtfake.Clientset
+		cdiClient  *cdifake.Clientset
+		k8sClient  *k8sfake.Clientset
+		sourceVM   *virtv1.VirtualMachine
+		vmClone    *clonev1alpha1.VirtualMachineClone
 	)
 
 	addVM := func(vm *virtv1.VirtualMachine) {
@@ -212,13 +217,15 @@ var _ = Describe("Clone", func() {
 		vmInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		snapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		restoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
-		cloneInformer, _ := testutils.NewFakeInformerFor(&clonev1alpha1.VirtualMachineClone{})
+		cloneInformer, _ := testutils.NewFakeInformerWithIndexersFor(&clonev1alpha1.VirtualMachineClone{}, kvcontroller.GetVirtualMachineCloneInformerIndexers())
 		snapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		objectTransferInformer, _ := testutils.NewFakeInformerFor(&cdiv1.ObjectTransfer{})
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
-		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
 		controller, _ = NewVmCloneController(
 			virtClient,
 			cloneInformer,
@@ -227,6 +234,8 @@ var _ = Describe("Clone", func() {
 			vmInformer,
 			snapshotContentInformer,
 			pvcInformer,
+			dataVolumeInformer,
+			objectTransferInformer,
 			recorder)
 		mockQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
 		controller.vmCloneQueue = mockQueue
@@ -240,6 +249,9 @@ var _ = Describe("Clone", func() {
 		virtClient.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
 
+		cdiClient = cdifake.NewSimpleClientset()
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+
 		k8sClient = k8sfake.NewSimpleClientset()
 		k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
 			Expect(action).To(BeNil())
@@ -604,6 +616,528 @@ var _ = Describe("Clone", func() {
 		})
 	})
 
+	Context("with snapshot target", func() {
+		const targetSnapshotName = "test-target-snapshot"
+
+		BeforeEach(func() {
+			vmClone.Spec.Target = &k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.P(snapshotAPIGroup),
+				Kind:     "VirtualMachineSnapshot",
+				Name:     targetSnapshotName,
+			}
+		})
+
+		It("should create a snapshot named after the target that is not owned by the clone", func() {
+			addVM(sourceVM)
+			addClone(vmClone)
+
+			controller.Execute()
+			expectEvent(SnapshotCreated)
+			expectCloneBeInPhase(clonev1alpha1.SnapshotInProgress)
+
+			snapshot, err := client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.TODO(), targetSnapshotName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshot.Spec.Source.Name).To(Equal(sourceVM.Name))
+			Expect(snapshot.OwnerReferences).To(BeEmpty())
+		})
+
+		It("when the snapshot is ready - should succeed and keep the snapshot", func() {
+			snapshot := createVirtualMachineSnapshot(sourceVM)
+			snapshot.Name = targetSnapshotName
+			snapshot.Status.ReadyToUse = pointer.P(true)
+
+			vmClone.Status.SnapshotName = pointer.P(targetSnapshotName)
+			vmClone.Status.Phase = clonev1alpha1.SnapshotInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+
+			controller.Execute()
+			expectEvent(SnapshotReady)
+			expectCloneBeInPhase(clonev1alpha1.Succeeded)
+			expectRestoreDoesNotExist()
+
+			clone, err := client.CloneV1alpha1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(clone.Status.TargetName).To(HaveValue(Equal(targetSnapshotName)))
+
+			_, err = client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.TODO(), targetSnapshotName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("when the target snapshot is deleted - should delete the vm clone resource", func() {
+			vmClone.Status.SnapshotName = pointer.P(targetSnapshotName)
+			vmClone.Status.TargetName = pointer.P(targetSnapshotName)
+			vmClone.Status.Phase = clonev1alpha1.Succeeded
+
+			addVM(sourceVM)
+			addClone(vmClone)
+
+			controller.Execute()
+			expectCloneDeletion()
+		})
+	})
+
+	Context("with target in another namespace", func() {
+		const (
+			targetNamespace    = "target-namespace"
+			intermediateVMName = "tmp-vm-clone-uid"
+			testTransferName   = "tmp-transfer-clone-uid-restore-pvc"
+		)
+
+		var (
+			snapshot *snapshotv1.VirtualMachineSnapshot
+			restore  *snapshotv1.VirtualMachineRestore
+		)
+
+		newIntermediateVM := func() *virtv1.VirtualMachine {
+			vm := sourceVM.DeepCopy()
+			vm.Name = intermediateVMName
+			vm.Spec.DataVolumeTemplates = []virtv1.DataVolumeTemplateSpec{{ObjectMeta: metav1.ObjectMeta{Name: "restore-pvc"}}}
+			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, virtv1.Volume{
+				Name: "disk",
+				VolumeSource: virtv1.VolumeSource{
+					DataVolume: &virtv1.DataVolumeSource{Name: "restore-pvc"},
+				},
+			})
+			return vm
+		}
+
+		newTargetVM := func() *virtv1.VirtualMachine {
+			return generateTransferTargetVM(newIntermediateVM(), vmClone.Spec.Target.Name, targetNamespace)
+		}
+
+		addTransfer := func(phase cdiv1.ObjectTransferPhase) {
+			transfer := generateObjectTransfer(vmClone, "PersistentVolumeClaim", "restore-pvc")
+			transfer.Status.Phase = phase
+			_, err := cdiClient.CdiV1beta1().ObjectTransfers().Create(context.TODO(), transfer, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(controller.objectTransferStore.Add(transfer)).To(Succeed())
+		}
+
+		getTargetVM := func() *virtv1.VirtualMachine {
+			vm, err := client.KubevirtV1().VirtualMachines(targetNamespace).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return vm
+		}
+
+		BeforeEach(func() {
+			vmClone.Spec.TargetNamespace = pointer.P(targetNamespace)
+
+			snapshot = createVirtualMachineSnapshot(sourceVM, createOwnerReference(vmClone))
+			snapshot.Status.ReadyToUse = pointer.P(true)
+			restore = createVirtualMachineRestore(sourceVM, snapshot.Name, createOwnerReference(vmClone))
+			restore.Spec.Target.Name = intermediateVMName
+			restore.Status.Complete = pointer.P(true)
+			restore.Status.Restores = []snapshotv1.VolumeRestore{{PersistentVolumeClaimName: "restore-pvc"}}
+
+			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+			virtClient.EXPECT().VirtualMachine(targetNamespace).Return(client.KubevirtV1().VirtualMachines(targetNamespace)).AnyTimes()
+		})
+
+		It("should restore a halted VM next to the snapshot", func() {
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.Phase = clonev1alpha1.SnapshotInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+
+			controller.Execute()
+			expectEvent(SnapshotReady)
+			expectEvent(RestoreCreated)
+			expectCloneBeInPhase(clonev1alpha1.RestoreInProgress)
+
+			vmRestore, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), testRestoreName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmRestore.Spec.Target.Name).To(Equal(intermediateVMName))
+			Expect(vmRestore.Spec.Patches).To(ContainElement(`{"op":"add","path":"/spec/runStrategy","value":"Halted"}`))
+		})
+
+		It("when the restore is ready - should create the halted target VM", func() {
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.RestoreName = pointer.P(restore.Name)
+			vmClone.Status.Phase = clonev1alpha1.RestoreInProgress
+
+			addVM(sourceVM)
+			addVM(newIntermediateVM())
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addRestore(restore)
+
+			controller.Execute()
+			expectEvent(RestoreReady)
+			expectCloneBeInPhase(clonev1alpha1.TransferInProgress)
+
+			targetVM := getTargetVM()
+			Expect(targetVM.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+			Expect(targetVM.Spec.DataVolumeTemplates).To(BeEmpty())
+			Expect(targetVM.Spec.Template.Spec.Volumes).To(ContainElement(virtv1.Volume{
+				Name: "disk",
+				VolumeSource: virtv1.VolumeSource{
+					PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+						PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "restore-pvc"},
+					},
+				},
+			}))
+		})
+
+		It("when the target VM exists - should delete the restored VM", func() {
+			intermediateVM := newIntermediateVM()
+			_, err := client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.TODO(), intermediateVM, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.RestoreName = pointer.P(restore.Name)
+			vmClone.Status.Phase = clonev1alpha1.TransferInProgress
+
+			addVM(sourceVM)
+			addVM(intermediateVM)
+			addVM(newTargetVM())
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addRestore(restore)
+
+			controller.Execute()
+			expectCloneBeInPhase(clonev1alpha1.TransferInProgress)
+
+			_, err = client.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.TODO(), intermediateVMName, metav1.GetOptions{})
+			Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+		})
+
+		DescribeTable("when the restored VM is deleted - should transfer the restored volumes", func(createDataVolume bool, expectedKind string) {
+			if createDataVolume {
+				dv := &cdiv1.DataVolume{ObjectMeta: metav1.ObjectMeta{Name: "restore-pvc", Namespace: metav1.NamespaceDefault}}
+				Expect(controller.dataVolumeStore.Add(dv)).To(Succeed())
+			}
+
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.RestoreName = pointer.P(restore.Name)
+			vmClone.Status.Phase = clonev1alpha1.TransferInProgress
+
+			addVM(sourceVM)
+			addVM(newTargetVM())
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addRestore(restore)
+
+			controller.Execute()
+			expectEvent(VolumeTransferCreated)
+			expectCloneBeInPhase(clonev1alpha1.TransferInProgress)
+
+			transfer, err := cdiClient.CdiV1beta1().ObjectTransfers().Get(context.TODO(), testTransferName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transfer.Spec.Source.Kind).To(Equal(expectedKind))
+			Expect(transfer.Spec.Source.Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(transfer.Spec.Source.Name).To(Equal("restore-pvc"))
+			Expect(transfer.Spec.Target.Namespace).To(HaveValue(Equal(targetNamespace)))
+		},
+			Entry("of a PersistentVolumeClaim", false, "PersistentVolumeClaim"),
+			Entry("of a DataVolume", true, "DataVolume"),
+		)
+
+		It("when a volume transfer fails - should fail", func() {
+			addTransfer(cdiv1.ObjectTransferError)
+
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.RestoreName = pointer.P(restore.Name)
+			vmClone.Status.Phase = clonev1alpha1.TransferInProgress
+
+			addVM(sourceVM)
+			addVM(newTargetVM())
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addRestore(restore)
+
+			controller.Execute()
+			expectEvent(VolumeTransferFailed)
+			expectCloneBeInPhase(clonev1alpha1.Failed)
+		})
+
+		It("when all the volumes are transferred - should start the target VM and clean up", func() {
+			addTransfer(cdiv1.ObjectTransferComplete)
+
+			runStrategy := virtv1.RunStrategyAlways
+			sourceVM.Spec.RunStrategy = &runStrategy
+			targetVM := newTargetVM()
+			_, err := client.KubevirtV1().VirtualMachines(targetNamespace).Create(context.TODO(), targetVM, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.RestoreName = pointer.P(restore.Name)
+			vmClone.Status.Phase = clonev1alpha1.TransferInProgress
+
+			addVM(sourceVM)
+			addVM(targetVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+			addRestore(restore)
+			addPVC(createPVC(targetNamespace, k8sv1.ClaimBound))
+
+			controller.Execute()
+			expectEvent(VolumesTransferred)
+			expectEvent(TargetVMCreated)
+			expectEvent(PVCBound)
+			expectCloneBeInPhase(clonev1alpha1.Succeeded)
+			expectSnapshotDoesNotExist()
+			expectRestoreDoesNotExist()
+
+			Expect(getTargetVM().Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyAlways)))
+			_, err = cdiClient.CdiV1beta1().ObjectTransfers().Get(context.TODO(), testTransferName, metav1.GetOptions{})
+			Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+		})
+
+		It("when the target VM is deleted - should delete the vm clone resource", func() {
+			vmClone.Status.TargetName = pointer.P(vmClone.Spec.Target.Name)
+			vmClone.Status.Phase = clonev1alpha1.Succeeded
+
+			// a VM with the target name in the clone's namespace is not the target
+			vm := sourceVM.DeepCopy()
+			vm.Name = vmClone.Spec.Target.Name
+			addVM(vm)
+			addClone(vmClone)
+
+			controller.Execute()
+			expectCloneDeletion()
+		})
+
+		It("should only enqueue the clone when the VM in the target namespace is deleted", func() {
+			Expect(controller.vmCloneIndexer.Add(vmClone)).To(Succeed())
+
+			vm := sourceVM.DeepCopy()
+			vm.Name = vmClone.Spec.Target.Name
+			controller.handleDeleteVM(vm)
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(BeZero())
+
+			vm.Namespace = targetNamespace
+			controller.handleDeleteVM(vm)
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		})
+
+		DescribeTable("should enqueue the succeeded clone when a restored PVC is bound", func(pvcNamespace string) {
+			vmClone.Status.Phase = clonev1alpha1.Succeeded
+			vmClone.Status.RestoreName = pointer.P(testRestoreName)
+			Expect(controller.vmCloneIndexer.Add(vmClone)).To(Succeed())
+
+			controller.handlePVC(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "restore-pvc",
+					Namespace:   pvcNamespace,
+					Annotations: map[string]string{virtsnapshot.RestoreNameAnnotation: testRestoreName},
+				},
+				Status: k8sv1.PersistentVolumeClaimStatus{Phase: k8sv1.ClaimBound},
+			})
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		},
+			Entry("in the clone namespace", metav1.NamespaceDefault),
+			Entry("in the target namespace", targetNamespace),
+		)
+	})
+
+	Context("with replicas", func() {
+		const replicas = 3
+
+		var snapshot *snapshotv1.VirtualMachineSnapshot
+
+		replicaRestoreName := func(index int) string {
+			return fmt.Sprintf("%s-%d", testRestoreName, index)
+		}
+
+		replicaTargetName := func(index int) string {
+			return fmt.Sprintf("%s-%d", vmClone.Spec.Target.Name, index)
+		}
+
+		newReplicaRestore := func(index int) *snapshotv1.VirtualMachineRestore {
+			restore := createVirtualMachineRestore(sourceVM, snapshot.Name, createOwnerReference(vmClone))
+			restore.Name = replicaRestoreName(index)
+			restore.Spec.Target.Name = replicaTargetName(index)
+			restore.Status.Complete = pointer.P(true)
+			restore.Status.Restores = []snapshotv1.VolumeRestore{{PersistentVolumeClaimName: "restore-pvc"}}
+			return restore
+		}
+
+		newReplicaVM := func(index int) *virtv1.VirtualMachine {
+			vm := sourceVM.DeepCopy()
+			vm.Name = replicaTargetName(index)
+			return vm
+		}
+
+		replicaStatuses := func(phase clonev1alpha1.VirtualMachineClonePhase, withRestores bool) []clonev1alpha1.VirtualMachineCloneReplicaStatus {
+			statuses := make([]clonev1alpha1.VirtualMachineCloneReplicaStatus, replicas)
+			for i := range statuses {
+				statuses[i] = clonev1alpha1.VirtualMachineCloneReplicaStatus{
+					Index:      int32(i),
+					Phase:      phase,
+					TargetName: pointer.P(replicaTargetName(i)),
+				}
+				if withRestores {
+					statuses[i].RestoreName = pointer.P(replicaRestoreName(i))
+				}
+			}
+			return statuses
+		}
+
+		getClone := func() *clonev1alpha1.VirtualMachineClone {
+			clone, err := client.CloneV1alpha1().VirtualMachineClones(metav1.NamespaceDefault).Get(context.TODO(), vmClone.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return clone
+		}
+
+		BeforeEach(func() {
+			vmClone.Spec.Replicas = pointer.P(int32(replicas))
+
+			snapshot = createVirtualMachineSnapshot(sourceVM, createOwnerReference(vmClone))
+			snapshot.Status.ReadyToUse = pointer.P(true)
+		})
+
+		It("when the snapshot is ready - should create a restore for every replica", func() {
+			sourceVM.Spec.Template.Spec.Domain.Firmware = &virtv1.Firmware{Serial: "original-serial"}
+			vmClone.Spec.NewMacAddresses = map[string]string{
+				sourceVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].Name: "02:00:00:00:00:0a",
+			}
+			vmClone.Spec.NewSMBiosSerial = pointer.P("serial")
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.Phase = clonev1alpha1.SnapshotInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+
+			controller.Execute()
+			expectEvent(SnapshotReady)
+			for i := 0; i < replicas; i++ {
+				expectEvent(RestoreCreated)
+			}
+			expectCloneBeInPhase(clonev1alpha1.RestoreInProgress)
+			Expect(getClone().Status.Replicas).To(Equal(replicaStatuses(clonev1alpha1.RestoreInProgress, true)))
+
+			expectedMacAddresses := []string{"02:00:00:00:00:0a", "02:00:00:00:00:0b", "02:00:00:00:00:0c"}
+			for i := 0; i < replicas; i++ {
+				vmRestore, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), replicaRestoreName(i), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(snapshot.Name))
+				Expect(vmRestore.Spec.Target.Name).To(Equal(replicaTargetName(i)))
+				Expect(vmRestore.Spec.Patches).To(ContainElement(ContainSubstring(expectedMacAddresses[i])))
+				Expect(vmRestore.Spec.Patches).To(ContainElement(ContainSubstring(fmt.Sprintf(`"serial-%d"`, i))))
+				validateOwnerReference(vmRestore.OwnerReferences[0], vmClone)
+			}
+		})
+
+		It("should name the replicas after the target name pattern", func() {
+			vmClone.Spec.TargetNamePattern = "lab-{{ .Index }}-vm"
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.Phase = clonev1alpha1.SnapshotInProgress
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+
+			controller.Execute()
+			expectCloneBeInPhase(clonev1alpha1.RestoreInProgress)
+
+			for i := 0; i < replicas; i++ {
+				vmRestore, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), replicaRestoreName(i), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmRestore.Spec.Target.Name).To(Equal(fmt.Sprintf("lab-%d-vm", i)))
+			}
+		})
+
+		It("when only some of the target VMs are created - should report them per replica", func() {
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.Replicas = replicaStatuses(clonev1alpha1.RestoreInProgress, true)
+			vmClone.Status.Phase = clonev1alpha1.RestoreInProgress
+
+			addVM(sourceVM)
+			addVM(newReplicaVM(0))
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+			for i := 0; i < replicas; i++ {
+				addRestore(newReplicaRestore(i))
+			}
+
+			controller.Execute()
+			expectCloneBeInPhase(clonev1alpha1.CreatingTargetVM)
+
+			statuses := getClone().Status.Replicas
+			Expect(statuses).To(HaveLen(replicas))
+			Expect(statuses[0].Phase).To(Equal(clonev1alpha1.Succeeded))
+			Expect(statuses[1].Phase).To(Equal(clonev1alpha1.CreatingTargetVM))
+			Expect(statuses[2].Phase).To(Equal(clonev1alpha1.CreatingTargetVM))
+		})
+
+		It("when all the target VMs are created - should move to Succeeded phase", func() {
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.Replicas = replicaStatuses(clonev1alpha1.CreatingTargetVM, true)
+			vmClone.Status.Phase = clonev1alpha1.CreatingTargetVM
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addSnapshotContent(createVirtualMachineSnapshotContent(sourceVM))
+			for i := 0; i < replicas; i++ {
+				addVM(newReplicaVM(i))
+				addRestore(newReplicaRestore(i))
+			}
+
+			controller.Execute()
+			for i := 0; i < replicas; i++ {
+				expectEvent(TargetVMCreated)
+			}
+			expectCloneBeInPhase(clonev1alpha1.Succeeded)
+			Expect(getClone().Status.Replicas).To(Equal(replicaStatuses(clonev1alpha1.Succeeded, true)))
+		})
+
+		It("when all the PVCs are bound - should delete the snapshot and the restores", func() {
+			vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+			vmClone.Status.Replicas = replicaStatuses(clonev1alpha1.Succeeded, true)
+			vmClone.Status.Phase = clonev1alpha1.Succeeded
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			addSnapshot(snapshot)
+			addPVC(createPVC(metav1.NamespaceDefault, k8sv1.ClaimBound))
+			for i := 0; i < replicas; i++ {
+				addVM(newReplicaVM(i))
+				addRestore(newReplicaRestore(i))
+			}
+
+			controller.Execute()
+			expectSnapshotDoesNotExist()
+			for i := 0; i < replicas; i++ {
+				_, err := client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.TODO(), replicaRestoreName(i), metav1.GetOptions{})
+				Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+			}
+			Expect(getClone().Status.Replicas).To(Equal(replicaStatuses(clonev1alpha1.Succeeded, false)))
+		})
+
+		DescribeTable("when target VMs are deleted", func(remainingTargets int, expectDeletion bool) {
+			vmClone.Status.Replicas = replicaStatuses(clonev1alpha1.Succeeded, false)
+			vmClone.Status.Phase = clonev1alpha1.Succeeded
+
+			addVM(sourceVM)
+			addClone(vmClone)
+			for i := 0; i < remainingTargets; i++ {
+				addVM(newReplicaVM(i))
+			}
+
+			controller.Execute()
+			if expectDeletion {
+				expectCloneDeletion()
+			} else {
+				expectCloneBeInPhase(clonev1alpha1.Succeeded)
+			}
+		},
+			Entry("and some still exist - should keep the vm clone resource", 1, false),
+			Entry("and none exists - should delete the vm clone resource", 0, true),
+		)
+	})
+
 	Context("generation of target VM", func() {
 		BeforeEach(func() {
 			snapshot := createVirtualMachineSnapshot(sourceVM)
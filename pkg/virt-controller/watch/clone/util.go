@@ -17,6 +17,7 @@ import (
 
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 const (
@@ -64,6 +65,14 @@ func generateRestoreName(vmCloneUID types.UID) string {
 	return fmt.Sprintf("tmp-restore-%s", string(vmCloneUID))
 }
 
+func generateIntermediateVMName(vmCloneUID types.UID) string {
+	return fmt.Sprintf("tmp-vm-%s", string(vmCloneUID))
+}
+
+func generateTransferName(vmCloneUID types.UID, claimName string) string {
+	return fmt.Sprintf("tmp-transfer-%s-%s", string(vmCloneUID), claimName)
+}
+
 func generateVolumeName(volumeName string) string {
 	return generateNameWithRandomSuffix("clone", "volume", volumeName)
 }
@@ -72,6 +81,17 @@ func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
 
+func getTargetNamespace(vmClone *clonev1alpha1.VirtualMachineClone) string {
+	if vmClone.Spec.TargetNamespace != nil && *vmClone.Spec.TargetNamespace != "" {
+		return *vmClone.Spec.TargetNamespace
+	}
+	return vmClone.Namespace
+}
+
+func isCrossNamespaceClone(vmClone *clonev1alpha1.VirtualMachineClone) bool {
+	return getTargetNamespace(vmClone) != vmClone.Namespace
+}
+
 func isInPhase(vmClone *clonev1alpha1.VirtualMachineClone, phase clonev1alpha1.VirtualMachineClonePhase) bool {
 	return vmClone.Status.Phase == phase
 }
@@ -95,6 +115,16 @@ func generateSnapshot(vmClone *clonev1alpha1.VirtualMachineClone, sourceVM *v1.V
 	}
 }
 
+// generateTargetSnapshot generates the snapshot of a clone whose target is a VirtualMachineSnapshot.
+// Unlike the temporary snapshot used to clone a VirtualMachine, it is not owned by the clone.
+func generateTargetSnapshot(vmClone *clonev1alpha1.VirtualMachineClone, sourceVM *v1.VirtualMachine) *snapshotv1.VirtualMachineSnapshot {
+	snapshot := generateSnapshot(vmClone, sourceVM)
+	snapshot.Name = vmClone.Spec.Target.Name
+	snapshot.OwnerReferences = nil
+
+	return snapshot
+}
+
 func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName, namespace, cloneName, snapshotName string, cloneUID types.UID, patches []string) *snapshotv1.VirtualMachineRestore {
 	targetInfo = targetInfo.DeepCopy()
 	if targetInfo.Name == "" {
@@ -117,6 +147,62 @@ func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName,
 	}
 }
 
+// generateTransferTargetVM generates the VirtualMachine that is created in the target namespace of a
+// cross namespace clone out of the VirtualMachine restored in the clone's namespace. The target is
+// created halted and refers to its volumes as plain PersistentVolumeClaims, as these are moved into
+// the target namespace only after it is created.
+func generateTransferTargetVM(intermediateVM *v1.VirtualMachine, name, namespace string) *v1.VirtualMachine {
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      intermediateVM.Labels,
+			Annotations: intermediateVM.Annotations,
+		},
+		Spec: *intermediateVM.Spec.DeepCopy(),
+	}
+
+	runStrategy := v1.RunStrategyHalted
+	vm.Spec.Running = nil
+	vm.Spec.RunStrategy = &runStrategy
+	vm.Spec.DataVolumeTemplates = nil
+
+	if vm.Spec.Template != nil {
+		for i, volume := range vm.Spec.Template.Spec.Volumes {
+			if volume.DataVolume == nil {
+				continue
+			}
+			vm.Spec.Template.Spec.Volumes[i].VolumeSource = v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: volume.DataVolume.Name,
+					},
+				},
+			}
+		}
+	}
+
+	return vm
+}
+
+func generateObjectTransfer(vmClone *clonev1alpha1.VirtualMachineClone, kind, claimName string) *cdiv1.ObjectTransfer {
+	return &cdiv1.ObjectTransfer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: generateTransferName(vmClone.UID, claimName),
+		},
+		Spec: cdiv1.ObjectTransferSpec{
+			Source: cdiv1.TransferSource{
+				Kind:      kind,
+				Namespace: vmClone.Namespace,
+				Name:      claimName,
+			},
+			Target: cdiv1.TransferTarget{
+				Namespace: pointer.String(getTargetNamespace(vmClone)),
+			},
+		},
+	}
+}
+
 func getCloneOwnerReference(cloneName string, cloneUID types.UID) metav1.OwnerReference {
 	return metav1.OwnerReference{
 		APIVersion:         clonev1alpha1.VirtualMachineCloneKind.GroupVersion().String(),
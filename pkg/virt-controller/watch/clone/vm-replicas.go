@@ -0,0 +1,323 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	k6tv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
+	"kubevirt.io/kubevirt/pkg/util/placeholder"
+)
+
+func getReplicas(vmClone *clonev1alpha1.VirtualMachineClone) int32 {
+	if vmClone.Spec.Replicas == nil {
+		return 1
+	}
+	return *vmClone.Spec.Replicas
+}
+
+func isBulkClone(vmClone *clonev1alpha1.VirtualMachineClone) bool {
+	return getReplicas(vmClone) > 1
+}
+
+func generateReplicaName(vmClone *clonev1alpha1.VirtualMachineClone, index int32) string {
+	pattern := vmClone.Spec.TargetNamePattern
+	if pattern == "" && vmClone.Spec.Target != nil {
+		pattern = vmClone.Spec.Target.Name + "-" + placeholder.Index
+	}
+	return placeholder.ReplaceIndex(pattern, int(index))
+}
+
+func generateReplicaRestoreName(vmCloneUID types.UID, index int32) string {
+	return fmt.Sprintf("%s-%d", generateRestoreName(vmCloneUID), index)
+}
+
+// replicaCloneSpec returns the clone spec of a single replica, so that every replica gets its own
+// MAC addresses and SMBios serial
+func replicaCloneSpec(cloneSpec *clonev1alpha1.VirtualMachineCloneSpec, index int32) (*clonev1alpha1.VirtualMachineCloneSpec, error) {
+	replicaSpec := cloneSpec.DeepCopy()
+
+	for ifaceName, macAddress := range replicaSpec.NewMacAddresses {
+		replicaMacAddress, err := offsetMacAddress(macAddress, index)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mac address %s of interface %s: %v", macAddress, ifaceName, err)
+		}
+		replicaSpec.NewMacAddresses[ifaceName] = replicaMacAddress
+	}
+
+	if serial := replicaSpec.NewSMBiosSerial; serial != nil && *serial != "" {
+		replicaSpec.NewSMBiosSerial = pointer.String(fmt.Sprintf("%s-%d", *serial, index))
+	}
+
+	return replicaSpec, nil
+}
+
+func offsetMacAddress(macAddress string, offset int32) (string, error) {
+	hwAddr, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return "", err
+	}
+	if len(hwAddr) != 6 {
+		return "", fmt.Errorf("only 48-bit mac addresses are supported")
+	}
+
+	var value uint64
+	for _, b := range hwAddr {
+		value = value<<8 | uint64(b)
+	}
+	value += uint64(offset)
+	for i := len(hwAddr) - 1; i >= 0; i-- {
+		hwAddr[i] = byte(value)
+		value >>= 8
+	}
+
+	return hwAddr.String(), nil
+}
+
+func getReplicaStatus(vmClone *clonev1alpha1.VirtualMachineClone, index int32) clonev1alpha1.VirtualMachineCloneReplicaStatus {
+	for _, replica := range vmClone.Status.Replicas {
+		if replica.Index == index {
+			return *replica.DeepCopy()
+		}
+	}
+
+	return clonev1alpha1.VirtualMachineCloneReplicaStatus{
+		Index:      index,
+		Phase:      clonev1alpha1.RestoreInProgress,
+		TargetName: pointer.String(generateReplicaName(vmClone, index)),
+	}
+}
+
+func hasReplicaRestores(vmClone *clonev1alpha1.VirtualMachineClone) bool {
+	for _, replica := range vmClone.Status.Replicas {
+		if replica.RestoreName != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// syncTargetVMReplicas clones the source into several VMs. A single snapshot is used for all the
+// replicas, each of them being restored from it.
+func (ctrl *VMCloneController) syncTargetVMReplicas(vmCloneInfo *vmCloneInfo) syncInfoType {
+	vmClone := vmCloneInfo.vmClone
+	syncInfo := syncInfoType{}
+
+	switch vmClone.Status.Phase {
+	case clonev1alpha1.PhaseUnset, clonev1alpha1.SnapshotInProgress:
+
+		if vmCloneInfo.sourceType == sourceTypeVM {
+			if vmClone.Status.SnapshotName == nil {
+				_, syncInfo = ctrl.createSnapshotFromVm(vmClone, generateSnapshot(vmClone, vmCloneInfo.sourceVm), syncInfo)
+				return syncInfo
+			}
+		}
+
+		vmCloneInfo.snapshot, syncInfo = ctrl.verifySnapshotReady(vmClone, vmCloneInfo.snapshotName, vmClone.Namespace, syncInfo)
+		if syncInfo.isFailingOrError() || !syncInfo.snapshotReady {
+			return syncInfo
+		}
+
+		fallthrough
+
+	case clonev1alpha1.RestoreInProgress, clonev1alpha1.CreatingTargetVM:
+
+		if vmCloneInfo.snapshot == nil {
+			vmCloneInfo.snapshot, syncInfo = ctrl.getSnapshot(vmCloneInfo.snapshotName, vmClone.Namespace, syncInfo)
+			if syncInfo.isFailingOrError() {
+				return syncInfo
+			}
+		}
+
+		vm, err := ctrl.getVmFromSnapshot(vmCloneInfo.snapshot)
+		if err != nil {
+			syncInfo.setError(fmt.Errorf("cannot get VM manifest from snapshot: %v", err))
+			return syncInfo
+		}
+
+		syncInfo = ctrl.syncReplicas(vmClone, vm, vmCloneInfo.snapshotName, syncInfo)
+
+	case clonev1alpha1.Succeeded:
+
+		if hasReplicaRestores(vmClone) {
+			for _, replica := range vmClone.Status.Replicas {
+				if replica.RestoreName == nil {
+					continue
+				}
+
+				syncInfo.pvcBound = false
+				syncInfo = ctrl.verifyPVCBound(vmClone, *replica.RestoreName, syncInfo)
+				if syncInfo.isFailingOrError() || !syncInfo.pvcBound {
+					return syncInfo
+				}
+			}
+
+			for _, replica := range vmClone.Status.Replicas {
+				if replica.RestoreName == nil {
+					continue
+				}
+
+				syncInfo = ctrl.cleanupRestore(vmClone, *replica.RestoreName, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+
+			if vmCloneInfo.sourceType == sourceTypeVM {
+				syncInfo = ctrl.cleanupSnapshot(vmClone, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+		}
+
+	default:
+		log.Log.Object(vmClone).Infof("clone %s is in phase %s - nothing to do", vmClone.Name, string(vmClone.Status.Phase))
+	}
+
+	return syncInfo
+}
+
+// syncReplicas creates the restore of every replica and follows it until the replica's VM is created
+func (ctrl *VMCloneController) syncReplicas(vmClone *clonev1alpha1.VirtualMachineClone, vm *k6tv1.VirtualMachine, snapshotName string, syncInfo syncInfoType) syncInfoType {
+	replicas := make([]clonev1alpha1.VirtualMachineCloneReplicaStatus, getReplicas(vmClone))
+	restoresReady, targetsCreated := true, true
+
+	for i := range replicas {
+		replica := getReplicaStatus(vmClone, int32(i))
+
+		switch replica.Phase {
+		case clonev1alpha1.RestoreInProgress:
+			if replica.RestoreName == nil {
+				restoreName, err := ctrl.createReplicaRestore(vmClone, vm, snapshotName, replica)
+				if err != nil {
+					syncInfo.setError(err)
+					return syncInfo
+				}
+				replica.RestoreName = pointer.String(restoreName)
+				restoresReady, targetsCreated = false, false
+				break
+			}
+
+			ready, err := ctrl.isReplicaRestoreReady(vmClone, *replica.RestoreName)
+			if err != nil {
+				syncInfo.setError(err)
+				return syncInfo
+			}
+			if !ready {
+				restoresReady, targetsCreated = false, false
+				break
+			}
+			replica.Phase = clonev1alpha1.CreatingTargetVM
+
+			fallthrough
+
+		case clonev1alpha1.CreatingTargetVM:
+			_, exists, err := ctrl.vmStore.GetByKey(getKey(*replica.TargetName, vmClone.Namespace))
+			if err != nil {
+				syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", *replica.TargetName, vmClone.Name, err))
+				return syncInfo
+			}
+			if !exists {
+				targetsCreated = false
+				break
+			}
+
+			ctrl.logAndRecord(vmClone, TargetVMCreated, fmt.Sprintf("created target VM %s for clone %s", *replica.TargetName, vmClone.Name))
+			replica.Phase = clonev1alpha1.Succeeded
+		}
+
+		replicas[i] = replica
+	}
+
+	syncInfo.replicas = replicas
+	syncInfo.restoreReady = restoresReady
+	syncInfo.targetVMCreated = targetsCreated
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) createReplicaRestore(vmClone *clonev1alpha1.VirtualMachineClone, vm *k6tv1.VirtualMachine, snapshotName string, replica clonev1alpha1.VirtualMachineCloneReplicaStatus) (string, error) {
+	recordFailure := func(err error) error {
+		retErr := fmt.Errorf("failed creating restore of replica %d for clone %s: %v", replica.Index, vmClone.Name, err)
+		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(RestoreCreationFailed), retErr.Error())
+		return retErr
+	}
+
+	replicaSpec, err := replicaCloneSpec(&vmClone.Spec, replica.Index)
+	if err != nil {
+		return "", recordFailure(err)
+	}
+
+	patches, err := generatePatches(vm, replicaSpec)
+	if err != nil {
+		return "", recordFailure(err)
+	}
+
+	targetInfo := &corev1.TypedLocalObjectReference{
+		APIGroup: pointer.String(kubevirtApiGroup),
+		Kind:     vmKind,
+		Name:     *replica.TargetName,
+	}
+	restore := generateRestore(targetInfo, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
+	restore.Name = generateReplicaRestoreName(vmClone.UID, replica.Index)
+
+	_, err = ctrl.client.VirtualMachineRestore(restore.Namespace).Create(context.Background(), restore, v1.CreateOptions{})
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return "", recordFailure(err)
+		}
+		return restore.Name, nil
+	}
+
+	ctrl.logAndRecord(vmClone, RestoreCreated, fmt.Sprintf("created restore %s for clone %s", restore.Name, vmClone.Name))
+	return restore.Name, nil
+}
+
+func (ctrl *VMCloneController) isReplicaRestoreReady(vmClone *clonev1alpha1.VirtualMachineClone, restoreName string) (bool, error) {
+	obj, exists, err := ctrl.restoreStore.GetByKey(getKey(restoreName, vmClone.Namespace))
+	if err != nil {
+		return false, fmt.Errorf("error getting restore %s from cache for clone %s: %v", restoreName, vmClone.Name, err)
+	} else if !exists {
+		return false, fmt.Errorf("restore %s is not created yet for clone %s", restoreName, vmClone.Name)
+	}
+
+	restore := obj.(*snapshotv1.VirtualMachineRestore)
+	if virtsnapshot.VmRestoreProgressing(restore) {
+		log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("restore %s for clone %s is not ready to use yet", restore.Name, vmClone.Name)
+		return false, nil
+	}
+
+	ctrl.logAndRecord(vmClone, RestoreReady, fmt.Sprintf("restore %s for clone %s is ready to use", restore.Name, vmClone.Name))
+	return true, nil
+}
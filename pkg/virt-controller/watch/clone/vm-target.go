@@ -52,6 +52,21 @@ func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clonev1alpha1.Virt
 	return patches, nil
 }
 
+// addHaltPatches appends patches that keep the restored VM from being started
+func addHaltPatches(patches []string, source *k6tv1.VirtualMachine) ([]string, error) {
+	patchSet := patch.New(patch.WithAdd("/spec/runStrategy", k6tv1.RunStrategyHalted))
+	if source.Spec.Running != nil {
+		patchSet.AddOption(patch.WithRemove("/spec/running"))
+	}
+
+	haltPatches, err := generateStringPatchOperations(patchSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(patches, haltPatches...), nil
+}
+
 func generateStringPatchOperations(set *patch.PatchSet) ([]string, error) {
 	var patches []string
 	for _, patchOp := range set.GetPatches() {
@@ -120,6 +120,7 @@ var _ = Describe("Evacuation", func() {
 			migration := evacuation.GenerateNewMigration("my-vmi", "somenode")
 			Expect(migration.Spec.VMIName).To(Equal("my-vmi"))
 			Expect(migration.Annotations[v1.EvacuationMigrationAnnotation]).To(Equal("somenode"))
+			Expect(migration.Spec.Priority).To(HaveValue(Equal(v1.MigrationPrioritySystemCritical)))
 		})
 
 	})
@@ -21,6 +21,8 @@ package watch
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"sort"
@@ -70,12 +72,17 @@ const (
 	successfulUpdatePodDisruptionBudgetReason = "SuccessfulUpdate"
 	failedUpdatePodDisruptionBudgetReason     = "FailedUpdate"
 	failedGetAttractionPodsFmt                = "failed to get attachment pods: %v"
+	migrationPreemptedReason                  = "MigrationPreempted"
+	migrationRequeuedReason                   = "MigrationRequeued"
 )
 
 // This is the timeout used when a target pod is stuck in
 // a pending unschedulable state.
 const defaultUnschedulablePendingTimeoutSeconds = int64(60 * 5)
 
+// receiveTokenLength is the number of random bytes of the token issued for migrations receiving a decentralized migration
+const receiveTokenLength = 32
+
 // This is how many finalized migration objects left in
 // the system before we begin garbage collecting the oldest
 // migration objects
@@ -102,6 +109,7 @@ type MigrationController struct {
 	pvcStore             cache.Store
 	pdbIndexer           cache.Indexer
 	migrationPolicyStore cache.Store
+	namespaceStore       cache.Store
 	resourceQuotaIndexer cache.Indexer
 	recorder             record.EventRecorder
 	podExpectations      *controller.UIDTrackingControllerExpectations
@@ -128,6 +136,7 @@ func NewMigrationController(templateService services.TemplateService,
 	pdbInformer cache.SharedIndexInformer,
 	migrationPolicyInformer cache.SharedIndexInformer,
 	resourceQuotaInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -144,6 +153,7 @@ func NewMigrationController(templateService services.TemplateService,
 		pdbIndexer:           pdbInformer.GetIndexer(),
 		resourceQuotaIndexer: resourceQuotaInformer.GetIndexer(),
 		migrationPolicyStore: migrationPolicyInformer.GetStore(),
+		namespaceStore:       namespaceInformer.GetStore(),
 		recorder:             recorder,
 		clientset:            clientset,
 		podExpectations:      controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -157,7 +167,7 @@ func NewMigrationController(templateService services.TemplateService,
 	}
 
 	c.hasSynced = func() bool {
-		return vmiInformer.HasSynced() && podInformer.HasSynced() && migrationInformer.HasSynced() && pdbInformer.HasSynced() && resourceQuotaInformer.HasSynced()
+		return vmiInformer.HasSynced() && podInformer.HasSynced() && migrationInformer.HasSynced() && pdbInformer.HasSynced() && resourceQuotaInformer.HasSynced() && namespaceInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -250,6 +260,21 @@ func ensureSelectorLabelPresent(migration *virtv1.VirtualMachineInstanceMigratio
 	} else if _, exist := migration.Labels[virtv1.MigrationSelectorLabel]; !exist {
 		migration.Labels[virtv1.MigrationSelectorLabel] = migration.Spec.VMIName
 	}
+	if migrationID := decentralizedMigrationID(migration); migrationID != "" {
+		migration.Labels[virtv1.MigrationIDLabel] = migrationID
+	}
+}
+
+// decentralizedMigrationID returns the ID shared by the migrations in the source and the target cluster of a
+// decentralized migration, or an empty string for migrations within the cluster
+func decentralizedMigrationID(migration *virtv1.VirtualMachineInstanceMigration) string {
+	switch {
+	case migration.IsDecentralizedSource():
+		return migration.Spec.SendTo.MigrationID
+	case migration.IsDecentralizedTarget():
+		return migration.Spec.Receive.MigrationID
+	}
+	return ""
 }
 
 func (c *MigrationController) patchVMI(origVMI, newVMI *virtv1.VirtualMachineInstance) error {
@@ -437,7 +462,7 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 		migrationCopy.Status.Phase = virtv1.MigrationFailed
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because vmi does not exist.")
 		log.Log.Object(migration).Error("vmi does not exist")
-	} else if vmi.IsFinal() {
+	} else if vmi.IsFinal() && !isMigrationSentToTargetCluster(migration, vmi) {
 		migrationCopy.Status.Phase = virtv1.MigrationFailed
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed vmi shutdown during migration.")
 		log.Log.Object(migration).Error("Unable to migrate vmi because vmi is shutdown.")
@@ -452,11 +477,20 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
 		}
 		migrationCopy.Status.Phase = virtv1.MigrationFailed
+	} else if conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationPreempted) {
+		if c.isMigrationHandedOff(migration, vmi) {
+			// the migration was handed off before its target pod could be removed
+			conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationPreempted)
+		} else if !podExists {
+			migrationCopy.Status.Phase = virtv1.MigrationPending
+			conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationPreempted)
+			c.recorder.Event(migration, k8sv1.EventTypeNormal, migrationRequeuedReason, "Migration is pending again after being preempted")
+		}
 	} else if podExists && controller.PodIsDown(pod) {
 		migrationCopy.Status.Phase = virtv1.MigrationFailed
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration failed because target pod shutdown during migration")
 		log.Log.Object(migration).Errorf("target pod %s/%s shutdown during migration", pod.Namespace, pod.Name)
-	} else if migration.TargetIsCreated() && !podExists {
+	} else if migration.TargetIsCreated() && !podExists && !migration.IsDecentralizedSource() {
 		migrationCopy.Status.Phase = virtv1.MigrationFailed
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedMigrationReason, "Migration target pod was removed during active migration.")
 		log.Log.Object(migration).Error("target pod disappeared during migration")
@@ -505,7 +539,11 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 	}
 
 	controller.SetVMIMigrationPhaseTransitionTimestamp(migration, migrationCopy)
-	controller.SetSourcePod(migrationCopy, vmi, c.podIndexer)
+	if !migration.IsDecentralizedTarget() {
+		controller.SetSourcePod(migrationCopy, vmi, c.podIndexer)
+	} else if err := issueReceiveToken(migrationCopy, vmi); err != nil {
+		return err
+	}
 
 	if !equality.Semantic.DeepEqual(migration.Status, migrationCopy.Status) {
 		err := c.statusUpdater.UpdateStatus(migrationCopy)
@@ -547,7 +585,11 @@ func (c *MigrationController) processMigrationPhase(
 			log.Log.Object(migration).Error("Migration object ont eligible for migration because another job is in progress")
 		}
 	case virtv1.MigrationPending:
-		if pod != nil {
+		if migration.IsDecentralizedSource() {
+			if c.isMigrationHandedOff(migration, vmi) {
+				migrationCopy.Status.Phase = virtv1.MigrationTargetReady
+			}
+		} else if pod != nil {
 			if controller.VMIHasHotplugVolumes(vmi) {
 				if attachmentPod != nil {
 					migrationCopy.Status.Phase = virtv1.MigrationScheduling
@@ -563,10 +605,18 @@ func (c *MigrationController) processMigrationPhase(
 			}
 			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
 		}
+		// the maintenance windows of the source cluster apply to decentralized migrations
+		if pod == nil && !migration.IsDecentralizedTarget() {
+			if err := c.updateMaintenanceWindowStatus(migrationCopy, vmi); err != nil {
+				return err
+			}
+		}
 	case virtv1.MigrationScheduling:
 		if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
 			conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota)
 		}
+		conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationWaitingForMaintenanceWindow)
+		migrationCopy.Status.ExpectedStartTimestamp = nil
 		if controller.IsPodReady(pod) {
 			if controller.VMIHasHotplugVolumes(vmi) {
 				if attachmentPod != nil && controller.IsPodReady(attachmentPod) {
@@ -592,17 +642,20 @@ func (c *MigrationController) processMigrationPhase(
 			migrationCopy.Status.Phase = virtv1.MigrationRunning
 		}
 	case virtv1.MigrationRunning:
-		_, exists := pod.Annotations[virtv1.MigrationTargetReadyTimestamp]
-		if !exists && vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp != nil {
-			patchBytes, err := patch.New(
-				patch.WithAdd(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.MigrationTargetReadyTimestamp)), vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp.String()),
-			).GeneratePayload()
-			if err != nil {
-				return err
-			}
+		// decentralized migrations to another cluster have no target pod
+		if pod != nil {
+			_, exists := pod.Annotations[virtv1.MigrationTargetReadyTimestamp]
+			if !exists && vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp != nil {
+				patchBytes, err := patch.New(
+					patch.WithAdd(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(virtv1.MigrationTargetReadyTimestamp)), vmi.Status.MigrationState.TargetNodeDomainReadyTimestamp.String()),
+				).GeneratePayload()
+				if err != nil {
+					return err
+				}
 
-			if _, err = c.clientset.CoreV1().Pods(pod.Namespace).Patch(context.Background(), pod.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{}); err != nil {
-				return err
+				if _, err = c.clientset.CoreV1().Pods(pod.Namespace).Patch(context.Background(), pod.Name, types.JSONPatchType, patchBytes, v1.PatchOptions{}); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -867,6 +920,26 @@ func (c *MigrationController) handlePreHandoffMigrationCancel(migration *virtv1.
 	return nil
 }
 
+// handlePreemptedMigration removes the target pod of a preempted migration, the migration is then pending
+// again and waits for a free slot.
+func (c *MigrationController) handlePreemptedMigration(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
+	if pod == nil || pod.DeletionTimestamp != nil {
+		return nil
+	}
+
+	c.podExpectations.ExpectDeletions(controller.MigrationKey(migration), []string{controller.PodKey(pod)})
+	err := c.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, v1.DeleteOptions{})
+	if err != nil {
+		c.podExpectations.DeletionObserved(controller.MigrationKey(migration), controller.PodKey(pod))
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedDeletePodReason, "Error deleting preempted migration target pod: %v", err)
+		return fmt.Errorf("cannot delete target pod %s/%s of preempted migration: %v", pod.Namespace, pod.Name, err)
+	}
+
+	log.Log.Object(vmi).Infof("Deleted target pod %s/%s of preempted migration %s", pod.Namespace, pod.Name, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulDeletePodReason, "migration preempted and pod %s/%s is deleted", pod.Namespace, pod.Name)
+	return nil
+}
+
 func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
 
 	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID {
@@ -881,7 +954,11 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 		SourceNode:   vmi.Status.NodeName,
 		TargetPod:    pod.Name,
 	}
-	if migration.Status.MigrationState != nil {
+	if migration.IsDecentralizedTarget() {
+		// the source runs in another cluster
+		vmiCopy.Status.MigrationState.SourceNode = ""
+		vmiCopy.Status.MigrationState.MigrationID = migration.Spec.Receive.MigrationID
+	} else if migration.Status.MigrationState != nil {
 		vmiCopy.Status.MigrationState.SourcePod = migration.Status.MigrationState.SourcePod
 	}
 
@@ -940,6 +1017,94 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 	return nil
 }
 
+// issueReceiveToken issues the token a migration receiving a decentralized migration has to be sent with. The token
+// is bound to the receiving VMI and is issued again if the VMI was recreated.
+func issueReceiveToken(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if migration.IsFinal() || vmi == nil {
+		return nil
+	}
+	if receiveToken := migration.Status.ReceiveToken; receiveToken != nil && receiveToken.VMIUID == vmi.UID {
+		return nil
+	}
+	token := make([]byte, receiveTokenLength)
+	if _, err := rand.Read(token); err != nil {
+		return fmt.Errorf("failed to generate the receive token: %v", err)
+	}
+	migration.Status.ReceiveToken = &virtv1.MigrationReceiveToken{
+		Token:  hex.EncodeToString(token),
+		VMIUID: vmi.UID,
+	}
+	return nil
+}
+
+// handleDecentralizedSourceHandoff hands off a migration to a target in another cluster to the source virt-handler.
+// No target pod is created, the source virt-handler connects to the migration endpoint of the target cluster.
+func (c *MigrationController) handleDecentralizedSourceHandoff(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if c.isMigrationHandedOff(migration, vmi) {
+		return nil
+	}
+
+	if expectedStart, waiting, err := c.getMaintenanceWindowStart(migration, vmi); err != nil {
+		return err
+	} else if waiting {
+		log.Log.Object(migration).Infof("Waiting for the next maintenance window to migrate vmi [%s/%s] to the target cluster.", vmi.Namespace, vmi.Name)
+		c.Queue.AddAfter(key, maintenanceWindowRequeueDelay(expectedStart))
+		return nil
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+		MigrationUID:                 migration.UID,
+		MigrationID:                  migration.Spec.SendTo.MigrationID,
+		TargetMigrationEndpoint:      migration.Spec.SendTo.ConnectURL,
+		TargetMigrationEndpointToken: migration.Spec.SendTo.Token,
+		SourceNode:                   vmi.Status.NodeName,
+	}
+	if migration.Status.MigrationState != nil {
+		vmiCopy.Status.MigrationState.SourcePod = migration.Status.MigrationState.SourcePod
+	}
+
+	clusterMigrationConfigs := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	if err := c.matchMigrationPolicy(vmiCopy, clusterMigrationConfigs); err != nil {
+		return fmt.Errorf("failed to match migration policy: %v", err)
+	}
+
+	if !c.isMigrationPolicyMatched(vmiCopy) {
+		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
+	}
+
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, controller.FailedHandOverPodReason, fmt.Sprintf("Failed to set MigrationStat in VMI status. :%v", err))
+		return err
+	}
+
+	c.addHandOffKey(controller.MigrationKey(migration))
+	log.Log.Object(vmi).Infof("Handed off migration %s/%s to source virt-handler.", migration.Namespace, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, controller.SuccessfulHandOverPodReason, "Migration to the target cluster is ready to be started by virt-handler.")
+	return nil
+}
+
+// syncDecentralizedTarget hands off a migration received from another cluster once the pod of the receiving VMI is
+// ready. The pod belongs to the VMI and is never deleted by the migration controller.
+func (c *MigrationController) syncDecentralizedTarget(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
+	if migration.Status.Phase != virtv1.MigrationScheduled || migration.DeletionTimestamp != nil {
+		return nil
+	}
+	if pod != nil && controller.IsPodReady(pod) {
+		return c.handleTargetPodHandoff(migration, vmi, pod)
+	}
+	return nil
+}
+
+// isMigrationSentToTargetCluster returns true if the VMI was shut down because it was migrated to another cluster
+func isMigrationSentToTargetCluster(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) bool {
+	return migration.IsDecentralizedSource() &&
+		vmi.Status.MigrationState != nil &&
+		vmi.Status.MigrationState.MigrationUID == migration.UID &&
+		vmi.Status.MigrationState.Completed &&
+		!vmi.Status.MigrationState.Failed
+}
+
 func (c *MigrationController) markMigrationAbortInVmiStatus(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
 
 	if vmi.Status.MigrationState == nil {
@@ -1011,13 +1176,27 @@ func (c *MigrationController) handleTargetPodCreation(key string, migration *vir
 		return fmt.Errorf("failed to determin the number of running migrations: %v", err)
 	}
 
+	higherPriorityMigrations, err := c.findPendingMigrationsWithHigherPriority(migration, runningMigrations)
+	if err != nil {
+		return fmt.Errorf("failed to determine the pending migrations with a higher priority: %v", err)
+	}
+
 	// XXX: Make this configurable, think about limit per node, bandwidth per migration, and so on.
-	if len(runningMigrations) >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster) {
+	clusterLimit := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
+	if len(runningMigrations) >= clusterLimit {
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel migration count [%d] is currently at the global cluster limit.", vmi.Namespace, vmi.Name, len(runningMigrations))
+		if err := c.preemptMigration(migration, runningMigrations); err != nil {
+			return err
+		}
 		// Let's wait until some migrations are done
 		c.Queue.AddAfter(key, time.Second*5)
 		return nil
 	}
+	if len(higherPriorityMigrations) >= clusterLimit-len(runningMigrations) {
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because [%d] migrations with a higher priority are pending.", vmi.Namespace, vmi.Name, len(higherPriorityMigrations))
+		c.Queue.AddAfter(key, time.Second*1)
+		return nil
+	}
 
 	outboundMigrations, err := c.outboundMigrationsOnNode(vmi.Status.NodeName, runningMigrations)
 
@@ -1025,10 +1204,37 @@ func (c *MigrationController) handleTargetPodCreation(key string, migration *vir
 		return err
 	}
 
-	if outboundMigrations >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode) {
+	nodeLimit := int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode)
+	if outboundMigrations >= nodeLimit {
 		// Let's ensure that we only have two outbound migrations per node
 		// XXX: Make this configurable, thinkg about inbound migration limit, bandwidh per migration, and so on.
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel outbound migrations on target node [%d] has hit outbound migrations per node limit.", vmi.Namespace, vmi.Name, outboundMigrations)
+		nodeMigrations, err := c.filterMigrationsOnNode(vmi.Status.NodeName, runningMigrations)
+		if err != nil {
+			return err
+		}
+		if err := c.preemptMigration(migration, nodeMigrations); err != nil {
+			return err
+		}
+		c.Queue.AddAfter(key, time.Second*5)
+		return nil
+	}
+
+	higherPriorityMigrationsOnNode, err := c.filterMigrationsOnNode(vmi.Status.NodeName, higherPriorityMigrations)
+	if err != nil {
+		return err
+	}
+	if len(higherPriorityMigrationsOnNode) >= nodeLimit-outboundMigrations {
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because [%d] migrations with a higher priority are pending on the same node.", vmi.Namespace, vmi.Name, len(higherPriorityMigrationsOnNode))
+		c.Queue.AddAfter(key, time.Second*1)
+		return nil
+	}
+
+	policyLimitReached, err := c.policyMigrationsLimitReached(vmi, runningMigrations)
+	if err != nil {
+		return fmt.Errorf("failed to check the migration policy limits: %v", err)
+	}
+	if policyLimitReached {
 		c.Queue.AddAfter(key, time.Second*5)
 		return nil
 	}
@@ -1259,6 +1465,15 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 		return fmt.Errorf("vmi is inelgible for migration because another migration job is running")
 	}
 
+	if migration.IsDecentralizedTarget() {
+		return c.syncDecentralizedTarget(migration, vmi, pod)
+	}
+
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	if conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationPreempted) && !c.isMigrationHandedOff(migration, vmi) {
+		return c.handlePreemptedMigration(migration, vmi, pod)
+	}
+
 	switch migration.Status.Phase {
 	case virtv1.MigrationPending:
 		if migration.DeletionTimestamp != nil {
@@ -1270,6 +1485,10 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 			return nil
 		}
 
+		if migration.IsDecentralizedSource() {
+			return c.handleDecentralizedSourceHandoff(key, migration, vmi)
+		}
+
 		if !targetPodExists {
 			sourcePod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
 			if err != nil {
@@ -1290,6 +1509,14 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 				}
 			}
 
+			if expectedStart, waiting, err := c.getMaintenanceWindowStart(migration, vmi); err != nil {
+				return err
+			} else if waiting {
+				log.Log.Object(migration).Infof("Waiting for the next maintenance window to schedule target pod for vmi [%s/%s] migration.", vmi.Namespace, vmi.Name)
+				c.Queue.AddAfter(key, maintenanceWindowRequeueDelay(expectedStart))
+				return nil
+			}
+
 			// patch VMI annotations and set RuntimeUser in preparation for target pod creation
 			patches := c.setupVMIRuntimeUser(vmi)
 			if !patches.IsEmpty() {
@@ -1338,7 +1565,8 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 			return c.handleTargetPodHandoff(migration, vmi, pod)
 		}
 	case virtv1.MigrationPreparingTarget, virtv1.MigrationTargetReady, virtv1.MigrationFailed:
-		if (!targetPodExists || controller.PodIsDown(pod)) &&
+		if !migration.IsDecentralizedSource() &&
+			(!targetPodExists || controller.PodIsDown(pod)) &&
 			vmi.Status.MigrationState != nil &&
 			len(vmi.Status.MigrationState.TargetDirectMigrationNodePorts) == 0 &&
 			vmi.Status.MigrationState.StartTimestamp == nil &&
@@ -1399,6 +1627,16 @@ func (c *MigrationController) setupVMIRuntimeUser(vmi *virtv1.VirtualMachineInst
 
 func (c *MigrationController) listMatchingTargetPods(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) ([]*k8sv1.Pod, error) {
 
+	// The VMI receiving a decentralized migration is started in its own pod,
+	// which acts as the target pod of the migration
+	if migration.IsDecentralizedTarget() {
+		pod, err := controller.CurrentVMIPod(vmi, c.podIndexer)
+		if err != nil || pod == nil {
+			return nil, err
+		}
+		return []*k8sv1.Pod{pod}, nil
+	}
+
 	selector, err := v1.LabelSelectorAsSelector(&v1.LabelSelector{
 		MatchLabels: map[string]string{
 			virtv1.CreatedByLabel:    string(vmi.UID),
@@ -1830,6 +2068,122 @@ func (c *MigrationController) outboundMigrationsOnNode(node string, runningMigra
 	return sum, nil
 }
 
+// filterMigrationsOnNode returns the migrations of VMIs running on the given node
+func (c *MigrationController) filterMigrationsOnNode(node string, migrations []*virtv1.VirtualMachineInstanceMigration) ([]*virtv1.VirtualMachineInstanceMigration, error) {
+	var migrationsOnNode []*virtv1.VirtualMachineInstanceMigration
+	for _, migration := range migrations {
+		obj, exists, err := c.vmiStore.GetByKey(migration.Namespace + "/" + migration.Spec.VMIName)
+		if err != nil {
+			return nil, err
+		}
+		if exists && obj.(*virtv1.VirtualMachineInstance).Status.NodeName == node {
+			migrationsOnNode = append(migrationsOnNode, migration)
+		}
+	}
+	return migrationsOnNode, nil
+}
+
+// findPendingMigrationsWithHigherPriority returns the migrations which wait for their target pod to be created
+// and which have a higher priority than the given migration. Migrations which could not use a free slot anyway
+// are not taken into account, so that they do not block migrations with a lower priority: the ones waiting for
+// a maintenance window, rejected by a resource quota or waiting for the outbound migrations limit of their node.
+func (c *MigrationController) findPendingMigrationsWithHigherPriority(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration) ([]*virtv1.VirtualMachineInstanceMigration, error) {
+	running := map[types.UID]bool{}
+	for _, runningMigration := range runningMigrations {
+		running[runningMigration.UID] = true
+	}
+
+	rank := migrations.PriorityRank(migration)
+	nodeLimit := int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode)
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	var pendingMigrations []*virtv1.VirtualMachineInstanceMigration
+	for _, pendingMigration := range migrations.ListUnfinishedMigrations(c.migrationIndexer) {
+		if running[pendingMigration.UID] || pendingMigration.IsRunning() || pendingMigration.DeletionTimestamp != nil {
+			continue
+		}
+		if migrations.PriorityRank(pendingMigration) <= rank {
+			continue
+		}
+		if conditionManager.HasCondition(pendingMigration, virtv1.VirtualMachineInstanceMigrationWaitingForMaintenanceWindow) ||
+			conditionManager.HasCondition(pendingMigration, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
+			continue
+		}
+		obj, exists, err := c.vmiStore.GetByKey(pendingMigration.Namespace + "/" + pendingMigration.Spec.VMIName)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		outboundMigrations, err := c.outboundMigrationsOnNode(obj.(*virtv1.VirtualMachineInstance).Status.NodeName, runningMigrations)
+		if err != nil {
+			return nil, err
+		}
+		if outboundMigrations >= nodeLimit {
+			continue
+		}
+		pendingMigrations = append(pendingMigrations, pendingMigration)
+	}
+	return pendingMigrations, nil
+}
+
+// preemptMigration makes room for the given migration by preempting one of the given running migrations that has
+// a lower priority and that has not been handed off to virt-handler yet. The preempted migration gets the Preempted
+// condition, its target pod is removed and it is pending again afterwards. Migrations with the lowest priority are
+// preempted first, and among them the most recent one. Nothing is preempted while a migration is being deleted or
+// preempted anyway, since it frees its slot soon.
+func (c *MigrationController) preemptMigration(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration) error {
+	rank := migrations.PriorityRank(migration)
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	var candidate *virtv1.VirtualMachineInstanceMigration
+	for _, runningMigration := range runningMigrations {
+		if runningMigration.DeletionTimestamp != nil || conditionManager.HasCondition(runningMigration, virtv1.VirtualMachineInstanceMigrationPreempted) {
+			return nil
+		}
+		if migrations.PriorityRank(runningMigration) >= rank {
+			continue
+		}
+		if runningMigration.Status.Phase != virtv1.MigrationPending && runningMigration.Status.Phase != virtv1.MigrationScheduling {
+			continue
+		}
+		obj, exists, err := c.vmiStore.GetByKey(runningMigration.Namespace + "/" + runningMigration.Spec.VMIName)
+		if err != nil {
+			return err
+		}
+		if !exists || c.isMigrationHandedOff(runningMigration, obj.(*virtv1.VirtualMachineInstance)) {
+			continue
+		}
+		if candidate == nil || isPreferredPreemptionCandidate(runningMigration, candidate) {
+			candidate = runningMigration
+		}
+	}
+	if candidate == nil {
+		return nil
+	}
+
+	log.Log.Object(candidate).Infof("Preempting migration in favor of migration %s/%s with a higher priority", migration.Namespace, migration.Name)
+	message := fmt.Sprintf("Migration was preempted by migration %s/%s with a higher priority", migration.Namespace, migration.Name)
+	candidateCopy := candidate.DeepCopy()
+	candidateCopy.Status.Conditions = append(candidateCopy.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+		Type:          virtv1.VirtualMachineInstanceMigrationPreempted,
+		Status:        k8sv1.ConditionTrue,
+		LastProbeTime: v1.Now(),
+		Message:       message,
+	})
+	if err := c.statusUpdater.UpdateStatus(candidateCopy); err != nil {
+		return fmt.Errorf("failed to preempt migration %s/%s: %v", candidate.Namespace, candidate.Name, err)
+	}
+	c.recorder.Event(candidate, k8sv1.EventTypeNormal, migrationPreemptedReason, message)
+	return nil
+}
+
+func isPreferredPreemptionCandidate(migration, other *virtv1.VirtualMachineInstanceMigration) bool {
+	if rank, otherRank := migrations.PriorityRank(migration), migrations.PriorityRank(other); rank != otherRank {
+		return rank < otherRank
+	}
+	return other.CreationTimestamp.Before(&migration.CreationTimestamp)
+}
+
 // findRunningMigrations calcules how many migrations are running or in flight to be triggered to running
 // Migrations which are in running phase are added alongside with migrations which are still pending but
 // where we already see a target pod.
@@ -1961,11 +2315,37 @@ func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels ma
 }
 
 func (c *MigrationController) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, clusterMigrationConfiguration *virtv1.MigrationConfiguration) error {
-	vmiNamespace, err := c.clientset.CoreV1().Namespaces().Get(context.Background(), vmi.Namespace, v1.GetOptions{})
+	// Override cluster-wide migration configuration if migration policy is matched
+	matchedPolicy, err := c.getMatchingMigrationPolicy(vmi)
+	if err != nil {
+		return err
+	}
+
+	if matchedPolicy == nil {
+		log.Log.Object(vmi).Infof("no migration policy matched for VMI %s", vmi.Name)
+		return nil
+	}
+
+	isUpdated, err := matchedPolicy.GetMigrationConfByPolicy(clusterMigrationConfiguration)
 	if err != nil {
 		return err
 	}
 
+	if isUpdated {
+		vmi.Status.MigrationState.MigrationPolicyName = &matchedPolicy.Name
+		vmi.Status.MigrationState.MigrationConfiguration = clusterMigrationConfiguration
+		log.Log.Object(vmi).Infof("migration is updated by migration policy named %s.", matchedPolicy.Name)
+	}
+
+	return nil
+}
+
+func (c *MigrationController) getMatchingMigrationPolicy(vmi *virtv1.VirtualMachineInstance) (*v1alpha1.MigrationPolicy, error) {
+	vmiNamespace, err := c.getNamespace(vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
 	// Fetch cluster policies
 	var policies []v1alpha1.MigrationPolicy
 	migrationInterfaceList := c.migrationPolicyStore.List()
@@ -1975,28 +2355,165 @@ func (c *MigrationController) matchMigrationPolicy(vmi *virtv1.VirtualMachineIns
 	}
 	policiesListObj := v1alpha1.MigrationPolicyList{Items: policies}
 
-	// Override cluster-wide migration configuration if migration policy is matched
-	matchedPolicy := MatchPolicy(&policiesListObj, vmi, vmiNamespace)
+	return MatchPolicy(&policiesListObj, vmi, vmiNamespace), nil
+}
 
-	if matchedPolicy == nil {
-		log.Log.Object(vmi).Reason(err).Infof("no migration policy matched for VMI %s", vmi.Name)
-		return nil
+func (c *MigrationController) getNamespace(name string) (*k8sv1.Namespace, error) {
+	obj, exists, err := c.namespaceStore.GetByKey(name)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return &k8sv1.Namespace{ObjectMeta: v1.ObjectMeta{Name: name}}, nil
 	}
+	return obj.(*k8sv1.Namespace), nil
+}
 
-	isUpdated, err := matchedPolicy.GetMigrationConfByPolicy(clusterMigrationConfiguration)
+// policyMigrationsLimitReached checks the parallel migration limits of the migration policy matching the vmi, if
+// any. Only the running migrations of VMIs matched by the same policy are counted.
+func (c *MigrationController) policyMigrationsLimitReached(vmi *virtv1.VirtualMachineInstance, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (bool, error) {
+	policy, err := c.getMatchingMigrationPolicy(vmi)
+	if err != nil {
+		return false, err
+	}
+	if policy == nil || (policy.Spec.ParallelMigrationsPerCluster == nil && policy.Spec.ParallelOutboundMigrationsPerNode == nil) {
+		return false, nil
+	}
+
+	var policyMigrations, policyOutboundMigrations int
+	for _, migration := range runningMigrations {
+		obj, exists, err := c.vmiStore.GetByKey(migration.Namespace + "/" + migration.Spec.VMIName)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			continue
+		}
+
+		migratingVMI := obj.(*virtv1.VirtualMachineInstance)
+		migrationPolicy, err := c.getMatchingMigrationPolicy(migratingVMI)
+		if err != nil {
+			return false, err
+		}
+		if migrationPolicy == nil || migrationPolicy.Name != policy.Name {
+			continue
+		}
+
+		policyMigrations++
+		if migratingVMI.Status.NodeName == vmi.Status.NodeName {
+			policyOutboundMigrations++
+		}
+	}
+
+	if limit := policy.Spec.ParallelMigrationsPerCluster; limit != nil && policyMigrations >= int(*limit) {
+		log.Log.Object(vmi).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because running parallel migration count [%d] is currently at the limit of migration policy %s.", vmi.Namespace, vmi.Name, policyMigrations, policy.Name)
+		return true, nil
+	}
+	if limit := policy.Spec.ParallelOutboundMigrationsPerNode; limit != nil && policyOutboundMigrations >= int(*limit) {
+		log.Log.Object(vmi).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because running parallel outbound migrations on the node [%d] has hit the outbound migrations per node limit of migration policy %s.", vmi.Namespace, vmi.Name, policyOutboundMigrations, policy.Name)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// isMigrationUrgent returns true if the migration has to start without waiting for a maintenance window. Besides
+// the migrations marked as urgent, this is the case for migrations from nodes being drained.
+func (c *MigrationController) isMigrationUrgent(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) bool {
+	if migration.Spec.Urgent {
+		return true
+	}
+
+	obj, exists, err := c.nodeStore.GetByKey(vmi.Status.NodeName)
+	if err != nil || !exists {
+		return false
+	}
+	node := obj.(*k8sv1.Node)
+	if node.Spec.Unschedulable {
+		return true
+	}
+
+	drainTaint := &k8sv1.Taint{
+		Key:    *c.clusterConfig.GetMigrationConfiguration().NodeDrainTaintKey,
+		Effect: k8sv1.TaintEffectNoSchedule,
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.MatchTaint(drainTaint) {
+			return true
+		}
+	}
+	return false
+}
+
+// getMaintenanceWindowStart returns true if the migration has to wait for a maintenance window to start, along with
+// the time at which the next window opens. The maintenance windows of the matching migration policy replace the
+// cluster-wide ones.
+func (c *MigrationController) getMaintenanceWindowStart(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) (time.Time, bool, error) {
+	if c.isMigrationUrgent(migration, vmi) {
+		return time.Time{}, false, nil
+	}
+
+	windows := c.clusterConfig.GetMigrationConfiguration().MaintenanceWindows
+	policy, err := c.getMatchingMigrationPolicy(vmi)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if policy != nil && policy.Spec.MaintenanceWindows != nil {
+		windows = policy.Spec.MaintenanceWindows
+	}
+	if len(windows) == 0 {
+		return time.Time{}, false, nil
+	}
+
+	open, nextStart, err := migrations.CheckMaintenanceWindows(windows, time.Now())
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return nextStart, !open, nil
+}
+
+// updateMaintenanceWindowStatus reports on the migration whether it is waiting for a maintenance window to start
+func (c *MigrationController) updateMaintenanceWindowStatus(migrationCopy *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+
+	expectedStart, waiting, err := c.getMaintenanceWindowStart(migrationCopy, vmi)
 	if err != nil {
 		return err
 	}
 
-	if isUpdated {
-		vmi.Status.MigrationState.MigrationPolicyName = &matchedPolicy.Name
-		vmi.Status.MigrationState.MigrationConfiguration = clusterMigrationConfiguration
-		log.Log.Object(vmi).Infof("migration is updated by migration policy named %s.", matchedPolicy.Name)
+	if !waiting {
+		conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationWaitingForMaintenanceWindow)
+		migrationCopy.Status.ExpectedStartTimestamp = nil
+		return nil
 	}
 
+	if !conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationWaitingForMaintenanceWindow) {
+		migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+			Type:          virtv1.VirtualMachineInstanceMigrationWaitingForMaintenanceWindow,
+			Status:        k8sv1.ConditionTrue,
+			LastProbeTime: v1.Now(),
+			Message:       "The migration is not urgent and waits for the next maintenance window to start",
+		})
+	}
+
+	migrationCopy.Status.ExpectedStartTimestamp = nil
+	if !expectedStart.IsZero() {
+		migrationCopy.Status.ExpectedStartTimestamp = &v1.Time{Time: expectedStart}
+	}
 	return nil
 }
 
+// maintenanceWindowRequeueDelay returns how long a migration waiting for a maintenance window should be requeued
+// for. Windows that never open are checked again hourly, in case the configuration changes.
+func maintenanceWindowRequeueDelay(expectedStart time.Time) time.Duration {
+	if expectedStart.IsZero() {
+		return time.Hour
+	}
+	if delay := time.Until(expectedStart); delay > time.Second {
+		return delay
+	}
+	return time.Second
+}
+
 func (c *MigrationController) isMigrationPolicyMatched(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
 		return false
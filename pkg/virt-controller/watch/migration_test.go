@@ -256,6 +256,7 @@ var _ = Describe("Migration watcher", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			namespaceInformer,
 			recorder,
 			virtClient,
 			config,
@@ -271,6 +272,7 @@ var _ = Describe("Migration watcher", func() {
 
 		// Set up mock client
 		kubeClient = fake.NewSimpleClientset(&namespace)
+		Expect(namespaceInformer.GetStore().Add(&namespace)).To(Succeed())
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(virtClientset.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
@@ -785,6 +787,362 @@ var _ = Describe("Migration watcher", func() {
 			expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
 		})
 
+		DescribeTable("should respect the parallel migration limits of the matching migration policy", func(setLimit func(*migrationsv1.MigrationPolicySpec), otherNodeName string, otherVMIMatched bool, expectTargetPod bool) {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			policy := generatePolicyAndAlignVMI(vmi)
+			setLimit(&policy.Spec)
+
+			otherVMI := newVirtualMachine("testvmi0", virtv1.Running)
+			otherVMI.Status.NodeName = otherNodeName
+			otherVMI.Labels["app"] = "other"
+			if otherVMIMatched {
+				for key, value := range policy.Spec.Selectors.VirtualMachineInstanceSelector {
+					otherVMI.Labels[key] = value
+				}
+			}
+
+			addMigrationPolicies(*policy)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+			addMigration(newMigration("testmigration0", otherVMI.Name, virtv1.MigrationScheduling))
+			addVirtualMachineInstance(otherVMI)
+
+			controller.Execute()
+
+			if expectTargetPod {
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			} else {
+				expectPodDoesNotExist(vmi.Namespace, "testvmi", "testmigration")
+			}
+		},
+			Entry("when the cluster limit is reached",
+				func(spec *migrationsv1.MigrationPolicySpec) { spec.ParallelMigrationsPerCluster = pointer.P(uint32(1)) },
+				"node01", true, false,
+			),
+			Entry("when the cluster limit is reached by VMIs not matched by the policy",
+				func(spec *migrationsv1.MigrationPolicySpec) { spec.ParallelMigrationsPerCluster = pointer.P(uint32(1)) },
+				"node01", false, true,
+			),
+			Entry("when the node limit is reached",
+				func(spec *migrationsv1.MigrationPolicySpec) {
+					spec.ParallelOutboundMigrationsPerNode = pointer.P(uint32(1))
+				},
+				"tefwegwrerg", true, false,
+			),
+			Entry("when the node limit is reached on another node",
+				func(spec *migrationsv1.MigrationPolicySpec) {
+					spec.ParallelOutboundMigrationsPerNode = pointer.P(uint32(1))
+				},
+				"node01", true, true,
+			),
+		)
+
+		Context("with maintenance windows", func() {
+			// maintenanceWindows returns maintenance windows that are open right now, or that open in a few hours
+			maintenanceWindows := func(open bool) []virtv1.MaintenanceWindow {
+				windowStart := time.Now().UTC().Add(3 * time.Hour)
+				if open {
+					windowStart = time.Now().UTC().Add(-time.Hour)
+				}
+				return []virtv1.MaintenanceWindow{{
+					Schedule: fmt.Sprintf("%d %d * * *", windowStart.Minute(), windowStart.Hour()),
+					Duration: metav1.Duration{Duration: 2 * time.Hour},
+				}}
+			}
+
+			expectWaitingForMaintenanceWindow := func(namespace, name string) {
+				updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(namespace).Get(context.Background(), name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVMIM.Status.Conditions).To(ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(virtv1.VirtualMachineInstanceMigrationWaitingForMaintenanceWindow),
+						"Status": Equal(k8sv1.ConditionTrue),
+					}),
+				))
+				Expect(updatedVMIM.Status.ExpectedStartTimestamp).ToNot(BeNil())
+				Expect(updatedVMIM.Status.ExpectedStartTimestamp.Time).To(BeTemporally("~", time.Now().Add(3*time.Hour), time.Minute))
+			}
+
+			var vmi *virtv1.VirtualMachineInstance
+			var migration *virtv1.VirtualMachineInstanceMigration
+
+			BeforeEach(func() {
+				vmi = newVirtualMachine("testvmi", virtv1.Running)
+				migration = newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			})
+
+			run := func() {
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+
+				controller.Execute()
+			}
+
+			It("should queue migrations until a maintenance window opens", func() {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{MaintenanceWindows: maintenanceWindows(false)},
+				})
+
+				run()
+
+				expectPodDoesNotExist(vmi.Namespace, vmi.Name, migration.Name)
+				expectMigrationPendingState(migration.Namespace, migration.Name)
+				expectWaitingForMaintenanceWindow(migration.Namespace, migration.Name)
+			})
+
+			It("should start migrations in a maintenance window", func() {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{MaintenanceWindows: maintenanceWindows(true)},
+				})
+
+				run()
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			})
+
+			It("should start urgent migrations right away", func() {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{MaintenanceWindows: maintenanceWindows(false)},
+				})
+				migration.Spec.Urgent = true
+
+				run()
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			})
+
+			DescribeTable("should start migrations from drained nodes right away", func(drainNode func(*k8sv1.Node)) {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{MaintenanceWindows: maintenanceWindows(false)},
+				})
+				node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: vmi.Status.NodeName}}
+				drainNode(node)
+				addNode(node)
+				setEvacuationAnnotation(migration)
+
+				run()
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			},
+				Entry("when cordoned", func(node *k8sv1.Node) {
+					node.Spec.Unschedulable = true
+				}),
+				Entry("when tainted with the drain taint", func(node *k8sv1.Node) {
+					node.Spec.Taints = []k8sv1.Taint{{Key: "kubevirt.io/drain", Effect: k8sv1.TaintEffectNoSchedule}}
+				}),
+			)
+
+			It("should queue evictions from nodes that are not drained", func() {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{MaintenanceWindows: maintenanceWindows(false)},
+				})
+				addNode(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: vmi.Status.NodeName}})
+				setEvacuationAnnotation(migration)
+
+				run()
+
+				expectPodDoesNotExist(vmi.Namespace, vmi.Name, migration.Name)
+				expectWaitingForMaintenanceWindow(migration.Namespace, migration.Name)
+			})
+
+			DescribeTable("should let the maintenance windows of the migration policy replace the cluster-wide ones", func(clusterWindowOpen, policyWindowOpen, expectTargetPod bool) {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{MaintenanceWindows: maintenanceWindows(clusterWindowOpen)},
+				})
+				policy := generatePolicyAndAlignVMI(vmi)
+				policy.Spec.MaintenanceWindows = maintenanceWindows(policyWindowOpen)
+				addMigrationPolicies(*policy)
+
+				run()
+
+				if expectTargetPod {
+					testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+					expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+				} else {
+					expectPodDoesNotExist(vmi.Namespace, vmi.Name, migration.Name)
+					expectWaitingForMaintenanceWindow(migration.Namespace, migration.Name)
+				}
+			},
+				Entry("when the policy window is open", false, true, true),
+				Entry("when the policy window is closed", true, false, false),
+			)
+		})
+
+		Context("with migration priorities", func() {
+			var vmi, otherVMI *virtv1.VirtualMachineInstance
+			var migration *virtv1.VirtualMachineInstanceMigration
+
+			BeforeEach(func() {
+				vmi = newVirtualMachine("testvmi", virtv1.Running)
+				migration = newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				otherVMI = newVirtualMachine("testvmi0", virtv1.Running)
+			})
+
+			withPriority := func(migration *virtv1.VirtualMachineInstanceMigration, priority *virtv1.MigrationPriority) *virtv1.VirtualMachineInstanceMigration {
+				migration.Spec.Priority = priority
+				return migration
+			}
+
+			run := func(otherMigration *virtv1.VirtualMachineInstanceMigration) {
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				addPod(newSourcePodForVirtualMachine(vmi))
+				addMigration(otherMigration)
+				addVirtualMachineInstance(otherVMI)
+
+				controller.Execute()
+			}
+
+			DescribeTable("should start pending migrations with a higher priority first", func(kvConfig *virtv1.KubeVirtConfiguration, priority, otherPriority *virtv1.MigrationPriority, otherNodeName string, expectTargetPod bool) {
+				setConfig(kvConfig)
+				withPriority(migration, priority)
+				otherVMI.Status.NodeName = otherNodeName
+
+				run(withPriority(newMigration("testmigration0", otherVMI.Name, virtv1.MigrationPending), otherPriority))
+
+				if expectTargetPod {
+					testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+					expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+				} else {
+					expectPodDoesNotExist(vmi.Namespace, vmi.Name, migration.Name)
+				}
+			},
+				Entry("when a migration with a higher priority waits for the last cluster slot",
+					&virtv1.KubeVirtConfiguration{MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelMigrationsPerCluster: pointer.P(uint32(1))}},
+					pointer.P(virtv1.MigrationPrioritySystemMaintenance), pointer.P(virtv1.MigrationPrioritySystemCritical), "node01", false,
+				),
+				Entry("when a migration without priority waits for the last cluster slot",
+					&virtv1.KubeVirtConfiguration{MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelMigrationsPerCluster: pointer.P(uint32(1))}},
+					pointer.P(virtv1.MigrationPrioritySystemMaintenance), nil, "node01", false,
+				),
+				Entry("when a migration with the same priority waits for the last cluster slot",
+					&virtv1.KubeVirtConfiguration{MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelMigrationsPerCluster: pointer.P(uint32(1))}},
+					pointer.P(virtv1.MigrationPriorityUserTriggered), nil, "node01", true,
+				),
+				Entry("when a migration with a lower priority waits for the last cluster slot",
+					&virtv1.KubeVirtConfiguration{MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelMigrationsPerCluster: pointer.P(uint32(1))}},
+					pointer.P(virtv1.MigrationPrioritySystemCritical), pointer.P(virtv1.MigrationPrioritySystemMaintenance), "node01", true,
+				),
+				Entry("when a migration with a higher priority waits for the last slot of the node",
+					&virtv1.KubeVirtConfiguration{MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelOutboundMigrationsPerNode: pointer.P(uint32(1))}},
+					pointer.P(virtv1.MigrationPrioritySystemMaintenance), pointer.P(virtv1.MigrationPrioritySystemCritical), "tefwegwrerg", false,
+				),
+				Entry("when a migration with a higher priority waits for the last slot of another node",
+					&virtv1.KubeVirtConfiguration{MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelOutboundMigrationsPerNode: pointer.P(uint32(1))}},
+					pointer.P(virtv1.MigrationPrioritySystemMaintenance), pointer.P(virtv1.MigrationPrioritySystemCritical), "node01", true,
+				),
+			)
+
+			DescribeTable("should preempt running migrations with a lower priority", func(priority, otherPriority *virtv1.MigrationPriority, expectPreemption bool) {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelMigrationsPerCluster: pointer.P(uint32(1))},
+				})
+				withPriority(migration, priority)
+				otherVMI.Status.NodeName = "node01"
+
+				run(withPriority(newMigration("testmigration0", otherVMI.Name, virtv1.MigrationScheduling), otherPriority))
+
+				expectPodDoesNotExist(vmi.Namespace, vmi.Name, migration.Name)
+				if expectPreemption {
+					expectMigrationCondition(metav1.NamespaceDefault, "testmigration0", virtv1.VirtualMachineInstanceMigrationPreempted)
+					testutils.ExpectEvent(recorder, migrationPreemptedReason)
+				} else {
+					otherMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault).Get(context.Background(), "testmigration0", metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(otherMigration.Status.Conditions).To(BeEmpty())
+				}
+			},
+				Entry("for a node drain preempting a workload update", pointer.P(virtv1.MigrationPrioritySystemCritical), pointer.P(virtv1.MigrationPrioritySystemMaintenance), true),
+				Entry("for a node drain preempting a user migration", pointer.P(virtv1.MigrationPrioritySystemCritical), nil, true),
+				Entry("for a user migration preempting a workload update", nil, pointer.P(virtv1.MigrationPrioritySystemMaintenance), true),
+				Entry("but not for a user migration and a node drain", pointer.P(virtv1.MigrationPriorityUserTriggered), pointer.P(virtv1.MigrationPrioritySystemCritical), false),
+				Entry("but not for migrations with the same priority", pointer.P(virtv1.MigrationPrioritySystemMaintenance), pointer.P(virtv1.MigrationPrioritySystemMaintenance), false),
+			)
+
+			It("should not preempt migrations which were handed off", func() {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{ParallelMigrationsPerCluster: pointer.P(uint32(1))},
+				})
+				withPriority(migration, pointer.P(virtv1.MigrationPrioritySystemCritical))
+				otherMigration := withPriority(newMigration("testmigration0", otherVMI.Name, virtv1.MigrationScheduling), pointer.P(virtv1.MigrationPrioritySystemMaintenance))
+				otherVMI.Status.NodeName = "node01"
+				otherVMI.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{MigrationUID: otherMigration.UID}
+
+				run(otherMigration)
+
+				expectPodDoesNotExist(vmi.Namespace, vmi.Name, migration.Name)
+				otherMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(metav1.NamespaceDefault).Get(context.Background(), otherMigration.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(otherMigration.Status.Conditions).To(BeEmpty())
+			})
+
+			It("should not be blocked by a migration with a higher priority waiting for the limit of its node", func() {
+				setConfig(&virtv1.KubeVirtConfiguration{
+					MigrationConfiguration: &virtv1.MigrationConfiguration{
+						ParallelMigrationsPerCluster:      pointer.P(uint32(2)),
+						ParallelOutboundMigrationsPerNode: pointer.P(uint32(1)),
+					},
+				})
+				withPriority(migration, pointer.P(virtv1.MigrationPrioritySystemMaintenance))
+				otherVMI.Status.NodeName = "node01"
+				runningVMI := newVirtualMachine("testvmi1", virtv1.Running)
+				runningVMI.Status.NodeName = "node01"
+				Expect(controller.vmiStore.Add(runningVMI)).To(Succeed())
+				Expect(controller.migrationIndexer.Add(newMigration("testmigration1", runningVMI.Name, virtv1.MigrationRunning))).To(Succeed())
+
+				run(withPriority(newMigration("testmigration0", otherVMI.Name, virtv1.MigrationPending), pointer.P(virtv1.MigrationPrioritySystemCritical)))
+
+				testutils.ExpectEvent(recorder, virtcontroller.SuccessfulCreatePodReason)
+				expectPodCreation(vmi.Namespace, vmi.UID, migration.UID, 1, 0, 0)
+			})
+
+			Context("when preempted", func() {
+				preempted := func(migration *virtv1.VirtualMachineInstanceMigration) *virtv1.VirtualMachineInstanceMigration {
+					migration.Status.Conditions = append(migration.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+						Type:   virtv1.VirtualMachineInstanceMigrationPreempted,
+						Status: k8sv1.ConditionTrue,
+					})
+					return migration
+				}
+
+				BeforeEach(func() {
+					migration = preempted(newMigration("testmigration", vmi.Name, virtv1.MigrationScheduling))
+					addMigration(migration)
+					addVirtualMachineInstance(vmi)
+					addPod(newSourcePodForVirtualMachine(vmi))
+				})
+
+				It("should delete the target pod", func() {
+					targetPod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodPending)
+					addPod(targetPod)
+
+					controller.Execute()
+
+					testutils.ExpectEvent(recorder, virtcontroller.SuccessfulDeletePodReason)
+					_, err := kubeClient.CoreV1().Pods(targetPod.Namespace).Get(context.Background(), targetPod.Name, metav1.GetOptions{})
+					Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+					expectMigrationCondition(migration.Namespace, migration.Name, virtv1.VirtualMachineInstanceMigrationPreempted)
+				})
+
+				It("should be pending again once the target pod is gone", func() {
+					controller.Execute()
+
+					testutils.ExpectEvent(recorder, migrationRequeuedReason)
+					expectMigrationPendingState(migration.Namespace, migration.Name)
+					updatedMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(updatedMigration.Status.Conditions).To(BeEmpty())
+				})
+			})
+		})
+
 		It("should not overload the node and only run 2 outbound migrations in parallel", func() {
 			// It should create a pod for this one if we would not limit migrations
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
@@ -1733,6 +2091,43 @@ var _ = Describe("Migration watcher", func() {
 					policyInfo{"zz", 2, 2}, policyInfo{"aa", 2, 2}),
 			)
 
+			It("if two policies are detailed at the same level, the policy with the highest priority should be matched", func() {
+				lowPriorityPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 2, 2)
+				lowPriorityPolicy.Name = "aa"
+				highPriorityPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 2, 2)
+				highPriorityPolicy.Name = "zz"
+				highPriorityPolicy.Spec.Priority = pointer.P(int32(10))
+
+				policyList := kubecli.NewMinimalMigrationPolicyList(*lowPriorityPolicy, *highPriorityPolicy)
+				matchedPolicy, conflicts := matchPolicyWithConflicts(policyList, vmi, &namespace)
+				Expect(matchedPolicy.Name).To(Equal("zz"))
+				Expect(conflicts).To(BeEmpty())
+			})
+
+			It("priority should not have precedence over the number of matching labels", func() {
+				highPriorityPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 1, 0)
+				highPriorityPolicy.Spec.Priority = pointer.P(int32(10))
+				detailedPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 3, 0)
+
+				policyList := kubecli.NewMinimalMigrationPolicyList(*highPriorityPolicy, *detailedPolicy)
+				matchedPolicy := MatchPolicy(policyList, vmi, &namespace)
+				Expect(matchedPolicy.Name).To(Equal(detailedPolicy.Name))
+			})
+
+			It("policies matched with the same precedence should be reported as conflicting", func() {
+				policies := make([]migrationsv1.MigrationPolicy, 0)
+				for _, name := range []string{"zz", "aa", "mm"} {
+					policy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 2, 2)
+					policy.Name = name
+					policies = append(policies, *policy)
+				}
+
+				policyList := kubecli.NewMinimalMigrationPolicyList(policies...)
+				matchedPolicy, conflicts := matchPolicyWithConflicts(policyList, vmi, &namespace)
+				Expect(matchedPolicy.Name).To(Equal("aa"))
+				Expect(conflicts).To(Equal([]string{"aa", "mm", "zz"}))
+			})
+
 			It("policy with one non-fitting label should not match", func() {
 				const labelKeyFmt = "%s-key-0"
 
@@ -1858,6 +2253,48 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set progress timeout",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ProgressTimeout = &stubNumber },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ProgressTimeout).To(HaveValue(Equal(stubNumber)))
+				},
+				true,
+			),
+			Entry("allow unsafe migrations",
+				func(p *migrationsv1.MigrationPolicySpec) { p.UnsafeMigrationOverride = pointer.P(true) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.UnsafeMigrationOverride).To(HaveValue(BeTrue()))
+				},
+				true,
+			),
+			Entry("disable TLS",
+				func(p *migrationsv1.MigrationPolicySpec) { p.DisableTLS = pointer.P(true) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.DisableTLS).To(HaveValue(BeTrue()))
+				},
+				true,
+			),
+			Entry("set migration network",
+				func(p *migrationsv1.MigrationPolicySpec) { p.Network = pointer.P("migration-net") },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.Network).To(HaveValue(Equal("migration-net")))
+				},
+				true,
+			),
+			Entry("set maintenance windows",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.MaintenanceWindows = []virtv1.MaintenanceWindow{{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}}}
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.MaintenanceWindows).To(Equal([]virtv1.MaintenanceWindow{{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: time.Hour}}}))
+				},
+				true,
+			),
+			Entry("only set parallel migration limits",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ParallelMigrationsPerCluster = pointer.P(uint32(1)) },
+				func(c *virtv1.MigrationConfiguration) {},
+				false,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
@@ -2098,6 +2535,132 @@ var _ = Describe("Migration watcher", func() {
 		})
 	})
 
+	Context("Decentralized migration", func() {
+		It("should hand a migration to another cluster over to the source virt-handler without creating a target pod", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			addNodeNameToVMI(vmi, "node01")
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSource{
+				MigrationID: "my-migration",
+				ConnectURL:  "migration.example.com:8187",
+				Token:       "0123abcd",
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			controller.Execute()
+
+			expectPodDoesNotExist(vmi.Namespace, string(vmi.UID), string(migration.UID))
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID":                 Equal(migration.UID),
+				"MigrationID":                  Equal("my-migration"),
+				"TargetMigrationEndpoint":      Equal("migration.example.com:8187"),
+				"TargetMigrationEndpointToken": Equal("0123abcd"),
+				"SourceNode":                   Equal("node01"),
+				"TargetNode":                   BeEmpty(),
+			})))
+			expectMigrationTargetReadyState(migration.Namespace, migration.Name)
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+		})
+
+		It("should succeed once the source VMI was sent to the target cluster", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Succeeded)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationRunning)
+			migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSource{
+				MigrationID: "my-migration",
+				ConnectURL:  "migration.example.com:8187",
+			}
+			now := metav1.Now()
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:            migration.UID,
+				TargetMigrationEndpoint: "migration.example.com:8187",
+				StartTimestamp:          &now,
+				EndTimestamp:            &now,
+				Completed:               true,
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			controller.Execute()
+
+			expectMigrationCompletedState(migration.Namespace, migration.Name)
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulMigrationReason)
+		})
+
+		It("should hand a received migration over to the virt-handler of the receiving VMI pod", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Scheduled)
+			vmi.Annotations[virtv1.MigrationReceiverAnnotation] = ""
+			addNodeNameToVMI(vmi, "node01")
+			pod := newSourcePodForVirtualMachine(vmi)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+			migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationTarget{
+				MigrationID: "my-migration",
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			addPod(pod)
+
+			controller.Execute()
+
+			expectVirtualMachineInstanceMigrationState(vmi.Namespace, vmi.Name, PointTo(MatchFields(IgnoreExtras, Fields{
+				"MigrationUID": Equal(migration.UID),
+				"MigrationID":  Equal("my-migration"),
+				"TargetNode":   Equal("node01"),
+				"TargetPod":    Equal(pod.Name),
+				"SourceNode":   BeEmpty(),
+			})))
+			expectVirtualMachineInstanceLabels(vmi.Namespace, vmi.Name, HaveKeyWithValue(virtv1.MigrationTargetNodeNameLabel, "node01"))
+			_, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(context.Background(), pod.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			testutils.ExpectEvent(recorder, virtcontroller.SuccessfulHandOverPodReason)
+		})
+
+		DescribeTable("should issue a receive token bound to the receiving VMI", func(receiveToken *virtv1.MigrationReceiveToken, vmiRecreated bool) {
+			vmi := newVirtualMachine("testvmi", virtv1.Scheduled)
+			vmi.Annotations[virtv1.MigrationReceiverAnnotation] = ""
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationTarget{
+				MigrationID: "my-migration",
+			}
+			if receiveToken != nil {
+				receiveToken.VMIUID = vmi.UID
+				if vmiRecreated {
+					receiveToken.VMIUID = "old-vmi-uid"
+				}
+				migration.Status.ReceiveToken = receiveToken
+			}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			controller.Execute()
+
+			updatedVMIM, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMIM.Status.ReceiveToken).ToNot(BeNil())
+			Expect(updatedVMIM.Status.ReceiveToken.VMIUID).To(Equal(vmi.UID))
+			Expect(updatedVMIM.Status.ReceiveToken.Token).To(HaveLen(2 * receiveTokenLength))
+			if receiveToken == nil || vmiRecreated {
+				Expect(updatedVMIM.Status.ReceiveToken.Token).ToNot(Equal(strings.Repeat("ab", receiveTokenLength)))
+				Expect(updatedVMIM.Status.ReceiveToken.PeerCertificateSHA256).To(BeEmpty())
+			} else {
+				Expect(updatedVMIM.Status.ReceiveToken).To(Equal(receiveToken))
+			}
+		},
+			Entry("when the migration has no token yet", nil, false),
+			Entry("when the receiving VMI was recreated",
+				&virtv1.MigrationReceiveToken{Token: strings.Repeat("ab", receiveTokenLength), PeerCertificateSHA256: "abcd"}, true,
+			),
+			Entry("and keep the token of the receiving VMI",
+				&virtv1.MigrationReceiveToken{Token: strings.Repeat("ab", receiveTokenLength), PeerCertificateSHA256: "abcd"}, false,
+			),
+		)
+	})
+
 	Context("Migration target SELinux level", func() {
 		expectTargetPodWithSELinuxLevel := func(namespace string, uid types.UID, migrationUid types.UID, level string) {
 			pods, err := kubeClient.CoreV1().Pods(namespace).List(context.Background(), metav1.ListOptions{
@@ -2338,6 +2901,7 @@ func getDefaultMigrationConfiguration() *virtv1.MigrationConfiguration {
 	progressTimeout := virtconfig.MigrationProgressTimeout
 	unsafeMigrationOverride := virtconfig.DefaultUnsafeMigrationOverride
 	allowPostCopy := virtconfig.MigrationAllowPostCopy
+	allowAdaptiveTuning := virtconfig.MigrationAllowAdaptiveTuning
 
 	return &virtv1.MigrationConfiguration{
 		NodeDrainTaintKey:                 &nodeTaintKey,
@@ -2349,6 +2913,7 @@ func getDefaultMigrationConfiguration() *virtv1.MigrationConfiguration {
 		ProgressTimeout:                   &progressTimeout,
 		UnsafeMigrationOverride:           &unsafeMigrationOverride,
 		AllowPostCopy:                     &allowPostCopy,
+		AllowAdaptiveTuning:               &allowAdaptiveTuning,
 	}
 }
 
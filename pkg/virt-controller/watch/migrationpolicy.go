@@ -1,10 +1,25 @@
 package watch
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 
 	k6tv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
 )
 
 type migrationPolicyMatchScore struct {
@@ -38,10 +53,19 @@ func (score migrationPolicyMatchScore) lessThan(otherScore migrationPolicyMatchS
 // detailed policy, meaning the policy that matches the VMI and specifies the most labels that matched either
 // the VMI or its namespace labels.
 //
-// If two policies are matched and have the same level of details (i.e. same number of matching labels) the matched
-// policy is chosen by policies' names ordered by lexicographic order. The reason is to create a rather arbitrary yet
-// deterministic way of matching policies.
+// If two policies are matched and have the same level of details (i.e. same number of matching labels) the policy
+// with the highest priority is chosen. If their priority is the same as well, the matched policy is chosen by
+// policies' names ordered by lexicographic order. The reason is to create a rather arbitrary yet deterministic way
+// of matching policies.
 func MatchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) *v1alpha1.MigrationPolicy {
+	matchedPolicy, _ := matchPolicyWithConflicts(policyList, vmi, vmiNamespace)
+	return matchedPolicy
+}
+
+// matchPolicyWithConflicts returns the policy that is matched to the vmi, as MatchPolicy does. When the policy was
+// chosen by name because other policies match the vmi with the same precedence, the names of all these policies are
+// returned as well.
+func matchPolicyWithConflicts(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) (*v1alpha1.MigrationPolicy, []string) {
 	var mathingPolicies []v1alpha1.MigrationPolicy
 	bestScore := migrationPolicyMatchScore{}
 
@@ -58,25 +82,54 @@ func MatchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMac
 		}
 	}
 
+	// If more than one policy is matched with the same number of matching labels it will be chosen by priority
+	mathingPolicies = filterHighestPriorityPolicies(mathingPolicies)
+
 	if len(mathingPolicies) == 0 {
-		return nil
+		return nil, nil
 	} else if len(mathingPolicies) == 1 {
-		return &mathingPolicies[0]
+		return &mathingPolicies[0], nil
+	}
+
+	// If more than one policy is matched with the same number of matching labels and the same priority it will be
+	// chosen by policies names' lexicographic order
+	sort.Slice(mathingPolicies, func(i, j int) bool {
+		return mathingPolicies[i].Name < mathingPolicies[j].Name
+	})
+
+	conflictingPolicies := make([]string, 0, len(mathingPolicies))
+	for _, matchingPolicy := range mathingPolicies {
+		conflictingPolicies = append(conflictingPolicies, matchingPolicy.Name)
+	}
+
+	return &mathingPolicies[0], conflictingPolicies
+}
+
+func getPolicyPriority(policy *v1alpha1.MigrationPolicy) int32 {
+	if policy.Spec.Priority == nil {
+		return 0
 	}
+	return *policy.Spec.Priority
+}
 
-	// If more than one policy is matched with the same number of matching labels it will be chosen by policies names'
-	// lexicographic order
-	firstPolicyNameLexicographicOrder := mathingPolicies[0].Name
-	var firstPolicyNameLexicographicOrderIdx int
+func filterHighestPriorityPolicies(policies []v1alpha1.MigrationPolicy) []v1alpha1.MigrationPolicy {
+	var highestPriorityPolicies []v1alpha1.MigrationPolicy
+
+	for _, policy := range policies {
+		if len(highestPriorityPolicies) == 0 {
+			highestPriorityPolicies = append(highestPriorityPolicies, policy)
+			continue
+		}
 
-	for idx, matchingPolicy := range mathingPolicies {
-		if matchingPolicy.Name < firstPolicyNameLexicographicOrder {
-			firstPolicyNameLexicographicOrder = matchingPolicy.Name
-			firstPolicyNameLexicographicOrderIdx = idx
+		highestPriority := getPolicyPriority(&highestPriorityPolicies[0])
+		if priority := getPolicyPriority(&policy); priority > highestPriority {
+			highestPriorityPolicies = []v1alpha1.MigrationPolicy{policy}
+		} else if priority == highestPriority {
+			highestPriorityPolicies = append(highestPriorityPolicies, policy)
 		}
 	}
 
-	return &mathingPolicies[firstPolicyNameLexicographicOrderIdx]
+	return highestPriorityPolicies
 }
 
 // countMatchingLabels checks if a policy matches to a VMI and the number of matching labels.
@@ -120,3 +173,205 @@ func countMatchingLabels(policy *v1alpha1.MigrationPolicy, vmiLabels, namespaceL
 
 	return doesMatch, score
 }
+
+const (
+	// migrationPolicyStatusKey is the only key of the migration policy queue, since the status of every policy
+	// depends on all the policies and VMIs
+	migrationPolicyStatusKey = "migrationpolicies"
+	// maxReportedPolicyVMIs is the maximum number of VMIs listed in the status of a migration policy
+	maxReportedPolicyVMIs = 100
+)
+
+// MigrationPolicyController reports in the status of every migration policy the VMIs it applies to and the VMIs
+// it conflicts on with other policies.
+type MigrationPolicyController struct {
+	clientset            kubecli.KubevirtClient
+	Queue                workqueue.RateLimitingInterface
+	migrationPolicyStore cache.Store
+	vmiStore             cache.Store
+	namespaceStore       cache.Store
+	hasSynced            func() bool
+}
+
+// NewMigrationPolicyController creates a new instance of the MigrationPolicyController struct.
+func NewMigrationPolicyController(clientset kubecli.KubevirtClient, migrationPolicyInformer, vmiInformer, namespaceInformer cache.SharedIndexInformer) (*MigrationPolicyController, error) {
+	c := &MigrationPolicyController{
+		clientset:            clientset,
+		Queue:                workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-migrationpolicy"),
+		migrationPolicyStore: migrationPolicyInformer.GetStore(),
+		vmiStore:             vmiInformer.GetStore(),
+		namespaceStore:       namespaceInformer.GetStore(),
+	}
+
+	c.hasSynced = func() bool {
+		return migrationPolicyInformer.HasSynced() && vmiInformer.HasSynced() && namespaceInformer.HasSynced()
+	}
+
+	_, err := migrationPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateMigrationPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateLabels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateLabels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *MigrationPolicyController) enqueue() {
+	c.Queue.Add(migrationPolicyStatusKey)
+}
+
+func (c *MigrationPolicyController) updateMigrationPolicy(old, curr interface{}) {
+	oldPolicy := old.(*v1alpha1.MigrationPolicy)
+	currPolicy := curr.(*v1alpha1.MigrationPolicy)
+	if !equality.Semantic.DeepEqual(oldPolicy.Spec, currPolicy.Spec) {
+		c.enqueue()
+	}
+}
+
+func (c *MigrationPolicyController) updateLabels(old, curr interface{}) {
+	oldObj := old.(metav1.Object)
+	currObj := curr.(metav1.Object)
+	if !equality.Semantic.DeepEqual(oldObj.GetLabels(), currObj.GetLabels()) {
+		c.enqueue()
+	}
+}
+
+// Run runs the passed in MigrationPolicyController.
+func (c *MigrationPolicyController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting migration policy controller.")
+
+	// Wait for cache sync before we start the migration policy controller
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping migration policy controller.")
+}
+
+func (c *MigrationPolicyController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *MigrationPolicyController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	err := c.execute()
+
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *MigrationPolicyController) execute() error {
+	policyList := &v1alpha1.MigrationPolicyList{}
+	for _, obj := range c.migrationPolicyStore.List() {
+		policyList.Items = append(policyList.Items, *obj.(*v1alpha1.MigrationPolicy))
+	}
+	if len(policyList.Items) == 0 {
+		return nil
+	}
+
+	statuses := map[string]*v1alpha1.MigrationPolicyStatus{}
+	for _, policy := range policyList.Items {
+		statuses[policy.Name] = &v1alpha1.MigrationPolicyStatus{}
+	}
+
+	vmis := c.vmiStore.List()
+	sort.Slice(vmis, func(i, j int) bool {
+		return controller.VirtualMachineInstanceKey(vmis[i].(*k6tv1.VirtualMachineInstance)) <
+			controller.VirtualMachineInstanceKey(vmis[j].(*k6tv1.VirtualMachineInstance))
+	})
+
+	for _, obj := range vmis {
+		vmi := obj.(*k6tv1.VirtualMachineInstance)
+		vmiNamespace, err := c.getNamespace(vmi.Namespace)
+		if err != nil {
+			return err
+		}
+
+		matchedPolicy, conflictingPolicies := matchPolicyWithConflicts(policyList, vmi, vmiNamespace)
+		if matchedPolicy == nil {
+			continue
+		}
+
+		vmiRef := v1alpha1.MigrationPolicyVirtualMachineInstance{Namespace: vmi.Namespace, Name: vmi.Name}
+		status := statuses[matchedPolicy.Name]
+		status.MatchedVirtualMachineInstances++
+		if len(status.VirtualMachineInstances) < maxReportedPolicyVMIs {
+			status.VirtualMachineInstances = append(status.VirtualMachineInstances, vmiRef)
+		}
+
+		for _, policyName := range conflictingPolicies {
+			status = statuses[policyName]
+			if len(status.Conflicts) < maxReportedPolicyVMIs {
+				status.Conflicts = append(status.Conflicts, v1alpha1.MigrationPolicyConflict{
+					VirtualMachineInstance: vmiRef,
+					Policies:               conflictingPolicies,
+					AppliedPolicy:          matchedPolicy.Name,
+				})
+			}
+		}
+	}
+
+	var errs []error
+	for _, policy := range policyList.Items {
+		if equality.Semantic.DeepEqual(policy.Status, *statuses[policy.Name]) {
+			continue
+		}
+
+		policyCopy := policy.DeepCopy()
+		policyCopy.Status = *statuses[policy.Name]
+		if _, err := c.clientset.MigrationPolicy().UpdateStatus(context.Background(), policyCopy, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update status of migration policy %s: %v", policy.Name, err))
+		}
+	}
+
+	return errors.NewAggregate(errs)
+}
+
+func (c *MigrationPolicyController) getNamespace(name string) (*k8sv1.Namespace, error) {
+	obj, exists, err := c.namespaceStore.GetByKey(name)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return &k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	}
+	return obj.(*k8sv1.Namespace), nil
+}
@@ -0,0 +1,194 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Migration policy controller", func() {
+
+	var (
+		controller    *MigrationPolicyController
+		mockQueue     *testutils.MockWorkQueue
+		virtClientset *kubevirtfake.Clientset
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClientset = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().MigrationPolicy().Return(virtClientset.MigrationsV1alpha1().MigrationPolicies()).AnyTimes()
+
+		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+
+		var err error
+		controller, err = NewMigrationPolicyController(virtClient, migrationPolicyInformer, vmiInformer, namespaceInformer)
+		Expect(err).ToNot(HaveOccurred())
+		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
+		controller.Queue = mockQueue
+	})
+
+	addPolicy := func(policy *migrationsv1.MigrationPolicy) {
+		Expect(controller.migrationPolicyStore.Add(policy)).To(Succeed())
+		_, err := virtClientset.MigrationsV1alpha1().MigrationPolicies().Create(context.Background(), policy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMI := func(name string, labels map[string]string) {
+		vmi := newVirtualMachine(name, virtv1.Running)
+		vmi.Labels = labels
+		Expect(controller.vmiStore.Add(vmi)).To(Succeed())
+	}
+
+	newPolicy := func(name string, vmiSelector map[string]string) *migrationsv1.MigrationPolicy {
+		policy := kubecli.NewMinimalMigrationPolicy(name)
+		policy.Spec.Selectors = &migrationsv1.Selectors{
+			VirtualMachineInstanceSelector: vmiSelector,
+		}
+		return policy
+	}
+
+	execute := func() {
+		mockQueue.Add(migrationPolicyStatusKey)
+		controller.Execute()
+	}
+
+	getPolicyStatus := func(name string) migrationsv1.MigrationPolicyStatus {
+		policy, err := virtClientset.MigrationsV1alpha1().MigrationPolicies().Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy.Status
+	}
+
+	vmiRef := func(name string) migrationsv1.MigrationPolicyVirtualMachineInstance {
+		return migrationsv1.MigrationPolicyVirtualMachineInstance{Namespace: metav1.NamespaceDefault, Name: name}
+	}
+
+	It("should report the VMIs a policy applies to", func() {
+		addPolicy(newPolicy("policy", map[string]string{"app": "db"}))
+		addVMI("vmi-b", map[string]string{"app": "db"})
+		addVMI("vmi-a", map[string]string{"app": "db"})
+		addVMI("vmi-c", map[string]string{"app": "web"})
+
+		execute()
+
+		status := getPolicyStatus("policy")
+		Expect(status.MatchedVirtualMachineInstances).To(Equal(int32(2)))
+		Expect(status.VirtualMachineInstances).To(Equal([]migrationsv1.MigrationPolicyVirtualMachineInstance{vmiRef("vmi-a"), vmiRef("vmi-b")}))
+		Expect(status.Conflicts).To(BeEmpty())
+	})
+
+	It("should limit the number of reported VMIs", func() {
+		addPolicy(newPolicy("policy", map[string]string{"app": "db"}))
+		for i := 0; i < maxReportedPolicyVMIs+10; i++ {
+			addVMI(fmt.Sprintf("vmi-%03d", i), map[string]string{"app": "db"})
+		}
+
+		execute()
+
+		status := getPolicyStatus("policy")
+		Expect(status.MatchedVirtualMachineInstances).To(Equal(int32(maxReportedPolicyVMIs + 10)))
+		Expect(status.VirtualMachineInstances).To(HaveLen(maxReportedPolicyVMIs))
+	})
+
+	It("should report conflicts between policies matching a VMI with the same precedence", func() {
+		addPolicy(newPolicy("policy-b", map[string]string{"app": "db"}))
+		addPolicy(newPolicy("policy-a", map[string]string{"tier": "backend"}))
+		addVMI("vmi", map[string]string{"app": "db", "tier": "backend"})
+
+		execute()
+
+		expectedConflicts := []migrationsv1.MigrationPolicyConflict{{
+			VirtualMachineInstance: vmiRef("vmi"),
+			Policies:               []string{"policy-a", "policy-b"},
+			AppliedPolicy:          "policy-a",
+		}}
+		statusA := getPolicyStatus("policy-a")
+		Expect(statusA.MatchedVirtualMachineInstances).To(Equal(int32(1)))
+		Expect(statusA.Conflicts).To(Equal(expectedConflicts))
+		statusB := getPolicyStatus("policy-b")
+		Expect(statusB.MatchedVirtualMachineInstances).To(BeZero())
+		Expect(statusB.VirtualMachineInstances).To(BeEmpty())
+		Expect(statusB.Conflicts).To(Equal(expectedConflicts))
+	})
+
+	It("should not report a conflict when the priority breaks the tie", func() {
+		policyB := newPolicy("policy-b", map[string]string{"app": "db"})
+		policyB.Spec.Priority = pointer.P(int32(1))
+		addPolicy(policyB)
+		addPolicy(newPolicy("policy-a", map[string]string{"tier": "backend"}))
+		addVMI("vmi", map[string]string{"app": "db", "tier": "backend"})
+
+		execute()
+
+		Expect(getPolicyStatus("policy-a")).To(Equal(migrationsv1.MigrationPolicyStatus{}))
+		statusB := getPolicyStatus("policy-b")
+		Expect(statusB.VirtualMachineInstances).To(Equal([]migrationsv1.MigrationPolicyVirtualMachineInstance{vmiRef("vmi")}))
+		Expect(statusB.Conflicts).To(BeEmpty())
+	})
+
+	It("should match namespace labels", func() {
+		policy := kubecli.NewMinimalMigrationPolicy("policy")
+		policy.Spec.Selectors = &migrationsv1.Selectors{
+			NamespaceSelector: map[string]string{"env": "prod"},
+		}
+		addPolicy(policy)
+		Expect(controller.namespaceStore.Add(&k8sv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault, Labels: map[string]string{"env": "prod"}},
+		})).To(Succeed())
+		addVMI("vmi", map[string]string{"app": "db"})
+
+		execute()
+
+		Expect(getPolicyStatus("policy").VirtualMachineInstances).To(Equal([]migrationsv1.MigrationPolicyVirtualMachineInstance{vmiRef("vmi")}))
+	})
+
+	It("should not update a policy whose status did not change", func() {
+		policy := newPolicy("policy", map[string]string{"app": "db"})
+		policy.Status = migrationsv1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstances: 1,
+			VirtualMachineInstances:        []migrationsv1.MigrationPolicyVirtualMachineInstance{vmiRef("vmi")},
+		}
+		addPolicy(policy)
+		addVMI("vmi", map[string]string{"app": "db"})
+		virtClientset.ClearActions()
+
+		execute()
+
+		Expect(virtClientset.Actions()).To(BeEmpty())
+	})
+})
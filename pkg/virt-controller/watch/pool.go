@@ -2,16 +2,19 @@ package watch
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"maps"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
@@ -23,6 +26,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -37,6 +41,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/placeholder"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
 )
 
@@ -48,6 +53,7 @@ type PoolController struct {
 	vmiStore        cache.Store
 	poolIndexer     cache.Indexer
 	revisionIndexer cache.Indexer
+	nodeStore       cache.Store
 	recorder        record.EventRecorder
 	expectations    *controller.UIDTrackingControllerExpectations
 	burstReplicas   uint
@@ -67,9 +73,12 @@ const (
 	FailedScaleInReason         = "FailedScaleIn"
 	FailedUpdateReason          = "FailedUpdate"
 	FailedRevisionPruningReason = "FailedRevisionPruning"
+	FailedFinalizerReason       = "FailedFinalizer"
 
 	SuccessfulPausedPoolReason = "SuccessfulPaused"
 	SuccessfulResumePoolReason = "SuccessfulResume"
+
+	FailedUpdatedVirtualMachineReason = "FailedUpdatedVirtualMachine"
 )
 
 var virtControllerPoolWorkQueueTracer = &traceUtils.Tracer{Threshold: time.Second}
@@ -80,6 +89,7 @@ func NewPoolController(clientset kubecli.KubevirtClient,
 	vmInformer cache.SharedIndexInformer,
 	poolInformer cache.SharedIndexInformer,
 	revisionInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	burstReplicas uint) (*PoolController, error) {
 	c := &PoolController{
@@ -89,6 +99,7 @@ func NewPoolController(clientset kubecli.KubevirtClient,
 		vmiStore:        vmiInformer.GetStore(),
 		vmIndexer:       vmInformer.GetIndexer(),
 		revisionIndexer: revisionInformer.GetIndexer(),
+		nodeStore:       nodeInformer.GetStore(),
 		recorder:        recorder,
 		expectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		burstReplicas:   burstReplicas,
@@ -96,7 +107,7 @@ func NewPoolController(clientset kubecli.KubevirtClient,
 	}
 
 	c.hasSynced = func() bool {
-		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced()
+		return poolInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && revisionInformer.HasSynced() && nodeInformer.HasSynced()
 	}
 
 	_, err := poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -470,12 +481,9 @@ func (c *PoolController) listVMsFromNamespace(namespace string) ([]*virtv1.Virtu
 }
 
 func (c *PoolController) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
-	wantedReplicas := int32(1)
-	if pool.Spec.Replicas != nil {
-		wantedReplicas = *pool.Spec.Replicas
-	}
+	wantedReplicas := desiredReplicas(pool) + c.surgeReplicas(pool, vms)
 
-	return len(vms) - int(wantedReplicas)
+	return len(vms) - wantedReplicas
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -489,13 +497,23 @@ func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 	return filtered
 }
 
-// filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
-func (c *PoolController) filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+// filterStoppedVMs takes a list of VMs and returns all VMs which are not intentionally stopped.
+func filterStoppedVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 	return filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
-		return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
+		runStrategy, err := vm.RunStrategy()
+		return err != nil || runStrategy != virtv1.RunStrategyHalted
 	})
 }
 
+// filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
+func (c *PoolController) filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	return filterVMs(vms, c.isReadyVM)
+}
+
+func (c *PoolController) isReadyVM(vm *virtv1.VirtualMachine) bool {
+	return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
+}
+
 func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine) bool) []*virtv1.VirtualMachine {
 	filtered := []*virtv1.VirtualMachine{}
 	for _, vm := range vms {
@@ -508,11 +526,6 @@ func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine)
 
 func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) error {
 
-	poolKey, err := controller.KeyFunc(pool)
-	if err != nil {
-		return err
-	}
-
 	elgibleVMs := filterDeletingVMs(vms)
 
 	// make sure we count already deleting VMs here during scale in.
@@ -524,16 +537,156 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
-	rand.Shuffle(len(elgibleVMs), func(i, j int) {
-		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
+	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
+
+	_, whenScaled := dataVolumeRetentionPolicy(pool)
+	retainDataVolumes := whenScaled == poolv1.VirtualMachinePoolRetainDataVolumeRetentionPolicy
+
+	return c.deleteVMs(pool, c.scaleInCandidates(pool, elgibleVMs, count), retainDataVolumes)
+}
+
+// scaleInCandidates selects count vms for deletion according to the deletion cost
+// annotation and the scale in policy of the pool.
+func (c *PoolController) scaleInCandidates(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) []*virtv1.VirtualMachine {
+	// randomize the order first, so that otherwise equal vms are picked randomly
+	rand.Shuffle(len(vms), func(i, j int) {
+		vms[i], vms[j] = vms[j], vms[i]
 	})
 
-	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
+	if isOrderedPool(pool) {
+		// ordered pools always remove the vm with the highest index first
+		sort.SliceStable(vms, func(i, j int) bool {
+			iIndex, _ := indexFromName(vms[i].Name)
+			jIndex, _ := indexFromName(vms[j].Name)
+			return iIndex > jIndex
+		})
+		return vms[:count]
+	}
+
+	var less func(a, b *virtv1.VirtualMachine) bool
+	switch pool.Spec.ScaleInPolicy {
+	case poolv1.VirtualMachinePoolNewestScaleInPolicy:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return b.CreationTimestamp.Before(&a.CreationTimestamp)
+		}
+	case poolv1.VirtualMachinePoolOldestScaleInPolicy:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+	case poolv1.VirtualMachinePoolNotReadyScaleInPolicy:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return !c.isReadyVM(a) && c.isReadyVM(b)
+		}
+	case poolv1.VirtualMachinePoolNodeSpreadScaleInPolicy:
+		return c.nodeSpreadScaleInCandidates(vms, count)
+	default:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return false
+		}
+	}
+
+	sort.SliceStable(vms, func(i, j int) bool {
+		costI, costJ := vmDeletionCost(vms[i]), vmDeletionCost(vms[j])
+		if costI != costJ {
+			return costI < costJ
+		}
+		return less(vms[i], vms[j])
+	})
+
+	return vms[:count]
+}
+
+// nodeSpreadScaleInCandidates selects count vms for deletion, one at a time, always
+// picking a vm from the zone and node which currently host most of the remaining vms.
+// Vms which are not scheduled to a node do not contribute to the spread and are picked first.
+func (c *PoolController) nodeSpreadScaleInCandidates(vms []*virtv1.VirtualMachine, count int) []*virtv1.VirtualMachine {
+	nodes := map[string]string{}
+	zones := map[string]string{}
+	nodeCount := map[string]int{}
+	zoneCount := map[string]int{}
+	for _, vm := range vms {
+		node, zone := c.vmTopology(vm)
+		nodes[vm.Name] = node
+		zones[vm.Name] = zone
+		if node != "" {
+			nodeCount[node]++
+			zoneCount[zone]++
+		}
+	}
+
+	remaining := append([]*virtv1.VirtualMachine{}, vms...)
+	candidates := make([]*virtv1.VirtualMachine, 0, count)
+	for len(candidates) < count {
+		sort.SliceStable(remaining, func(i, j int) bool {
+			a, b := remaining[i], remaining[j]
+			costA, costB := vmDeletionCost(a), vmDeletionCost(b)
+			if costA != costB {
+				return costA < costB
+			}
+			nodeA, nodeB := nodes[a.Name], nodes[b.Name]
+			if (nodeA == "") != (nodeB == "") {
+				return nodeA == ""
+			}
+			zoneA, zoneB := zones[a.Name], zones[b.Name]
+			if zoneCount[zoneA] != zoneCount[zoneB] {
+				return zoneCount[zoneA] > zoneCount[zoneB]
+			}
+			return nodeCount[nodeA] > nodeCount[nodeB]
+		})
+
+		vm := remaining[0]
+		remaining = remaining[1:]
+		candidates = append(candidates, vm)
+		if node := nodes[vm.Name]; node != "" {
+			nodeCount[node]--
+			zoneCount[zones[vm.Name]]--
+		}
+	}
+
+	return candidates
+}
+
+// vmTopology returns the node a vm is running on and the zone of that node.
+func (c *PoolController) vmTopology(vm *virtv1.VirtualMachine) (node string, zone string) {
+	obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		return "", ""
+	}
+	node = obj.(*virtv1.VirtualMachineInstance).Status.NodeName
+	if node == "" {
+		return "", ""
+	}
+
+	obj, exists, _ = c.nodeStore.GetByKey(node)
+	if !exists {
+		return node, ""
+	}
+	return node, obj.(*k8score.Node).Labels[k8score.LabelTopologyZone]
+}
+
+// vmDeletionCost returns the deletion cost of a vm. Invalid or missing costs are treated as 0.
+func vmDeletionCost(vm *virtv1.VirtualMachine) int32 {
+	value, exists := vm.Annotations[poolv1.VirtualMachinePoolDeletionCostAnnotation]
+	if !exists {
+		return 0
+	}
+	cost, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(cost)
+}
+
+// deleteVMs deletes the given vms. If retainDataVolumes is set, the DataVolumes of the vms
+// are orphaned instead of deleted, which also orphans the vmis, so they get deleted explicitly.
+func (c *PoolController) deleteVMs(pool *poolv1.VirtualMachinePool, deleteList []*virtv1.VirtualMachine, retainDataVolumes bool) error {
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
 
 	var wg sync.WaitGroup
 
-	deleteList := elgibleVMs[0:count]
 	c.expectations.ExpectDeletions(poolKey, controller.VirtualMachineKeys(deleteList))
 	wg.Add(len(deleteList))
 	errChan := make(chan error, len(deleteList))
@@ -542,14 +695,25 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 			defer wg.Done()
 			vm := deleteList[idx]
 
-			foreGround := metav1.DeletePropagationForeground
-			err := c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{PropagationPolicy: &foreGround})
+			propagationPolicy := metav1.DeletePropagationForeground
+			if retainDataVolumes {
+				propagationPolicy = metav1.DeletePropagationOrphan
+			}
+			err := c.clientset.VirtualMachine(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 			if err != nil {
 				c.expectations.DeletionObserved(poolKey, controller.VirtualMachineKey(vm))
 				c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedDeleteVirtualMachineReason, "Error deleting virtual machine %s: %v", vm.ObjectMeta.Name, err)
 				errChan <- err
 				return
 			}
+			if retainDataVolumes {
+				err = c.clientset.VirtualMachineInstance(vm.Namespace).Delete(context.Background(), vm.Name, metav1.DeleteOptions{})
+				if err != nil && !errors.IsNotFound(err) {
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedDeleteVirtualMachineReason, "Error deleting virtual machine instance %s: %v", vm.ObjectMeta.Name, err)
+					errChan <- err
+					return
+				}
+			}
 			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDeleteVirtualMachineReason, "Deleted VM %s/%s with uid %v from pool", vm.Namespace, vm.Name, vm.ObjectMeta.UID)
 			log.Log.Object(pool).Infof("Deleted vm %s/%s from pool", vm.Namespace, vm.Name)
 		}(i)
@@ -616,6 +780,10 @@ func indexFromName(name string) (int, error) {
 
 func indexVMSpec(spec *virtv1.VirtualMachineSpec, idx int) *virtv1.VirtualMachineSpec {
 
+	if spec.Template != nil {
+		indexCloudInit(spec.Template.Spec.Volumes, idx)
+	}
+
 	if len(spec.DataVolumeTemplates) == 0 {
 		return spec
 	}
@@ -646,6 +814,37 @@ func indexVMSpec(spec *virtv1.VirtualMachineSpec, idx int) *virtv1.VirtualMachin
 	return spec
 }
 
+// indexCloudInit replaces the {{ .Index }} placeholder in the inline cloud-init data with the vm index.
+// Cloud-init data referenced from secrets is shared by all the vms and is rejected by the admitter for stateful pools.
+func indexCloudInit(volumes []virtv1.Volume, idx int) {
+	for i := range volumes {
+		if noCloud := volumes[i].CloudInitNoCloud; noCloud != nil {
+			noCloud.UserData = placeholder.ReplaceIndex(noCloud.UserData, idx)
+			noCloud.NetworkData = placeholder.ReplaceIndex(noCloud.NetworkData, idx)
+			noCloud.UserDataBase64 = indexBase64(noCloud.UserDataBase64, idx)
+			noCloud.NetworkDataBase64 = indexBase64(noCloud.NetworkDataBase64, idx)
+		}
+		if configDrive := volumes[i].CloudInitConfigDrive; configDrive != nil {
+			configDrive.UserData = placeholder.ReplaceIndex(configDrive.UserData, idx)
+			configDrive.NetworkData = placeholder.ReplaceIndex(configDrive.NetworkData, idx)
+			configDrive.UserDataBase64 = indexBase64(configDrive.UserDataBase64, idx)
+			configDrive.NetworkDataBase64 = indexBase64(configDrive.NetworkDataBase64, idx)
+		}
+	}
+}
+
+// indexBase64 replaces the {{ .Index }} placeholder in base64 encoded data, data which can not be decoded is kept.
+func indexBase64(data string, idx int) string {
+	if data == "" {
+		return data
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil || !placeholder.HasIndex(string(decoded)) {
+		return data
+	}
+	return base64.StdEncoding.EncodeToString([]byte(placeholder.ReplaceIndex(string(decoded), idx)))
+}
+
 func injectPoolRevisionLabelsIntoVM(vm *virtv1.VirtualMachine, revisionName string) *virtv1.VirtualMachine {
 
 	if vm.Labels == nil {
@@ -803,6 +1002,15 @@ func (c *PoolController) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.Vi
 	}
 
 	diff = limit(diff, c.burstReplicas)
+	if isOrderedPool(pool) {
+		// ordered pools add or remove one vm at a time, once all other vms are ready.
+		// Stopped vms are not expected to become ready and do not block the pool.
+		if len(c.filterReadyVMs(filterDeletingVMs(vms))) != len(filterStoppedVMs(vms)) {
+			return nil, false
+		}
+		diff = limit(diff, 1)
+	}
+
 	if diff < 0 {
 		err := c.scaleOut(pool, abs(diff))
 		if err != nil {
@@ -874,33 +1082,58 @@ func (c *PoolController) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vm
 }
 
 func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
+	vmiUpdates, err := c.outdatedVMIs(vmUpdatedList)
+	if err != nil {
+		return err
+	}
+
+	return c.updateVMIs(pool, vmiUpdates)
+}
+
+type vmiUpdate struct {
+	vm         *virtv1.VirtualMachine
+	vmi        *virtv1.VirtualMachineInstance
+	updateType proactiveUpdateType
+}
+
+// outdatedVMIs returns the pending updates for the VMIs of the given up-to-date VMs.
+func (c *PoolController) outdatedVMIs(vmUpdatedList []*virtv1.VirtualMachine) ([]vmiUpdate, error) {
+	var vmiUpdates []vmiUpdate
+	for _, vm := range vmUpdatedList {
+		vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+		obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
+		if !exists {
+			// no VMI to update
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil {
+			// ignore VMIs which are already deleting
+			continue
+		}
+
+		updateType, err := c.isOutdatedVMI(vm, vmi)
+		if err != nil {
+			return nil, err
+		}
+		if updateType != proactiveUpdateTypeNone {
+			vmiUpdates = append(vmiUpdates, vmiUpdate{vm: vm, vmi: vmi, updateType: updateType})
+		}
+	}
+	return vmiUpdates, nil
+}
+
+func (c *PoolController) updateVMIs(pool *poolv1.VirtualMachinePool, vmiUpdates []vmiUpdate) error {
 	var wg sync.WaitGroup
-	wg.Add(len(vmUpdatedList))
-	errChan := make(chan error, len(vmUpdatedList))
-	for i := 0; i < len(vmUpdatedList); i++ {
+	wg.Add(len(vmiUpdates))
+	errChan := make(chan error, len(vmiUpdates))
+	for i := 0; i < len(vmiUpdates); i++ {
 		go func(idx int) {
 			defer wg.Done()
-			vm := vmUpdatedList[idx]
+			vm := vmiUpdates[idx].vm
+			vmi := vmiUpdates[idx].vmi
 
-			vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
-			obj, exists, _ := c.vmiStore.GetByKey(vmiKey)
-			if !exists {
-				// no VMI to update
-				return
-			}
-			vmi := obj.(*virtv1.VirtualMachineInstance)
-			if vmi.DeletionTimestamp != nil {
-				// ignore VMIs which are already deleting
-				return
-			}
-
-			updateType, err := c.isOutdatedVMI(vm, vmi)
-			if err != nil {
-				errChan <- err
-				return
-
-			}
-			switch updateType {
+			switch vmiUpdates[idx].updateType {
 			case proactiveUpdateTypeRestart:
 				err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, v1.DeleteOptions{})
 				if err != nil {
@@ -1049,33 +1282,240 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 }
 
 func (c *PoolController) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
+	reason, err := c.outdatedVMReason(pool, vm)
+	if err != nil {
+		return true, err
+	} else if reason != "" {
+		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to %s", vm.Namespace, vm.Name, reason)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// outdatedVMReason returns why the VM does not match the current pool template,
+// or an empty string if the VM is up-to-date.
+func (c *PoolController) outdatedVMReason(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (string, error) {
 
 	if vm.Labels == nil {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to missing labels ", vm.Namespace, vm.Name)
-		return true, nil
+		return "missing labels", nil
 	}
 
 	revisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
 	if !exists {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to missing revision labels ", vm.Namespace, vm.Name)
-		return true, nil
+		return "missing revision labels", nil
 	}
 
 	oldPoolSpec, exists, err := c.getControllerRevision(pool.Namespace, revisionName)
 	if err != nil {
-		return true, err
+		return "", err
 	} else if !exists {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due to missing revision", vm.Namespace, vm.Name)
-		return true, nil
+		return "missing revision", nil
 	}
 
 	if !equality.Semantic.DeepEqual(oldPoolSpec.VirtualMachineTemplate, pool.Spec.VirtualMachineTemplate) {
-		log.Log.Object(pool).Infof("Marking vm %s/%s for update due out of date spec", vm.Namespace, vm.Name)
-		return true, nil
+		return "out of date spec", nil
 	}
 
-	return false, nil
+	return "", nil
+}
+
+// isUpdatedVM returns true if the VM and its VMI, if any, run the current pool template.
+func (c *PoolController) isUpdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	if reason, err := c.outdatedVMReason(pool, vm); err != nil || reason != "" {
+		return false
+	}
 
+	obj, exists, _ := c.vmiStore.GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		return true
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	return vmi.Labels[virtv1.VirtualMachinePoolRevisionName] == vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+}
+
+func isOrderedPool(pool *poolv1.VirtualMachinePool) bool {
+	return pool.Spec.StatefulPolicy != nil &&
+		pool.Spec.StatefulPolicy.ManagementPolicy != poolv1.VirtualMachinePoolParallelManagementPolicy
+}
+
+// dataVolumeRetentionPolicy returns what happens to the DataVolumes of the pool vms
+// when the pool is deleted and when it is scaled in.
+func dataVolumeRetentionPolicy(pool *poolv1.VirtualMachinePool) (whenDeleted, whenScaled poolv1.VirtualMachinePoolDataVolumeRetentionPolicyType) {
+	if pool.Spec.StatefulPolicy == nil {
+		return poolv1.VirtualMachinePoolDeleteDataVolumeRetentionPolicy, poolv1.VirtualMachinePoolDeleteDataVolumeRetentionPolicy
+	}
+
+	whenDeleted = poolv1.VirtualMachinePoolRetainDataVolumeRetentionPolicy
+	whenScaled = poolv1.VirtualMachinePoolRetainDataVolumeRetentionPolicy
+	if policy := pool.Spec.StatefulPolicy.DataVolumeRetentionPolicy; policy != nil {
+		if policy.WhenDeleted != "" {
+			whenDeleted = policy.WhenDeleted
+		}
+		if policy.WhenScaled != "" {
+			whenScaled = policy.WhenScaled
+		}
+	}
+	return whenDeleted, whenScaled
+}
+
+// syncRetentionFinalizer makes sure that the pool carries the DataVolume retention
+// finalizer exactly when its DataVolumes have to be retained on deletion.
+func (c *PoolController) syncRetentionFinalizer(pool *poolv1.VirtualMachinePool) (*poolv1.VirtualMachinePool, error) {
+	whenDeleted, _ := dataVolumeRetentionPolicy(pool)
+	needsFinalizer := whenDeleted == poolv1.VirtualMachinePoolRetainDataVolumeRetentionPolicy
+	if needsFinalizer == controller.HasFinalizer(pool, poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer) {
+		return pool, nil
+	}
+
+	newFinalizers := []string{}
+	for _, fin := range pool.Finalizers {
+		if fin != poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer {
+			newFinalizers = append(newFinalizers, fin)
+		}
+	}
+	if needsFinalizer {
+		newFinalizers = append(newFinalizers, poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer)
+	}
+
+	return c.patchFinalizers(pool, newFinalizers)
+}
+
+// finalizeRetainingDataVolumes removes all vms of a deleted pool without their DataVolumes
+// and releases the pool afterwards by removing the DataVolume retention finalizer.
+func (c *PoolController) finalizeRetainingDataVolumes(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) syncError {
+	if activeVMs := filterDeletingVMs(vms); len(activeVMs) > 0 {
+		log.Log.Object(pool).Infof("Removing %d VMs from deleted pool while retaining their DataVolumes", len(activeVMs))
+		if err := c.deleteVMs(pool, activeVMs, true); err != nil {
+			return &syncErrorImpl{fmt.Errorf("Error during removal of vms: %v", err), FailedDeleteVirtualMachineReason}
+		}
+		return nil
+	}
+
+	if len(vms) > 0 {
+		// wait until all vms are gone
+		return nil
+	}
+
+	newFinalizers := []string{}
+	for _, fin := range pool.Finalizers {
+		if fin != poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer {
+			newFinalizers = append(newFinalizers, fin)
+		}
+	}
+	if _, err := c.patchFinalizers(pool, newFinalizers); err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error removing the DataVolume retention finalizer: %v", err), FailedFinalizerReason}
+	}
+	return nil
+}
+
+func (c *PoolController) patchFinalizers(pool *poolv1.VirtualMachinePool, finalizers []string) (*poolv1.VirtualMachinePool, error) {
+	patchBytes, err := patch.New(
+		patch.WithTest("/metadata/finalizers", pool.Finalizers),
+		patch.WithReplace("/metadata/finalizers", finalizers)).
+		GeneratePayload()
+	if err != nil {
+		return pool, err
+	}
+
+	return c.clientset.VirtualMachinePool(pool.Namespace).Patch(context.Background(), pool.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+}
+
+func isRollingUpdate(pool *poolv1.VirtualMachinePool) bool {
+	return pool.Spec.UpdateStrategy != nil &&
+		pool.Spec.UpdateStrategy.Type == poolv1.VirtualMachinePoolRollingUpdateStrategyType
+}
+
+func desiredReplicas(pool *poolv1.VirtualMachinePool) int {
+	if pool.Spec.Replicas != nil {
+		return int(*pool.Spec.Replicas)
+	}
+	return 1
+}
+
+// rollingUpdateLimits resolves maxUnavailable and maxSurge of the rolling update
+// against the desired replicas. Like for Deployments, at least one VM may be
+// unavailable if both limits resolve to zero, to ensure progress.
+func rollingUpdateLimits(pool *poolv1.VirtualMachinePool) (maxUnavailable int, maxSurge int, err error) {
+	rollingUpdate := pool.Spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate == nil {
+		rollingUpdate = &poolv1.VirtualMachinePoolRollingUpdate{}
+	}
+	replicas := desiredReplicas(pool)
+
+	maxSurge, err = intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(rollingUpdate.MaxSurge, intstr.FromInt(0)), replicas, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(rollingUpdate.MaxUnavailable, intstr.FromInt(1)), replicas, false)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if maxSurge == 0 && maxUnavailable == 0 {
+		maxUnavailable = 1
+	}
+	return maxUnavailable, maxSurge, nil
+}
+
+func rollingUpdatePartition(pool *poolv1.VirtualMachinePool) int {
+	rollingUpdate := pool.Spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.Partition == nil {
+		return 0
+	}
+	return int(*rollingUpdate.Partition)
+}
+
+// isInUpdatePartition returns true if the VM index is at or above the rolling update partition.
+func isInUpdatePartition(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	index, err := indexFromName(vm.Name)
+	if err != nil {
+		return false
+	}
+	return index >= rollingUpdatePartition(pool)
+}
+
+var failedVMStatuses = map[virtv1.VirtualMachinePrintableStatus]bool{
+	virtv1.VirtualMachineStatusCrashLoopBackOff: true,
+	virtv1.VirtualMachineStatusUnschedulable:    true,
+	virtv1.VirtualMachineStatusErrImagePull:     true,
+	virtv1.VirtualMachineStatusImagePullBackOff: true,
+	virtv1.VirtualMachineStatusPvcNotFound:      true,
+	virtv1.VirtualMachineStatusDataVolumeError:  true,
+}
+
+// isUpdateHalted returns true if the rolling update has to pause because an
+// already updated VM failed.
+func (c *PoolController) isUpdateHalted(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) bool {
+	if !isRollingUpdate(pool) || pool.Spec.UpdateStrategy.RollingUpdate == nil || !pool.Spec.UpdateStrategy.RollingUpdate.PauseOnFailure {
+		return false
+	}
+	for _, vm := range filterDeletingVMs(vms) {
+		if failedVMStatuses[vm.Status.PrintableStatus] && c.isUpdatedVM(pool, vm) {
+			return true
+		}
+	}
+	return false
+}
+
+// surgeReplicas returns how many VMs may exist on top of the desired replicas
+// while a rolling update with maxSurge is in progress.
+func (c *PoolController) surgeReplicas(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	if !isRollingUpdate(pool) {
+		return 0
+	}
+	_, maxSurge, err := rollingUpdateLimits(pool)
+	if err != nil || maxSurge == 0 || c.isUpdateHalted(pool, vms) {
+		return 0
+	}
+
+	outdated := 0
+	for _, vm := range filterDeletingVMs(vms) {
+		if isInUpdatePartition(pool, vm) && !c.isUpdatedVM(pool, vm) {
+			outdated++
+		}
+	}
+	return min(maxSurge, outdated)
 }
 
 func (c *PoolController) pruneUnusedRevisions(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) syncError {
@@ -1131,6 +1571,10 @@ func (c *PoolController) pruneUnusedRevisions(pool *poolv1.VirtualMachinePool, v
 }
 
 func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (syncError, bool) {
+	if isRollingUpdate(pool) {
+		return c.rollingUpdate(pool, vms)
+	}
+
 	// List of VMs that need to be updated
 	vmOutdatedList := []*virtv1.VirtualMachine{}
 	// List of VMs that are up-to-date that need to be checked to see if VMI is up-to-date
@@ -1167,6 +1611,102 @@ func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.V
 	return nil, vmUpdateStable
 }
 
+// rollingUpdate updates outdated VMs while keeping the number of unavailable
+// VMs within maxUnavailable. VM specs are updated right away since that does
+// not disrupt running VMIs, while outdated VMIs get restarted one budget slot
+// at a time. When VMs got surged, outdated VMs are deleted instead of restarted.
+func (c *PoolController) rollingUpdate(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (syncError, bool) {
+	if c.isUpdateHalted(pool, vms) {
+		log.Log.Object(pool).Info("Rolling update is paused due to failed updated VMs")
+		return nil, false
+	}
+
+	maxUnavailable, _, err := rollingUpdateLimits(pool)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error while resolving rolling update limits: %v", err), FailedUpdateReason}, false
+	}
+
+	activeVMs := filterDeletingVMs(vms)
+
+	vmOutdatedList := []*virtv1.VirtualMachine{}
+	vmUpdatedList := []*virtv1.VirtualMachine{}
+	for _, vm := range activeVMs {
+		if !isInUpdatePartition(pool, vm) {
+			continue
+		}
+		outdated, err := c.isOutdatedVM(pool, vm)
+		if err != nil {
+			return &syncErrorImpl{fmt.Errorf("Error while detected outdated VMs: %v", err), FailedUpdateReason}, false
+		}
+
+		if outdated {
+			vmOutdatedList = append(vmOutdatedList, vm)
+		} else {
+			vmUpdatedList = append(vmUpdatedList, vm)
+		}
+	}
+
+	err = c.opportunisticUpdate(pool, vmOutdatedList)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason}, false
+	}
+
+	vmiUpdates, err := c.outdatedVMIs(vmUpdatedList)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason}, false
+	}
+
+	// Restarting VMIs of VMs which are not ready does not reduce availability,
+	// so handle them first. Otherwise follow the VM index from the top.
+	sort.SliceStable(vmiUpdates, func(i, j int) bool {
+		iReady, jReady := c.isReadyVM(vmiUpdates[i].vm), c.isReadyVM(vmiUpdates[j].vm)
+		if iReady != jReady {
+			return !iReady
+		}
+		iIndex, _ := indexFromName(vmiUpdates[i].vm.Name)
+		jIndex, _ := indexFromName(vmiUpdates[j].vm.Name)
+		return iIndex > jIndex
+	})
+
+	budget := len(c.filterReadyVMs(activeVMs)) - (desiredReplicas(pool) - maxUnavailable)
+	surplus := len(activeVMs) - desiredReplicas(pool)
+
+	var vmiUpdateList []vmiUpdate
+	var deleteList []*virtv1.VirtualMachine
+	for _, update := range vmiUpdates {
+		if update.updateType == proactiveUpdateTypeRestart {
+			if c.isReadyVM(update.vm) {
+				if budget <= 0 {
+					continue
+				}
+				budget--
+			}
+			if surplus > 0 {
+				// replacements got surged already, remove the outdated VM instead of restarting it
+				surplus--
+				deleteList = append(deleteList, update.vm)
+				continue
+			}
+		}
+		vmiUpdateList = append(vmiUpdateList, update)
+	}
+
+	err = c.updateVMIs(pool, vmiUpdateList)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason}, false
+	}
+
+	if len(deleteList) > 0 {
+		log.Log.Object(pool).Infof("Removing %d outdated VMs from pool", len(deleteList))
+		err = c.deleteVMs(pool, deleteList, false)
+		if err != nil {
+			return &syncErrorImpl{fmt.Errorf("Error during VM replacement: %v", err), FailedUpdateReason}, false
+		}
+	}
+
+	return nil, len(vmOutdatedList) == 0 && len(vmiUpdates) == 0
+}
+
 // Execute runs commands from the controller queue, if there is
 // an error it requeues the command. Returns false if the queue
 // is empty.
@@ -1241,8 +1781,31 @@ func (c *PoolController) updateStatus(origPool *poolv1.VirtualMachinePool, vms [
 		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulResumePoolReason, "Pool is unpaused")
 	}
 
+	updateHalted := c.isUpdateHalted(pool, vms)
+	if updateHalted && !cm.HasCondition(pool, poolv1.VirtualMachinePoolUpdatePaused) {
+		cm.UpdateCondition(pool,
+			&poolv1.VirtualMachinePoolCondition{
+				Type:               poolv1.VirtualMachinePoolUpdatePaused,
+				Reason:             FailedUpdatedVirtualMachineReason,
+				Message:            "Rolling update is paused because updated VMs failed",
+				LastTransitionTime: metav1.Now(),
+				Status:             k8score.ConditionTrue,
+			})
+		c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdatedVirtualMachineReason, "Rolling update is paused")
+	} else if !updateHalted && cm.HasCondition(pool, poolv1.VirtualMachinePoolUpdatePaused) {
+		cm.RemoveCondition(pool, poolv1.VirtualMachinePoolUpdatePaused)
+	}
+
+	activeVMs := filterDeletingVMs(vms)
+	updatedVMs := filterVMs(activeVMs, func(vm *virtv1.VirtualMachine) bool {
+		return c.isUpdatedVM(pool, vm)
+	})
+
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	pool.Status.AvailableReplicas = int32(len(c.filterReadyVMs(activeVMs)))
+	pool.Status.UpdatedReplicas = int32(len(updatedVMs))
+	pool.Status.OutdatedReplicas = int32(len(activeVMs) - len(updatedVMs))
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		err := c.statusUpdater.UpdateStatus(pool)
@@ -1313,6 +1876,11 @@ func (c *PoolController) execute(key string) error {
 		scaleIsStable := false
 		updateIsStable := false
 
+		pool, err = c.syncRetentionFinalizer(pool)
+		if err != nil {
+			return err
+		}
+
 		syncErr, scaleIsStable = c.scale(pool, vms)
 		if syncErr != nil {
 			logger.Reason(err).Error("Scaling the pool failed.")
@@ -1332,6 +1900,9 @@ func (c *PoolController) execute(key string) error {
 		virtControllerPoolWorkQueueTracer.StepTrace(key, "sync", trace.Field{Key: "VMPool Name", Value: pool.Name})
 	} else if pool.DeletionTimestamp != nil {
 		syncErr = c.pruneUnusedRevisions(pool, vms)
+		if needsSync && syncErr == nil && controller.HasFinalizer(pool, poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer) {
+			syncErr = c.finalizeRetainingDataVolumes(pool, vms)
+		}
 	}
 
 	err = c.updateStatus(pool, vms, syncErr)
@@ -19,6 +19,7 @@
 package watch
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"maps"
@@ -32,6 +33,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -82,6 +84,42 @@ var _ = Describe("Pool", func() {
 			2),
 	)
 
+	It("should replace the index placeholder in cloud-init data", func() {
+		spec := &v1.VirtualMachineSpec{
+			Template: &v1.VirtualMachineInstanceTemplateSpec{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Volumes: []v1.Volume{
+						{
+							Name: "nocloud",
+							VolumeSource: v1.VolumeSource{
+								CloudInitNoCloud: &v1.CloudInitNoCloudSource{
+									UserData:    "#cloud-config\nhostname: member-{{ .Index }}\n",
+									NetworkData: "address: 10.0.0.{{.Index}}",
+								},
+							},
+						},
+						{
+							Name: "configdrive",
+							VolumeSource: v1.VolumeSource{
+								CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{
+									UserData:          "{{ .Index }}-{{ .Other }}",
+									NetworkDataBase64: base64.StdEncoding.EncodeToString([]byte("address: 10.0.0.{{ .Index }}")),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		spec = indexVMSpec(spec, 3)
+
+		Expect(spec.Template.Spec.Volumes[0].CloudInitNoCloud.UserData).To(Equal("#cloud-config\nhostname: member-3\n"))
+		Expect(spec.Template.Spec.Volumes[0].CloudInitNoCloud.NetworkData).To(Equal("address: 10.0.0.3"))
+		Expect(spec.Template.Spec.Volumes[1].CloudInitConfigDrive.UserData).To(Equal("3-{{ .Other }}"))
+		Expect(spec.Template.Spec.Volumes[1].CloudInitConfigDrive.NetworkDataBase64).To(Equal(base64.StdEncoding.EncodeToString([]byte("address: 10.0.0.3"))))
+	})
+
 	Context("One valid Pool controller given", func() {
 
 		const (
@@ -99,6 +137,7 @@ var _ = Describe("Pool", func() {
 		var vmiInformer cache.SharedIndexInformer
 		var vmInformer cache.SharedIndexInformer
 		var poolInformer cache.SharedIndexInformer
+		var nodeInformer cache.SharedIndexInformer
 		var stop chan struct{}
 		var controller *PoolController
 		var recorder *record.FakeRecorder
@@ -111,7 +150,8 @@ var _ = Describe("Pool", func() {
 			go vmInformer.Run(stop)
 			go poolInformer.Run(stop)
 			go crInformer.Run(stop)
-			Expect(cache.WaitForCacheSync(stop, vmiInformer.HasSynced, vmInformer.HasSynced, poolInformer.HasSynced, crInformer.HasSynced)).To(BeTrue())
+			go nodeInformer.Run(stop)
+			Expect(cache.WaitForCacheSync(stop, vmiInformer.HasSynced, vmInformer.HasSynced, poolInformer.HasSynced, crInformer.HasSynced, nodeInformer.HasSynced)).To(BeTrue())
 		}
 
 		addCR := func(cr *appsv1.ControllerRevision) {
@@ -146,6 +186,7 @@ var _ = Describe("Pool", func() {
 			vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 			vmInformer, vmSource = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 			poolInformer, poolSource = testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+			nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
 
@@ -166,6 +207,7 @@ var _ = Describe("Pool", func() {
 				vmInformer,
 				poolInformer,
 				crInformer,
+				nodeInformer,
 				recorder,
 				uint(10))
 			// Wrap our workqueue to have a way to detect when we are done processing updates
@@ -281,6 +323,8 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.AvailableReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			poolRevision := createPoolRevision(pool)
 
 			pool.Generation = 123
@@ -338,6 +382,8 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.AvailableReplicas = 1
+			pool.Status.OutdatedReplicas = 1
 
 			oldPoolRevision := createPoolRevision(pool)
 
@@ -394,6 +440,8 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.AvailableReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -414,6 +462,8 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.AvailableReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -644,6 +694,309 @@ var _ = Describe("Pool", func() {
 			Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachines")).To(HaveLen(5))
 		})
 
+		Context("on scale in", func() {
+			addScaleInPool := func(pool *poolv1.VirtualMachinePool, vms []*v1.VirtualMachine) *[]string {
+				addPool(pool)
+				for _, vm := range vms {
+					addVM(vm)
+				}
+
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					return true, update.GetObject(), nil
+				})
+
+				var deleted []string
+				client.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					deleted = append(deleted, action.(k8stesting.DeleteAction).GetName())
+					return true, nil, nil
+				})
+				return &deleted
+			}
+
+			newScaleInVMs := func(pool *poolv1.VirtualMachinePool, vm *v1.VirtualMachine, count int) []*v1.VirtualMachine {
+				var vms []*v1.VirtualMachine
+				for x := 0; x < count; x++ {
+					newVM := vm.DeepCopy()
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+					newVM.CreationTimestamp = metav1.NewTime(time.Unix(int64(1000+x), 0))
+					vms = append(vms, newVM)
+				}
+				return vms
+			}
+
+			It("should delete VMs with the lowest deletion cost first", func() {
+				pool, vm := DefaultPool(1)
+				vms := newScaleInVMs(pool, vm, 3)
+				vms[0].Annotations[poolv1.VirtualMachinePoolDeletionCostAnnotation] = "10"
+				vms[1].Annotations[poolv1.VirtualMachinePoolDeletionCostAnnotation] = "-5"
+				vms[2].Annotations[poolv1.VirtualMachinePoolDeletionCostAnnotation] = "invalid"
+				deleted := addScaleInPool(pool, vms)
+
+				controller.Execute()
+
+				Expect(*deleted).To(ConsistOf(vms[1].Name, vms[2].Name))
+			})
+
+			DescribeTable("should delete VMs according to the scale in policy", func(policy poolv1.VirtualMachinePoolScaleInPolicy, expected int) {
+				pool, vm := DefaultPool(2)
+				pool.Spec.ScaleInPolicy = policy
+				vms := newScaleInVMs(pool, vm, 3)
+				markVmAsReady(vms[0])
+				markVmAsReady(vms[2])
+				deleted := addScaleInPool(pool, vms)
+
+				controller.Execute()
+
+				Expect(*deleted).To(ConsistOf(vms[expected].Name))
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			},
+				Entry("Newest", poolv1.VirtualMachinePoolNewestScaleInPolicy, 2),
+				Entry("Oldest", poolv1.VirtualMachinePoolOldestScaleInPolicy, 0),
+				Entry("NotReady", poolv1.VirtualMachinePoolNotReadyScaleInPolicy, 1),
+			)
+
+			It("should prefer the deletion cost over the scale in policy", func() {
+				pool, vm := DefaultPool(2)
+				pool.Spec.ScaleInPolicy = poolv1.VirtualMachinePoolNewestScaleInPolicy
+				vms := newScaleInVMs(pool, vm, 3)
+				vms[0].Annotations[poolv1.VirtualMachinePoolDeletionCostAnnotation] = "-1"
+				deleted := addScaleInPool(pool, vms)
+
+				controller.Execute()
+
+				Expect(*deleted).To(ConsistOf(vms[0].Name))
+			})
+
+			It("should delete VMs from the most crowded zone and node with the NodeSpread policy", func() {
+				pool, vm := DefaultPool(3)
+				pool.Spec.ScaleInPolicy = poolv1.VirtualMachinePoolNodeSpreadScaleInPolicy
+				vms := newScaleInVMs(pool, vm, 5)
+
+				for node, zone := range map[string]string{"node1": "zone-a", "node2": "zone-a", "node3": "zone-b"} {
+					Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   node,
+							Labels: map[string]string{k8sv1.LabelTopologyZone: zone},
+						},
+					})).To(Succeed())
+				}
+				// zone-a hosts three VMs, two of them on node1, zone-b hosts one, the last VM is stopped
+				for i, node := range []string{"node1", "node1", "node2", "node3"} {
+					vmi := api.NewMinimalVMI(vms[i].Name)
+					vmi.Namespace = vms[i].Namespace
+					vmi.Status.NodeName = node
+					addVMI(vmi, false)
+				}
+				deleted := addScaleInPool(pool, vms)
+
+				controller.Execute()
+
+				Expect(*deleted).To(HaveLen(2))
+				Expect(*deleted).To(ContainElement(vms[4].Name))
+				Expect(*deleted).To(ContainElement(BeElementOf(vms[0].Name, vms[1].Name)))
+			})
+		})
+
+		Context("with stateful policy", func() {
+			newStatefulPool := func(replicas int32, policy *poolv1.VirtualMachinePoolStatefulPolicy) (*poolv1.VirtualMachinePool, *v1.VirtualMachine) {
+				pool, vm := DefaultPool(replicas)
+				pool.Spec.StatefulPolicy = policy
+				return pool, vm
+			}
+
+			expectFinalizerPatch := func(pool *poolv1.VirtualMachinePool) *[]string {
+				var patches []string
+				client.Fake.PrependReactor("patch", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					patchAction, ok := action.(k8stesting.PatchAction)
+					Expect(ok).To(BeTrue())
+					patches = append(patches, string(patchAction.GetPatch()))
+					return true, pool.DeepCopy(), nil
+				})
+				return &patches
+			}
+
+			expectStatusUpdate := func() {
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					return true, update.GetObject(), nil
+				})
+			}
+
+			It("should create only the VM with the lowest missing index when ordered", func() {
+				pool, _ := newStatefulPool(3, &poolv1.VirtualMachinePoolStatefulPolicy{})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				addPool(pool)
+
+				expectControllerRevisionCreation(createPoolRevision(pool))
+				expectVMCreation(Equal(fmt.Sprintf("%s-0", pool.Name)))
+				expectStatusUpdate()
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&client.Fake, "create", "virtualmachines")).To(HaveLen(1))
+			})
+
+			It("should not create further VMs while a VM is not ready when ordered", func() {
+				pool, vm := newStatefulPool(3, &poolv1.VirtualMachinePoolStatefulPolicy{})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				vm.Name = fmt.Sprintf("%s-0", pool.Name)
+				addPool(pool)
+				addVM(vm)
+				expectStatusUpdate()
+
+				controller.Execute()
+
+				Expect(testing.FilterActions(&client.Fake, "create", "virtualmachines")).To(BeEmpty())
+			})
+
+			It("should not be blocked by a stopped VM when ordered", func() {
+				pool, vm := newStatefulPool(3, &poolv1.VirtualMachinePoolStatefulPolicy{})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				vm.Name = fmt.Sprintf("%s-0", pool.Name)
+				vm.Spec.Running = nil
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyHalted)
+				addPool(pool)
+				addVM(vm)
+
+				expectControllerRevisionCreation(createPoolRevision(pool))
+				expectVMCreation(Equal(fmt.Sprintf("%s-1", pool.Name)))
+				expectStatusUpdate()
+
+				controller.Execute()
+
+				Expect(testing.FilterActions(&client.Fake, "create", "virtualmachines")).To(HaveLen(1))
+			})
+
+			It("should create all missing VMs at once with the parallel management policy", func() {
+				pool, _ := newStatefulPool(3, &poolv1.VirtualMachinePoolStatefulPolicy{
+					ManagementPolicy: poolv1.VirtualMachinePoolParallelManagementPolicy,
+				})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				addPool(pool)
+
+				expectControllerRevisionCreation(createPoolRevision(pool))
+				expectVMCreation(HavePrefix(fmt.Sprintf("%s-", pool.Name)))
+				expectStatusUpdate()
+
+				controller.Execute()
+
+				Expect(testing.FilterActions(&client.Fake, "create", "virtualmachines")).To(HaveLen(3))
+			})
+
+			It("should add the retention finalizer when DataVolumes are retained on deletion", func() {
+				pool, _ := newStatefulPool(0, &poolv1.VirtualMachinePoolStatefulPolicy{})
+				addPool(pool)
+				patches := expectFinalizerPatch(pool)
+				expectStatusUpdate()
+
+				controller.Execute()
+
+				Expect(*patches).To(HaveLen(1))
+				Expect((*patches)[0]).To(ContainSubstring(poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer))
+			})
+
+			It("should remove the retention finalizer when DataVolumes are deleted with the pool", func() {
+				pool, _ := newStatefulPool(0, &poolv1.VirtualMachinePoolStatefulPolicy{
+					DataVolumeRetentionPolicy: &poolv1.VirtualMachinePoolDataVolumeRetentionPolicy{
+						WhenDeleted: poolv1.VirtualMachinePoolDeleteDataVolumeRetentionPolicy,
+					},
+				})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				addPool(pool)
+				patches := expectFinalizerPatch(pool)
+				expectStatusUpdate()
+
+				controller.Execute()
+
+				Expect(*patches).To(HaveLen(1))
+				Expect((*patches)[0]).To(ContainSubstring(`{"op":"replace","path":"/metadata/finalizers","value":[]}`))
+			})
+
+			DescribeTable("should remove the VM with the highest index on scale in", func(whenScaled poolv1.VirtualMachinePoolDataVolumeRetentionPolicyType, expectOrphanedVMIDeletion bool) {
+				pool, vm := newStatefulPool(2, &poolv1.VirtualMachinePoolStatefulPolicy{
+					DataVolumeRetentionPolicy: &poolv1.VirtualMachinePoolDataVolumeRetentionPolicy{
+						WhenScaled: whenScaled,
+					},
+				})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				addPool(pool)
+				for x := 0; x < 3; x++ {
+					newVM := vm.DeepCopy()
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+					markVmAsReady(newVM)
+					addVM(newVM)
+				}
+				expectStatusUpdate()
+
+				client.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.(k8stesting.DeleteAction).GetName()).To(Equal(fmt.Sprintf("%s-2", pool.Name)))
+					return true, nil, nil
+				})
+				client.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.(k8stesting.DeleteAction).GetName()).To(Equal(fmt.Sprintf("%s-2", pool.Name)))
+					return true, nil, nil
+				})
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+				Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachines")).To(HaveLen(1))
+				// orphaning the DataVolumes also orphans the VMI, which is therefore deleted explicitly
+				if expectOrphanedVMIDeletion {
+					Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachineinstances")).To(HaveLen(1))
+				} else {
+					Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				}
+			},
+				Entry("and orphan its DataVolumes when they are retained", poolv1.VirtualMachinePoolRetainDataVolumeRetentionPolicy, true),
+				Entry("and delete its DataVolumes when they are not retained", poolv1.VirtualMachinePoolDeleteDataVolumeRetentionPolicy, false),
+			)
+
+			It("should remove the VMs of a deleted pool without their DataVolumes", func() {
+				pool, vm := newStatefulPool(2, &poolv1.VirtualMachinePoolStatefulPolicy{})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				pool.DeletionTimestamp = pointer.P(metav1.Now())
+				addPool(pool)
+				for x := 0; x < 2; x++ {
+					newVM := vm.DeepCopy()
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+					addVM(newVM)
+				}
+				expectStatusUpdate()
+
+				client.Fake.PrependReactor("delete", "virtualmachines", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+				client.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, nil
+				})
+
+				controller.Execute()
+
+				Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachines")).To(HaveLen(2))
+				Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachineinstances")).To(HaveLen(2))
+				Expect(testing.FilterActions(&client.Fake, "patch", "virtualmachinepools")).To(BeEmpty())
+			})
+
+			It("should remove the retention finalizer once all VMs of a deleted pool are gone", func() {
+				pool, _ := newStatefulPool(2, &poolv1.VirtualMachinePoolStatefulPolicy{})
+				pool.Finalizers = []string{poolv1.VirtualMachinePoolDataVolumeRetentionFinalizer}
+				pool.DeletionTimestamp = pointer.P(metav1.Now())
+				addPool(pool)
+				patches := expectFinalizerPatch(pool)
+				expectStatusUpdate()
+
+				controller.Execute()
+
+				Expect(*patches).To(HaveLen(1))
+				Expect((*patches)[0]).To(ContainSubstring(`{"op":"replace","path":"/metadata/finalizers","value":[]}`))
+			})
+		})
+
 		It("should ignore and skip the name for non-matching VMs", func() {
 
 			pool, vm := DefaultPool(3)
@@ -700,6 +1053,156 @@ var _ = Describe("Pool", func() {
 
 		})
 
+		Context("with rolling update strategy", func() {
+			var oldPoolRevision *appsv1.ControllerRevision
+			var newPoolRevision *appsv1.ControllerRevision
+
+			// newRollingPool returns a pool whose VMs already run the new revision,
+			// while their VMIs still run the old VMI template.
+			newRollingPool := func(replicas int32, rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) (*poolv1.VirtualMachinePool, []*v1.VirtualMachine, []*v1.VirtualMachineInstance) {
+				pool, vmTemplate := DefaultPool(replicas)
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type:          poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+					RollingUpdate: rollingUpdate,
+				}
+				oldPoolRevision = createPoolRevision(pool)
+
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				newPoolRevision = createPoolRevision(pool)
+
+				var vms []*v1.VirtualMachine
+				var vmis []*v1.VirtualMachineInstance
+				for i := 0; i < int(replicas); i++ {
+					vm := vmTemplate.DeepCopy()
+					vm.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					vm.Spec = *pool.Spec.VirtualMachineTemplate.Spec.DeepCopy()
+					vm = injectPoolRevisionLabelsIntoVM(vm, newPoolRevision.Name)
+					markVmAsReady(vm)
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Namespace = vm.Namespace
+					vmi.Labels = map[string]string{v1.VirtualMachinePoolRevisionName: oldPoolRevision.Name}
+					vmi.OwnerReferences = []metav1.OwnerReference{{
+						APIVersion:         v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+						Kind:               v1.VirtualMachineGroupVersionKind.Kind,
+						Name:               vm.ObjectMeta.Name,
+						UID:                vm.ObjectMeta.UID,
+						Controller:         &t,
+						BlockOwnerDeletion: &t,
+					}}
+					vms = append(vms, vm)
+					vmis = append(vmis, vmi)
+				}
+				return pool, vms, vmis
+			}
+
+			addRollingPool := func(pool *poolv1.VirtualMachinePool, vms []*v1.VirtualMachine, vmis []*v1.VirtualMachineInstance) {
+				addPool(pool)
+				for i := range vms {
+					addVM(vms[i])
+					// VMIs only cause an enqueue of the pool when they run another revision than their VM
+					addVMI(vmis[i], vmis[i].Labels[v1.VirtualMachinePoolRevisionName] != vms[i].Labels[v1.VirtualMachinePoolRevisionName])
+				}
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+
+				expectControllerRevisionCreation(newPoolRevision)
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(k8stesting.UpdateAction)
+					Expect(ok).To(BeTrue())
+					return true, update.GetObject(), nil
+				})
+			}
+
+			expectVMIDeletions := func() *[]string {
+				var deleted []string
+				client.Fake.PrependReactor("delete", "virtualmachineinstances", func(action k8stesting.Action) (handled bool, obj runtime.Object, err error) {
+					deleted = append(deleted, action.(k8stesting.DeleteAction).GetName())
+					return true, nil, nil
+				})
+				return &deleted
+			}
+
+			It("should restart at most maxUnavailable VMIs, starting with the highest index", func() {
+				pool, vms, vmis := newRollingPool(3, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt(1)),
+				})
+				addRollingPool(pool, vms, vmis)
+				deleted := expectVMIDeletions()
+
+				controller.Execute()
+
+				Expect(*deleted).To(ConsistOf(fmt.Sprintf("%s-2", pool.Name)))
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should restart VMIs of not ready VMs first", func() {
+				pool, vms, vmis := newRollingPool(3, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt(1)),
+				})
+				vms[0].Status.Conditions = nil
+				addRollingPool(pool, vms, vmis)
+				deleted := expectVMIDeletions()
+
+				controller.Execute()
+
+				Expect(*deleted).To(ConsistOf(fmt.Sprintf("%s-0", pool.Name)))
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should not update VMs below the partition", func() {
+				pool, vms, vmis := newRollingPool(3, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromString("100%")),
+					Partition:      pointer.P(int32(2)),
+				})
+				addRollingPool(pool, vms, vmis)
+				deleted := expectVMIDeletions()
+
+				controller.Execute()
+
+				Expect(*deleted).To(ConsistOf(fmt.Sprintf("%s-2", pool.Name)))
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should create surge VMs before removing outdated ones", func() {
+				pool, vms, vmis := newRollingPool(3, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt(0)),
+					MaxSurge:       pointer.P(intstr.FromInt(1)),
+				})
+				addRollingPool(pool, vms, vmis)
+				expectVMCreation(Equal(fmt.Sprintf("%s-3", pool.Name)))
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+				Expect(testing.FilterActions(&client.Fake, "create", "virtualmachines")).To(HaveLen(1))
+				Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+			})
+
+			It("should pause the update and add the paused condition when an updated VM failed", func() {
+				pool, vms, vmis := newRollingPool(3, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: pointer.P(intstr.FromInt(1)),
+					PauseOnFailure: true,
+				})
+				vmis[0].Labels[v1.VirtualMachinePoolRevisionName] = newPoolRevision.Name
+				vms[0].Status.PrintableStatus = v1.VirtualMachineStatusCrashLoopBackOff
+				addRollingPool(pool, vms, vmis)
+
+				controller.Execute()
+
+				Expect(testing.FilterActions(&client.Fake, "delete", "virtualmachineinstances")).To(BeEmpty())
+				testutils.ExpectEvent(recorder, FailedUpdatedVirtualMachineReason)
+
+				updates := testing.FilterActions(&client.Fake, "update", "virtualmachinepools")
+				Expect(updates).To(HaveLen(1))
+				updatedPool := updates[0].(k8stesting.UpdateAction).GetObject().(*poolv1.VirtualMachinePool)
+				Expect(updatedPool.Status.Conditions).To(ContainElement(HaveField("Type", poolv1.VirtualMachinePoolUpdatePaused)))
+				Expect(updatedPool.Status.UpdatedReplicas).To(Equal(int32(1)))
+				Expect(updatedPool.Status.OutdatedReplicas).To(Equal(int32(2)))
+			})
+		})
+
 		It("should detect a VM is detached, then release and replace it", func() {
 			pool, vm := DefaultPool(3)
 			vm.Labels = map[string]string{}
@@ -3035,6 +3035,10 @@ func (c *VMController) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.Virtual
 
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
+
+		if c.clusterConfig.HotplugNetworkInterfacesEnabled() {
+			liveUpdateInterfaces(lastSeenVM.Spec.Template.Spec.Domain.Devices.Interfaces, currentVM.Spec.Template.Spec.Domain.Devices.Interfaces)
+		}
 	}
 
 	if !equality.Semantic.DeepEqual(lastSeenVM.Spec.Template.Spec, currentVM.Spec.Template.Spec) {
@@ -3045,6 +3049,18 @@ func (c *VMController) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.Virtual
 	return false
 }
 
+// liveUpdateInterfaces copies the state and the bandwidth, which are applied on running VMs,
+// from the current interfaces to the last seen interfaces of the same name
+func liveUpdateInterfaces(lastSeenIfaces, currentIfaces []virtv1.Interface) {
+	currentIfacesByName := vmispec.IndexInterfaceSpecByName(currentIfaces)
+	for i := range lastSeenIfaces {
+		if currentIface, exists := currentIfacesByName[lastSeenIfaces[i].Name]; exists {
+			lastSeenIfaces[i].State = currentIface.State
+			lastSeenIfaces[i].Bandwidth = currentIface.Bandwidth.DeepCopy()
+		}
+	}
+}
+
 func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, key string, dataVolumes []*cdiv1.DataVolume) (*virtv1.VirtualMachine, syncError, error) {
 
 	defer virtControllerVMWorkQueueTracer.StepTrace(key, "sync", trace.Field{Key: "VM Name", Value: vm.Name})
@@ -6590,6 +6590,48 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(vm.Status.Conditions).To(restartRequiredMatcher(k8sv1.ConditionTrue), "restart required")
 			})
 
+			DescribeTable("when changing an interface", func(featureGates []string, updateIface func(*v1.Interface), expectRestartRequired bool) {
+				kv.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+				vm.Spec.Template.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+				lastSeenVMSpec := vm.Spec.DeepCopy()
+
+				updateIface(&vm.Spec.Template.Spec.Domain.Devices.Interfaces[0])
+
+				Expect(controller.addRestartRequiredIfNeeded(lastSeenVMSpec, vm)).To(Equal(expectRestartRequired))
+			},
+				Entry("should not appear for the bandwidth",
+					[]string{virtconfig.VMLiveUpdateFeaturesGate, virtconfig.HotplugNetworkIfacesGate},
+					func(iface *v1.Interface) {
+						iface.Bandwidth = &v1.InterfaceBandwidth{
+							Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("10Mi")},
+						}
+					},
+					false,
+				),
+				Entry("should not appear for the state",
+					[]string{virtconfig.VMLiveUpdateFeaturesGate, virtconfig.HotplugNetworkIfacesGate},
+					func(iface *v1.Interface) { iface.State = v1.InterfaceStateDown },
+					false,
+				),
+				Entry("should appear for the bandwidth without the interface hotplug feature gate",
+					[]string{virtconfig.VMLiveUpdateFeaturesGate},
+					func(iface *v1.Interface) {
+						iface.Bandwidth = &v1.InterfaceBandwidth{
+							Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("10Mi")},
+						}
+					},
+					true,
+				),
+				Entry("should appear for the MAC address",
+					[]string{virtconfig.VMLiveUpdateFeaturesGate, virtconfig.HotplugNetworkIfacesGate},
+					func(iface *v1.Interface) { iface.MacAddress = "02:00:00:00:00:01" },
+					true,
+				),
+			)
+
 			It("should appear when VM doesn't specify maxSockets and sockets go above cluster-wide maxSockets", func() {
 				var maxSockets uint32 = 8
 
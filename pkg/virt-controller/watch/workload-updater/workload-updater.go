@@ -28,6 +28,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
 	"kubevirt.io/kubevirt/pkg/util/status"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -513,7 +514,7 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	now := time.Now()
 
 	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
-	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
+	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 && c.isMaintenanceWindowOpen(now) {
 		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
 		c.lastDeletionBatch = now
 	} else {
@@ -566,7 +567,8 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 					GenerateName: "kubevirt-workload-update-",
 				},
 				Spec: virtv1.VirtualMachineInstanceMigrationSpec{
-					VMIName: vmi.Name,
+					VMIName:  vmi.Name,
+					Priority: pointer.P(virtv1.MigrationPrioritySystemMaintenance),
 				},
 			}, metav1.CreateOptions{})
 			if err != nil {
@@ -643,3 +645,20 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	return nil
 }
+
+// isMaintenanceWindowOpen returns true if outdated VMIs can be evicted, meaning that no cluster-wide
+// maintenance window is configured or that one of them is open. The migrations of outdated VMIs wait
+// for the maintenance windows in the migration controller.
+func (c *WorkloadUpdateController) isMaintenanceWindowOpen(now time.Time) bool {
+	windows := c.clusterConfig.GetMigrationConfiguration().MaintenanceWindows
+	if len(windows) == 0 {
+		return true
+	}
+
+	open, _, err := migrationutils.CheckMaintenanceWindows(windows, now)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to check the maintenance windows, postponing workload update evictions")
+		return false
+	}
+	return open
+}
@@ -126,6 +126,7 @@ var _ = Describe("Workload Updater", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(migrations.Items).To(HaveLen(1))
 			Expect(migrations.Items[0].Spec.VMIName).To(Equal("testvm"))
+			Expect(migrations.Items[0].Spec.Priority).To(HaveValue(Equal(v1.MigrationPrioritySystemMaintenance)))
 		})
 
 		It("should do nothing if deployment is updating", func() {
@@ -390,6 +391,52 @@ var _ = Describe("Workload Updater", func() {
 			Expect(migrations.Items).To(BeEmpty())
 		})
 
+		DescribeTable("should respect the maintenance windows when shutting down VMIs", func(windowOpen bool) {
+			const desiredNumberOfVMs = 5
+			windowStart := time.Now().Add(3 * time.Hour)
+			if windowOpen {
+				windowStart = time.Now().Add(-time.Hour)
+			}
+			controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				MigrationConfiguration: &v1.MigrationConfiguration{
+					MaintenanceWindows: []v1.MaintenanceWindow{{
+						Schedule: fmt.Sprintf("%d %d * * *", windowStart.UTC().Minute(), windowStart.UTC().Hour()),
+						Duration: metav1.Duration{Duration: 2 * time.Hour},
+					}},
+				},
+			})
+
+			for i := 0; i < desiredNumberOfVMs; i++ {
+				vmi := newVirtualMachineInstance(fmt.Sprintf("testvm-%d", i), true, "madeup")
+				pod := newLauncherPodForVMI(vmi)
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(pod)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, desiredNumberOfVMs)
+			kv := newKubeVirt(desiredNumberOfVMs)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodEvict}
+			addKubeVirt(kv)
+
+			evictionCount := 0
+			shouldExpectMultiplePodEvictions(&evictionCount)
+
+			controller.Execute()
+
+			if windowOpen {
+				var reasons []string
+				for i := 0; i < desiredNumberOfVMs; i++ {
+					reasons = append(reasons, SuccessfulEvictVirtualMachineInstanceReason)
+				}
+				testutils.ExpectEvents(recorder, reasons...)
+				Expect(evictionCount).To(Equal(desiredNumberOfVMs))
+			} else {
+				Expect(evictionCount).To(BeZero())
+			}
+		},
+			Entry("by evicting VMIs in a window", true),
+			Entry("by not evicting VMIs outside of the windows", false),
+		)
+
 		It("should not evict VMIs when an active migration is in flight", func() {
 			const desiredNumberOfVMs = 2
 			kv := newKubeVirt(desiredNumberOfVMs)
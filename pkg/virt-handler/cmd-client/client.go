@@ -77,6 +77,7 @@ type MigrationOptions struct {
 	UnsafeMigration          bool
 	AllowAutoConverge        bool
 	AllowPostCopy            bool
+	AllowAdaptiveTuning      bool
 	ParallelMigrationThreads *uint
 }
 
@@ -0,0 +1,161 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+type endpointTargetLookup struct {
+	migrationIndexer cache.Indexer
+	vmiStore         cache.Store
+	clientset        kubecli.KubevirtClient
+	clusterConfig    *virtconfig.ClusterConfig
+}
+
+// NewEndpointTargetLookup returns the lookup of the migration endpoint. It finds the target proxy of a decentralized
+// migration using the VMI of the migration receiving it, which can run on any node of the cluster. The migration
+// indexer has to index the migrations by controller.DecentralizedMigrationIndex, the VMI store has to contain the
+// VMIs which are the target of a migration.
+//
+// Connections are only accepted with the receive token of the migration, as long as it was issued for the current
+// receiving VMI. The first peer presenting the token binds it to its certificate, connections of other peers are
+// rejected afterwards.
+func NewEndpointTargetLookup(migrationIndexer cache.Indexer, vmiStore cache.Store, clientset kubecli.KubevirtClient, clusterConfig *virtconfig.ClusterConfig) EndpointTargetLookup {
+	l := &endpointTargetLookup{
+		migrationIndexer: migrationIndexer,
+		vmiStore:         vmiStore,
+		clientset:        clientset,
+		clusterConfig:    clusterConfig,
+	}
+	return l.lookup
+}
+
+func (l *endpointTargetLookup) lookup(request *EndpointRequest, peer *x509.Certificate) (*EndpointTarget, error) {
+	if !l.clusterConfig.DecentralizedLiveMigrationEnabled() {
+		return nil, fmt.Errorf("the %s feature gate is not enabled", virtconfig.DecentralizedLiveMigrationGate)
+	}
+
+	migration, err := l.findReceivingMigration(request)
+	if err != nil {
+		return nil, err
+	}
+	receiveToken := migration.Status.ReceiveToken
+
+	obj, exists, err := l.vmiStore.GetByKey(controller.NamespacedKey(migration.Namespace, migration.Spec.VMIName))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("the target of migration %s is not ready", request.MigrationID)
+	}
+	vmi := obj.(*v1.VirtualMachineInstance)
+	if vmi.UID != receiveToken.VMIUID {
+		return nil, fmt.Errorf("the token of migration %s was issued for another VMI", request.MigrationID)
+	}
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.MigrationUID != migration.UID || migrationState.TargetNodeAddress == "" {
+		return nil, fmt.Errorf("the target of migration %s is not ready", request.MigrationID)
+	}
+
+	if err := l.bindPeer(migration, peer); err != nil {
+		return nil, err
+	}
+
+	migrationConfiguration := migrationState.MigrationConfiguration
+	if migrationConfiguration == nil {
+		migrationConfiguration = l.clusterConfig.GetMigrationConfiguration()
+	}
+	return &EndpointTarget{
+		Address:        migrationState.TargetNodeAddress,
+		DestSrcPortMap: migrationState.TargetDirectMigrationNodePorts,
+		DisableTLS:     migrationConfiguration.DisableTLS != nil && *migrationConfiguration.DisableTLS,
+	}, nil
+}
+
+// findReceivingMigration returns the migration receiving the requested migration with the token of the request
+func (l *endpointTargetLookup) findReceivingMigration(request *EndpointRequest) (*v1.VirtualMachineInstanceMigration, error) {
+	objs, err := l.migrationIndexer.ByIndex(controller.DecentralizedMigrationIndex, controller.NamespacedKey(request.Namespace, request.MigrationID))
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		migration := obj.(*v1.VirtualMachineInstanceMigration)
+		if !migration.IsDecentralizedTarget() || migration.IsFinal() || migration.Status.ReceiveToken == nil {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(migration.Status.ReceiveToken.Token), []byte(request.Token)) == 1 {
+			return migration, nil
+		}
+	}
+	return nil, fmt.Errorf("no migration in namespace %s is receiving migration %s with the given token", request.Namespace, request.MigrationID)
+}
+
+// bindPeer binds the receive token of the migration to the certificate of the first peer presenting it
+func (l *endpointTargetLookup) bindPeer(migration *v1.VirtualMachineInstanceMigration, peer *x509.Certificate) error {
+	fingerprint := sha256.Sum256(peer.Raw)
+	peerCertificateSHA256 := hex.EncodeToString(fingerprint[:])
+
+	receiveToken := migration.Status.ReceiveToken
+	if receiveToken.PeerCertificateSHA256 != "" {
+		if receiveToken.PeerCertificateSHA256 != peerCertificateSHA256 {
+			return fmt.Errorf("the token of migration %s is bound to another peer", migration.Spec.Receive.MigrationID)
+		}
+		return nil
+	}
+
+	boundToken := receiveToken.DeepCopy()
+	boundToken.PeerCertificateSHA256 = peerCertificateSHA256
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/receiveToken", receiveToken),
+		patch.WithReplace("/status/receiveToken", boundToken),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = l.clientset.VirtualMachineInstanceMigration(migration.Namespace).PatchStatus(context.Background(), migration.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err == nil {
+		return nil
+	}
+
+	// the endpoint of another node may have bound the token to the same peer before the informer caught up
+	current, getErr := l.clientset.VirtualMachineInstanceMigration(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+	if getErr == nil && equality.Semantic.DeepEqual(current.Status.ReceiveToken, boundToken) {
+		return nil
+	}
+	return fmt.Errorf("failed to bind the token of migration %s to the peer: %v", migration.Spec.Receive.MigrationID, err)
+}
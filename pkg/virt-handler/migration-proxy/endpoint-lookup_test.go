@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Migration endpoint lookup", func() {
+	const (
+		migrationID = "my-migration"
+		token       = "0123abcd"
+	)
+
+	var (
+		migrationIndexer cache.Indexer
+		vmiStore         cache.Store
+		virtClientset    *kubevirtfake.Clientset
+		lookup           EndpointTargetLookup
+		migration        *v1.VirtualMachineInstanceMigration
+		vmi              *v1.VirtualMachineInstance
+		peer             *x509.Certificate
+	)
+
+	newLookup := func(featureGates ...string) EndpointTargetLookup {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+		return NewEndpointTargetLookup(migrationIndexer, vmiStore, virtClient, config)
+	}
+
+	addMigration := func(migration *v1.VirtualMachineInstanceMigration) {
+		Expect(migrationIndexer.Add(migration)).To(Succeed())
+		_, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Create(context.Background(), migration, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	fingerprint := func(certificate *x509.Certificate) string {
+		sum := sha256.Sum256(certificate.Raw)
+		return hex.EncodeToString(sum[:])
+	}
+
+	newRequest := func(token string) *EndpointRequest {
+		return &EndpointRequest{Namespace: k8sv1.NamespaceDefault, MigrationID: migrationID, Token: token, Port: LibvirtDirectMigrationPort}
+	}
+
+	BeforeEach(func() {
+		migrationIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{
+			controller.DecentralizedMigrationIndex: func(obj interface{}) ([]string, error) {
+				migration := obj.(*v1.VirtualMachineInstanceMigration)
+				return []string{controller.NamespacedKey(migration.Namespace, migration.Labels[v1.MigrationIDLabel])}, nil
+			},
+		})
+		vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		virtClientset = kubevirtfake.NewSimpleClientset()
+		lookup = newLookup(virtconfig.DecentralizedLiveMigrationGate)
+		peer = &x509.Certificate{Raw: []byte("peer")}
+
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: k8sv1.NamespaceDefault, UID: "vmi-uid"},
+		}
+		migration = &v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testmigration",
+				Namespace: k8sv1.NamespaceDefault,
+				UID:       "migration-uid",
+				Labels:    map[string]string{v1.MigrationIDLabel: migrationID},
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{
+				VMIName: vmi.Name,
+				Receive: &v1.VirtualMachineInstanceMigrationTarget{MigrationID: migrationID},
+			},
+			Status: v1.VirtualMachineInstanceMigrationStatus{
+				Phase:        v1.MigrationScheduled,
+				ReceiveToken: &v1.MigrationReceiveToken{Token: token, VMIUID: vmi.UID},
+			},
+		}
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			MigrationUID:                   migration.UID,
+			TargetNodeAddress:              "10.0.0.1",
+			TargetDirectMigrationNodePorts: map[string]int{"49152": LibvirtDirectMigrationPort},
+		}
+		Expect(vmiStore.Add(vmi)).To(Succeed())
+	})
+
+	It("should return the target proxy and bind the token to the first peer", func() {
+		addMigration(migration)
+
+		target, err := lookup(newRequest(token), peer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(target).To(Equal(&EndpointTarget{Address: "10.0.0.1", DestSrcPortMap: map[string]int{"49152": LibvirtDirectMigrationPort}}))
+
+		updatedMigration, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).Get(context.Background(), migration.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updatedMigration.Status.ReceiveToken.PeerCertificateSHA256).To(Equal(fingerprint(peer)))
+	})
+
+	It("should accept the peer the token is bound to", func() {
+		migration.Status.ReceiveToken.PeerCertificateSHA256 = fingerprint(peer)
+		addMigration(migration)
+
+		_, err := lookup(newRequest(token), peer)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept the peer if another endpoint bound the token to it before the informer caught up", func() {
+		addMigration(migration)
+		boundMigration := migration.DeepCopy()
+		boundMigration.Status.ReceiveToken.PeerCertificateSHA256 = fingerprint(peer)
+		_, err := virtClientset.KubevirtV1().VirtualMachineInstanceMigrations(migration.Namespace).UpdateStatus(context.Background(), boundMigration, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = lookup(newRequest(token), peer)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("should reject the connection", func(prepare func(), request *EndpointRequest, expectedError string) {
+		if prepare != nil {
+			prepare()
+		}
+		addMigration(migration)
+
+		_, err := lookup(request, peer)
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("with the feature gate disabled",
+			func() { lookup = newLookup() }, newRequest(token),
+			"feature gate is not enabled",
+		),
+		Entry("with another token",
+			nil, newRequest("abcd0123"),
+			"no migration in namespace default is receiving migration my-migration",
+		),
+		Entry("in another namespace",
+			nil, &EndpointRequest{Namespace: "other", MigrationID: migrationID, Token: token},
+			"no migration in namespace other is receiving migration my-migration",
+		),
+		Entry("of a sending migration",
+			func() {
+				migration.Spec.Receive = nil
+				migration.Spec.SendTo = &v1.VirtualMachineInstanceMigrationSource{MigrationID: migrationID}
+			}, newRequest(token),
+			"no migration in namespace default is receiving migration my-migration",
+		),
+		Entry("of a final migration",
+			func() { migration.Status.Phase = v1.MigrationFailed }, newRequest(token),
+			"no migration in namespace default is receiving migration my-migration",
+		),
+		Entry("with a token issued for another VMI",
+			func() { migration.Status.ReceiveToken.VMIUID = "old-vmi-uid" }, newRequest(token),
+			"the token of migration my-migration was issued for another VMI",
+		),
+		Entry("before the target is ready",
+			func() { vmi.Status.MigrationState.TargetNodeAddress = "" }, newRequest(token),
+			"the target of migration my-migration is not ready",
+		),
+		Entry("of another peer than the token is bound to",
+			func() { migration.Status.ReceiveToken.PeerCertificateSHA256 = "abcd" }, newRequest(token),
+			"the token of migration my-migration is bound to another peer",
+		),
+	)
+})
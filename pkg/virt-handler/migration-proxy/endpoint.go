@@ -0,0 +1,227 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"kubevirt.io/client-go/log"
+)
+
+// maxEndpointHeaderLength limits the header a source proxy sends on connections to the migration endpoint
+const maxEndpointHeaderLength = 256
+
+// EndpointTarget is the target proxy of a decentralized migration the migration endpoint forwards connections to
+type EndpointTarget struct {
+	// Address is the address of the node running the target proxy
+	Address string
+	// DestSrcPortMap maps the ports of the target proxy to the ports they receive on the source
+	DestSrcPortMap map[string]int
+	// DisableTLS indicates that the target proxy does not use TLS
+	DisableTLS bool
+}
+
+// EndpointRequest is the header source proxies send on connections to the migration endpoint
+type EndpointRequest struct {
+	// Namespace of the receiving migration
+	Namespace string
+	// MigrationID of the decentralized migration
+	MigrationID string
+	// Token issued with the receiving migration
+	Token string
+	// Port of the source proxy the connection was made to
+	Port int
+}
+
+// EndpointTargetLookup returns the target proxy of the decentralized migration a peer with the given certificate requests
+type EndpointTargetLookup func(request *EndpointRequest, peer *x509.Certificate) (*EndpointTarget, error)
+
+// MigrationEndpoint accepts the connections of decentralized migrations from other clusters and forwards them
+// to the target proxy of the receiving migration, which can run on any node of the cluster.
+type MigrationEndpoint struct {
+	bindAddress     string
+	port            int
+	serverTLSConfig *tls.Config
+	clientTLSConfig *tls.Config
+	lookup          EndpointTargetLookup
+}
+
+// EndpointHeader returns the header source proxies send on connections to the migration endpoint
+func EndpointHeader(request *EndpointRequest) []byte {
+	return []byte(fmt.Sprintf("%s %s %s %d\n", request.Namespace, request.MigrationID, request.Token, request.Port))
+}
+
+func readEndpointHeader(reader *bufio.Reader) (*EndpointRequest, error) {
+	line, err := reader.ReadSlice('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header: %v", err)
+	}
+	fields := strings.Fields(string(line))
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("malformed header")
+	}
+	port, err := strconv.Atoi(fields[3])
+	if err != nil {
+		return nil, fmt.Errorf("malformed port in header: %v", err)
+	}
+	return &EndpointRequest{
+		Namespace:   fields[0],
+		MigrationID: fields[1],
+		Token:       fields[2],
+		Port:        port,
+	}, nil
+}
+
+// NewMigrationEndpoint creates the migration endpoint. The server TLS configuration authenticates the peer
+// clusters, the client TLS configuration is used to connect to the target proxies within the cluster.
+func NewMigrationEndpoint(bindAddress string, port int, serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, lookup EndpointTargetLookup) *MigrationEndpoint {
+	return &MigrationEndpoint{
+		bindAddress:     bindAddress,
+		port:            port,
+		serverTLSConfig: serverTLSConfig,
+		clientTLSConfig: clientTLSConfig,
+		lookup:          lookup,
+	}
+}
+
+// Run accepts connections until the stop channel is closed
+func (e *MigrationEndpoint) Run(stopCh <-chan struct{}) error {
+	listener, err := tls.Listen("tcp", net.JoinHostPort(e.bindAddress, strconv.Itoa(e.port)), e.serverTLSConfig)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-stopCh
+		listener.Close()
+	}()
+
+	log.Log.Infof("migration endpoint started listening on %s", listener.Addr())
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-stopCh:
+				return nil
+			default:
+				return err
+			}
+		}
+		go e.handleConnection(conn)
+	}
+}
+
+func (e *MigrationEndpoint) dialTarget(request *EndpointRequest, peer *x509.Certificate) (net.Conn, error) {
+	target, err := e.lookup(request, peer)
+	if err != nil {
+		return nil, err
+	}
+	destPort := ""
+	for dest, src := range target.DestSrcPortMap {
+		if src == request.Port {
+			destPort = dest
+			break
+		}
+	}
+	if destPort == "" {
+		return nil, fmt.Errorf("the target of migration %s does not listen for port %d", request.MigrationID, request.Port)
+	}
+
+	address := net.JoinHostPort(target.Address, destPort)
+	if target.DisableTLS {
+		return net.Dial("tcp", address)
+	}
+	return tls.Dial("tcp", address, e.clientTLSConfig)
+}
+
+// peerCertificate completes the handshake of a connection to the migration endpoint and returns the certificate
+// the peer authenticated with
+func peerCertificate(fd net.Conn) (*x509.Certificate, error) {
+	tlsConn, ok := fd.(*tls.Conn)
+	if !ok {
+		return nil, fmt.Errorf("not a TLS connection")
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, fmt.Errorf("TLS handshake failed: %v", err)
+	}
+	peerCertificates := tlsConn.ConnectionState().PeerCertificates
+	if len(peerCertificates) == 0 {
+		return nil, fmt.Errorf("the peer did not present a certificate")
+	}
+	return peerCertificates[0], nil
+}
+
+func (e *MigrationEndpoint) handleConnection(fd net.Conn) {
+	defer fd.Close()
+
+	logger := log.Log.With("remote", fd.RemoteAddr().String())
+	peer, err := peerCertificate(fd)
+	if err != nil {
+		logger.Reason(err).Error("rejecting connection to the migration endpoint")
+		return
+	}
+	reader := bufio.NewReaderSize(fd, maxEndpointHeaderLength)
+	request, err := readEndpointHeader(reader)
+	if err != nil {
+		logger.Reason(err).Error("rejecting connection to the migration endpoint")
+		return
+	}
+	logger = logger.With("namespace", request.Namespace).With("migrationID", request.MigrationID).With("port", request.Port)
+
+	conn, err := e.dialTarget(request, peer)
+	if err != nil {
+		logger.Reason(err).Error("unable to create outbound leg of the migration endpoint")
+		return
+	}
+	defer conn.Close()
+
+	outBoundErr := make(chan error, 1)
+	inBoundErr := make(chan error, 1)
+
+	go func() {
+		//from outbound connection to endpoint
+		n, err := io.Copy(fd, conn)
+		logger.Infof("%d bytes copied outbound to inbound", n)
+		inBoundErr <- err
+	}()
+	go func() {
+		//from endpoint to outbound connection, the reader still holds data received after the header
+		n, err := io.Copy(conn, reader)
+		logger.Infof("%d bytes copied from inbound to outbound", n)
+		outBoundErr <- err
+	}()
+
+	select {
+	case err = <-outBoundErr:
+		if err != nil {
+			logger.Reason(err).Errorf("error encountered copying data to outbound connection")
+		}
+	case err = <-inBoundErr:
+		if err != nil {
+			logger.Reason(err).Errorf("error encountered copying data into inbound connection")
+		}
+	}
+}
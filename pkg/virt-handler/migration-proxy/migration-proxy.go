@@ -30,6 +30,7 @@ import (
 	"strings"
 	"sync"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
@@ -43,14 +44,17 @@ const (
 	LibvirtBlockMigrationPort  = 49153
 )
 
+const sourceUnixFileSuffix = "-source.sock"
+
 var migrationPortsRange = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
 
 type ProxyManager interface {
-	StartTargetListener(key string, targetUnixFiles []string) error
+	StartTargetListener(key string, targetUnixFiles []string, migrationConfiguration *v1.MigrationConfiguration) error
 	GetTargetListenerPorts(key string) map[string]int
 	StopTargetListener(key string)
 
-	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error
+	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, migrationConfiguration *v1.MigrationConfiguration) error
+	StartSourceEndpointListener(key string, endpointAddress string, request EndpointRequest, isBlockMigration bool, baseDir string) error
 	GetSourceListenerFiles(key string) []string
 	StopSourceListener(key string)
 
@@ -65,6 +69,8 @@ type migrationProxyManager struct {
 	managerLock     sync.Mutex
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
+	// endpointClientTLSConfig is used to connect to the migration endpoint of other clusters
+	endpointClientTLSConfig *tls.Config
 
 	isShuttingDown bool
 	config         *virtconfig.ClusterConfig
@@ -88,6 +94,8 @@ type migrationProxy struct {
 	listener        net.Listener
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
+	// header is sent on every outbound connection before any data is piped
+	header []byte
 
 	logger *log.FilteredLogger
 }
@@ -114,21 +122,43 @@ func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
 	return
 }
 
-func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
+func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, endpointClientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
 	return &migrationProxyManager{
-		sourceProxies:   make(map[string][]*migrationProxy),
-		targetProxies:   make(map[string][]*migrationProxy),
-		serverTLSConfig: serverTLSConfig,
-		clientTLSConfig: clientTLSConfig,
-		config:          config,
+		sourceProxies:           make(map[string][]*migrationProxy),
+		targetProxies:           make(map[string][]*migrationProxy),
+		serverTLSConfig:         serverTLSConfig,
+		clientTLSConfig:         clientTLSConfig,
+		endpointClientTLSConfig: endpointClientTLSConfig,
+		config:                  config,
 	}
 }
 
 func SourceUnixFile(baseDir string, key string) string {
-	return filepath.Join(baseDir, "migrationproxy", key+"-source.sock")
+	return filepath.Join(baseDir, "migrationproxy", key+sourceUnixFileSuffix)
 }
 
-func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles []string) error {
+// MigrationSocketID returns the ID the migration sockets of a VMI are named after. Decentralized migrations use
+// the migration ID, since the VMIs in the source and the target cluster have different UIDs.
+func MigrationSocketID(vmi *v1.VirtualMachineInstance) string {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationID != "" {
+		return vmi.Status.MigrationState.MigrationID
+	}
+	return string(vmi.UID)
+}
+
+// tlsConfigs returns the TLS configurations to use for a migration. The cluster-wide migration configuration
+// is used when the migration has no configuration of its own.
+func (m *migrationProxyManager) tlsConfigs(migrationConfiguration *v1.MigrationConfiguration) (serverTLSConfig *tls.Config, clientTLSConfig *tls.Config) {
+	if migrationConfiguration == nil {
+		migrationConfiguration = m.config.GetMigrationConfiguration()
+	}
+	if migrationConfiguration.DisableTLS != nil && *migrationConfiguration.DisableTLS {
+		return nil, nil
+	}
+	return m.serverTLSConfig, m.clientTLSConfig
+}
+
+func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles []string, migrationConfiguration *v1.MigrationConfiguration) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -169,12 +199,7 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 
 	zeroAddress := ip.GetIPZeroAddress()
 	proxiesList := []*migrationProxy{}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs(migrationConfiguration)
 	for _, targetUnixFile := range targetUnixFiles {
 		// 0 means random port is used
 		proxy := NewTargetProxy(zeroAddress, 0, serverTLSConfig, clientTLSConfig, targetUnixFile, key)
@@ -221,10 +246,9 @@ func (m *migrationProxyManager) GetTargetListenerPorts(key string) map[string]in
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
-	getPortFromSocket := func(id string, path string) int {
+	getPortFromSocket := func(path string) int {
 		for _, port := range migrationPortsRange {
-			key := ConstructProxyKey(id, port)
-			if strings.Contains(path, key) {
+			if strings.HasSuffix(strings.TrimSuffix(path, sourceUnixFileSuffix), ConstructProxyKey("", port)) {
 				return port
 			}
 		}
@@ -237,7 +261,7 @@ func (m *migrationProxyManager) GetTargetListenerPorts(key string) map[string]in
 	if exists {
 		for _, curProxy := range curProxies {
 			port := strconv.Itoa(curProxy.tcpBindPort)
-			targetSrcPortMap[port] = getPortFromSocket(key, curProxy.targetAddress)
+			targetSrcPortMap[port] = getPortFromSocket(curProxy.targetAddress)
 		}
 	}
 	return targetSrcPortMap
@@ -257,7 +281,7 @@ func (m *migrationProxyManager) StopTargetListener(key string) {
 	}
 }
 
-func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error {
+func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, migrationConfiguration *v1.MigrationConfiguration) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -296,12 +320,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 			}
 		}
 	}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs(migrationConfiguration)
 	proxiesList := []*migrationProxy{}
 	for destPort, srcPort := range destSrcPortMap {
 		proxyKey := ConstructProxyKey(key, srcPort)
@@ -328,6 +347,55 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 	return nil
 }
 
+// StartSourceEndpointListener starts the source proxies of a decentralized migration. All of them connect to the
+// migration endpoint of the target cluster, which forwards the connections to the target proxies of the migration.
+// Each proxy sends the request with its own port.
+func (m *migrationProxyManager) StartSourceEndpointListener(key string, endpointAddress string, request EndpointRequest, isBlockMigration bool, baseDir string) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.isShuttingDown {
+		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
+	}
+
+	ports := append([]int{0}, GetMigrationPortsList(isBlockMigration)...)
+	if curProxies, exists := m.sourceProxies[key]; exists {
+		if len(curProxies) == len(ports) && curProxies[0].targetAddress == endpointAddress {
+			// No Op, already exists
+			return nil
+		}
+		// stop the current proxy and point it somewhere new.
+		for _, curProxy := range curProxies {
+			curProxy.logger.Infof("Manager is stopping proxy on source node due to new target location")
+			curProxy.Stop()
+		}
+	}
+
+	proxiesList := []*migrationProxy{}
+	for _, port := range ports {
+		filePath := SourceUnixFile(baseDir, ConstructProxyKey(request.MigrationID, port))
+
+		os.RemoveAll(filePath)
+
+		request.Port = port
+		proxy := NewSourceEndpointProxy(filePath, endpointAddress, m.endpointClientTLSConfig, EndpointHeader(&request), key)
+
+		err := proxy.Start()
+		if err != nil {
+			proxy.Stop()
+			// close all already created proxies for this key
+			for _, curProxy := range proxiesList {
+				curProxy.Stop()
+			}
+			return err
+		}
+		proxiesList = append(proxiesList, proxy)
+		proxy.logger.Infof("Manager created endpoint proxy on source node")
+	}
+	m.sourceProxies[key] = proxiesList
+	return nil
+}
+
 func (m *migrationProxyManager) StopSourceListener(key string) {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
@@ -360,6 +428,14 @@ func NewSourceProxy(unixSocketPath string, tcpTargetAddress string, serverTLSCon
 	}
 }
 
+// Source endpoint proxy exposes a unix socket server and pipes to an outbound TLS connection to the migration
+// endpoint of another cluster. The header tells the endpoint which target proxy to forward the connection to.
+func NewSourceEndpointProxy(unixSocketPath string, endpointAddress string, clientTLSConfig *tls.Config, header []byte, vmiUID string) *migrationProxy {
+	proxy := NewSourceProxy(unixSocketPath, endpointAddress, nil, clientTLSConfig, vmiUID)
+	proxy.header = header
+	return proxy
+}
+
 // Target proxy listens on a tcp socket and pipes to a virtqemud unix socket
 func NewTargetProxy(tcpBindAddress string, tcpBindPort int, serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, virtqemudSocketPath string, vmiUID string) *migrationProxy {
 	return &migrationProxy{
@@ -454,6 +530,13 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 		return
 	}
 
+	if len(m.header) > 0 {
+		if _, err := conn.Write(m.header); err != nil {
+			m.logger.Reason(err).Error("unable to send the header to the migration endpoint")
+			return
+		}
+	}
+
 	go func() {
 		//from outbound connection to proxy
 		n, err := io.Copy(fd, conn)
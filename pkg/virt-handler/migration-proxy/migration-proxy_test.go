@@ -21,6 +21,9 @@ package migrationproxy
 
 import (
 	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
@@ -163,10 +166,10 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
-				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, config)
+				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock}, nil)
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
-				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
+				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir, nil)
 
 				defer manager.StopTargetListener("myKey")
 				defer manager.StopSourceListener("myKey")
@@ -213,6 +216,104 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(true)}),
 			)
 
+			It("by creating both ends of a decentralized migration connected through the migration endpoint", func() {
+				directMigrationPort := "49152"
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+				directSock := SourceUnixFile(tmpDir, ConstructProxyKey("migration-id", LibvirtDirectMigrationPort))
+				Expect(os.MkdirAll(filepath.Dir(directSock), 0755)).To(Succeed())
+				directListener, err := net.Listen("unix", directSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer directListener.Close()
+
+				// the peers of the migration endpoint authenticate with client certificates
+				endpointServerTLSConfig := tlsConfig.Clone()
+				endpointServerTLSConfig.ClientAuth = tls.RequireAnyClientCert
+				endpointClientTLSConfig := tlsConfig.Clone()
+				endpointClientTLSConfig.GetClientCertificate = func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+					return tlsConfig.GetCertificate(nil)
+				}
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, endpointClientTLSConfig, config)
+				Expect(manager.StartTargetListener("target-uid", []string{virtqemudSock, directSock}, nil)).To(Succeed())
+				defer manager.StopTargetListener("target-uid")
+				destSrcPortMap := manager.GetTargetListenerPorts("target-uid")
+				Expect(destSrcPortMap).To(ConsistOf(0, LibvirtDirectMigrationPort))
+
+				stopCh := make(chan struct{})
+				defer close(stopCh)
+				endpoint := NewMigrationEndpoint("127.0.0.1", 12346, endpointServerTLSConfig, tlsConfig, func(request *EndpointRequest, peer *x509.Certificate) (*EndpointTarget, error) {
+					Expect(peer).ToNot(BeNil())
+					if request.Namespace != "default" || request.MigrationID != "migration-id" || request.Token != "0123abcd" {
+						return nil, fmt.Errorf("unknown migration %s", request.MigrationID)
+					}
+					return &EndpointTarget{Address: "127.0.0.1", DestSrcPortMap: destSrcPortMap}, nil
+				})
+				go endpoint.Run(stopCh)
+				Eventually(func() error {
+					conn, err := tls.Dial("tcp", "127.0.0.1:12346", endpointClientTLSConfig)
+					if err == nil {
+						conn.Close()
+					}
+					return err
+				}).Should(Succeed())
+
+				By("rejecting connections without client certificate")
+				conn, err := tls.Dial("tcp", "127.0.0.1:12346", tlsConfig)
+				if err == nil {
+					_, err = conn.Read(make([]byte, 1))
+				}
+				Expect(err).To(HaveOccurred())
+
+				By("rejecting connections of unknown migrations")
+				conn, err = tls.Dial("tcp", "127.0.0.1:12346", endpointClientTLSConfig)
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = conn.Write(EndpointHeader(&EndpointRequest{Namespace: "default", MigrationID: "migration-id", Token: "abcd0123"}))
+				Expect(err).ShouldNot(HaveOccurred())
+				_, err = conn.Read(make([]byte, 1))
+				Expect(err).To(MatchError(io.EOF))
+
+				By("forwarding the connections of the source proxies to the target proxies")
+				// the source pod has its own directory with sockets named like the ones in the target pod
+				request := EndpointRequest{Namespace: "default", MigrationID: "migration-id", Token: "0123abcd"}
+				Expect(manager.StartSourceEndpointListener("source-uid", "127.0.0.1:12346", request, false, filepath.Join(tmpDir, "source"))).To(Succeed())
+				defer manager.StopSourceListener("source-uid")
+
+				msgReader := func(listener net.Listener, messages chan string) {
+					defer GinkgoRecover()
+					fd, err := listener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+
+					var bytes [1024]byte
+					n, err := fd.Read(bytes[0:])
+					Expect(err).ShouldNot(HaveOccurred())
+					messages <- string(bytes[:n])
+				}
+				libvirtChan := make(chan string)
+				directChan := make(chan string)
+				go msgReader(virtqemudListener, libvirtChan)
+				go msgReader(directListener, directChan)
+
+				sourceFiles := manager.GetSourceListenerFiles("source-uid")
+				Expect(sourceFiles).To(HaveLen(2))
+				for _, sockFile := range sourceFiles {
+					conn, err := net.Dial("unix", sockFile)
+					Expect(err).ShouldNot(HaveOccurred())
+					message := "some libvirt message"
+					messages := libvirtChan
+					if strings.Contains(sockFile, directMigrationPort) {
+						message = "some direct message"
+						messages = directChan
+					}
+					_, err = conn.Write([]byte(message))
+					Expect(err).ShouldNot(HaveOccurred())
+					Eventually(messages).Should(Receive(Equal(message)))
+				}
+			})
+
 			DescribeTable("by ensuring no new listeners can be created after shutdown", func(migrationConfig *v1.MigrationConfiguration) {
 
 				key1 := "key1"
@@ -231,11 +332,11 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
-				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, config)
+				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock}, nil)
 				Expect(err).ShouldNot(HaveOccurred())
 				destSrcPortMap := manager.GetTargetListenerPorts(key1)
-				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir, nil)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				defer manager.StopTargetListener(key1)
@@ -246,11 +347,11 @@ var _ = Describe("MigrationProxy", func() {
 				count := manager.OpenListenerCount()
 				Expect(count).To(Equal(2))
 
-				err = manager.StartTargetListener(key2, []string{virtqemudSock, directSock})
+				err = manager.StartTargetListener(key2, []string{virtqemudSock, directSock}, nil)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
-				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir, nil)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
@@ -20,12 +20,29 @@
 package virthandler
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
+	"strings"
+	"time"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
+// migrationProgressReportInterval is how often the progress of outbound migrations is reported on the migration objects
+const migrationProgressReportInterval = 5 * time.Second
+
+// podNetworkStatusPath is where the network-status annotation of the virt-handler pod is exposed with the downward API
+const podNetworkStatusPath = "/etc/podinfo/network-status"
+
 // FindMigrationIP looks for dedicated migration network migration0. If found, sets migration IP to it
 func FindMigrationIP(migrationIp string) (string, error) {
 	ief, err := net.InterfaceByName(v1.MigrationInterfaceName)
@@ -49,3 +66,67 @@ func FindMigrationIP(migrationIp string) (string, error) {
 
 	return migrationIp, fmt.Errorf("no IP found on %s", v1.MigrationInterfaceName)
 }
+
+// FindMigrationIPForNetwork looks for the IP of the virt-handler pod on the given network, using the network-status
+// annotation of the pod. The network can be referenced either by name or by namespace/name.
+func FindMigrationIPForNetwork(networkStatusAnnotationValue string, network string) (string, error) {
+	var networkStatusList []networkv1.NetworkStatus
+	if err := json.Unmarshal([]byte(networkStatusAnnotationValue), &networkStatusList); err != nil {
+		return "", fmt.Errorf("failed to unmarshal network-status annotation: %v", err)
+	}
+
+	for _, networkStatus := range networkStatusList {
+		if networkStatus.Name != network && !strings.HasSuffix(networkStatus.Name, "/"+network) {
+			continue
+		}
+		for _, address := range networkStatus.IPs {
+			ip := net.ParseIP(address)
+			if ip != nil && ip.IsGlobalUnicast() {
+				return ip.String(), nil
+			}
+		}
+		return "", fmt.Errorf("no IP found on migration network %s", network)
+	}
+
+	return "", fmt.Errorf("virt-handler is not attached to migration network %s", network)
+}
+
+// findMigration returns the migration of the VMI with the given UID, or nil if it does not exist anymore
+func findMigration(migrationIndexer cache.Indexer, vmi *v1.VirtualMachineInstance, uid types.UID) (*v1.VirtualMachineInstanceMigration, error) {
+	objs, err := migrationIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if migration := obj.(*v1.VirtualMachineInstanceMigration); migration.UID == uid {
+			return migration, nil
+		}
+	}
+	return nil, nil
+}
+
+// newMigrationProgress converts the migration job statistics of the hypervisor to the progress of the migration
+func newMigrationProgress(jobInfo *stats.DomainJobInfo, mode v1.MigrationMode) *v1.MigrationProgress {
+	progress := &v1.MigrationProgress{
+		LastUpdateTimestamp: metav1.Now(),
+		Mode:                mode,
+	}
+	setIfReported := func(field **int64, set bool, value uint64) {
+		if set {
+			*field = pointer.P(int64(value))
+		}
+	}
+	setIfReported(&progress.ElapsedMilliseconds, jobInfo.TimeElapsedSet, jobInfo.TimeElapsed)
+	setIfReported(&progress.DataTotalBytes, jobInfo.DataTotalSet, jobInfo.DataTotal)
+	setIfReported(&progress.DataProcessedBytes, jobInfo.DataProcessedSet, jobInfo.DataProcessed)
+	setIfReported(&progress.DataRemainingBytes, jobInfo.DataRemainingSet, jobInfo.DataRemaining)
+	setIfReported(&progress.MemoryTransferRateBytes, jobInfo.MemoryBpsSet, jobInfo.MemoryBps)
+	setIfReported(&progress.DirtyMemoryRateBytes, jobInfo.MemDirtyRateSet, jobInfo.MemDirtyRate)
+	setIfReported(&progress.Iteration, jobInfo.MemIterationSet, jobInfo.MemIteration)
+	setIfReported(&progress.ExpectedDowntimeMilliseconds, jobInfo.DowntimeSet, jobInfo.Downtime)
+	setIfReported(&progress.PostCopyRequests, jobInfo.MemPostcopyReqsSet, jobInfo.MemPostcopyReqs)
+	if jobInfo.AutoConvergeThrottleSet {
+		progress.AutoConvergeThrottle = pointer.P(int64(jobInfo.AutoConvergeThrottle))
+	}
+	return progress
+}
@@ -36,4 +36,26 @@ var _ = Describe("virt-handler", func() {
 			Expect(newIp).To(Equal(originalIP))
 		})
 	})
+
+	Context("FindMigrationIPForNetwork", func() {
+		const networkStatus = `[
+			{"name": "k8s-pod-network", "interface": "eth0", "ips": ["10.244.0.5"], "default": true},
+			{"name": "kubevirt/migration-net", "interface": "migration0", "ips": ["fe80::1", "172.16.0.5"]},
+			{"name": "kubevirt/fast-net", "interface": "net1", "ips": ["fd10::5"]}
+		]`
+
+		DescribeTable("should return the IP of the network", func(network, expectedIP string) {
+			ip, err := FindMigrationIPForNetwork(networkStatus, network)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ip).To(Equal(expectedIP))
+		},
+			Entry("referenced by name", "migration-net", "172.16.0.5"),
+			Entry("referenced by namespace and name", "kubevirt/fast-net", "fd10::5"),
+		)
+
+		It("should fail when virt-handler is not attached to the network", func() {
+			_, err := FindMigrationIPForNetwork(networkStatus, "other-net")
+			Expect(err).To(MatchError(ContainSubstring("not attached")))
+		})
+	})
 })
@@ -34,6 +34,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 const (
@@ -41,6 +42,8 @@ const (
 	failedFreezeVMI        = "Failed to freeze VMI"
 	failedDetectCmdClient  = "Failed to detect cmd client"
 	failedConnectCmdClient = "Failed to connect cmd client"
+
+	defaultGuestExecTimeoutSeconds = 30
 )
 
 type LifecycleHandler struct {
@@ -147,6 +150,49 @@ func (lh *LifecycleHandler) UnfreezeHandler(request *restful.Request, response *
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("No options in guest exec request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve guest exec options"))
+		return
+	}
+
+	defer request.Request.Body.Close()
+	opts := &v1.GuestExecOptions{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal guest exec options")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal guest exec options"))
+		return
+	}
+
+	if opts.TimeoutSeconds == 0 {
+		opts.TimeoutSeconds = defaultGuestExecTimeoutSeconds
+	}
+
+	log.Log.Object(vmi).V(3).Infof("Running %s in the guest", opts.Command)
+
+	exitCode, stdOut, err := client.Exec(api.VMINamespaceKeyFunc(vmi), opts.Command, opts.Args, opts.TimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to run %s in the guest", opts.Command)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(v1.GuestExecResult{
+		ExitCode: int32(exitCode),
+		StdOut:   stdOut,
+	})
+}
+
 func (lh *LifecycleHandler) SoftRebootHandler(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
@@ -89,6 +89,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
@@ -208,6 +209,7 @@ func NewController(
 	kubeletPodsDir string,
 	vmiSourceInformer cache.SharedIndexInformer,
 	vmiTargetInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
 	domainInformer cache.SharedInformer,
 	maxDevices int,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -239,9 +241,11 @@ func NewController(
 		clientset:                        clientset,
 		host:                             host,
 		migrationIpAddress:               migrationIpAddress,
+		podNetworkStatusPath:             podNetworkStatusPath,
 		virtShareDir:                     virtShareDir,
 		vmiSourceStore:                   vmiSourceInformer.GetStore(),
 		vmiTargetStore:                   vmiTargetInformer.GetStore(),
+		migrationIndexer:                 migrationInformer.GetIndexer(),
 		domainStore:                      domainInformer.GetStore(),
 		heartBeatInterval:                1 * time.Minute,
 		migrationProxy:                   migrationProxy,
@@ -261,7 +265,7 @@ func NewController(
 	}
 
 	c.hasSynced = func() bool {
-		return domainInformer.HasSynced() && vmiSourceInformer.HasSynced() && vmiTargetInformer.HasSynced()
+		return domainInformer.HasSynced() && vmiSourceInformer.HasSynced() && vmiTargetInformer.HasSynced() && migrationInformer.HasSynced()
 	}
 
 	_, err := vmiSourceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -330,11 +334,13 @@ type VirtualMachineController struct {
 	clientset                kubecli.KubevirtClient
 	host                     string
 	migrationIpAddress       string
+	podNetworkStatusPath     string
 	virtShareDir             string
 	virtPrivateDir           string
 	queue                    workqueue.RateLimitingInterface
 	vmiSourceStore           cache.Store
 	vmiTargetStore           cache.Store
+	migrationIndexer         cache.Indexer
 	domainStore              cache.Store
 	launcherClients          virtcache.LauncherClientInfoByVMI
 	heartBeatInterval        time.Duration
@@ -708,7 +714,15 @@ func (d *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.Vi
 	// way of transferring ownership. The only option here is to move the
 	// vmi to failed.  The cluster vmi controller will then tear down the
 	// resulting pods.
-	if migrationHost == "" {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.TargetMigrationEndpoint != "" {
+		// The VMI was migrated to another cluster, there is no node in this
+		// cluster to transfer ownership to.
+		vmi.Status.Phase = v1.Succeeded
+		vmi.Status.MigrationState.Completed = true
+
+		d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), fmt.Sprintf("The VirtualMachineInstance migrated to another cluster through %s.", vmi.Status.MigrationState.TargetMigrationEndpoint))
+		log.Log.Object(vmi).Infof("migration completed to another cluster through %s", vmi.Status.MigrationState.TargetMigrationEndpoint)
+	} else if migrationHost == "" {
 		// migrated to unknown host.
 		vmi.Status.Phase = v1.Failed
 		vmi.Status.MigrationState.Completed = true
@@ -774,6 +788,26 @@ func domainIsActiveOnTarget(domain *api.Domain) bool {
 
 }
 
+// getMigrationIpAddress returns the address the migration target listens on. It is the address on the migration
+// network of the migration policy matching the VMI, if the policy sets one.
+func (d *VirtualMachineController) getMigrationIpAddress(vmi *v1.VirtualMachineInstance) (string, error) {
+	if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.MigrationConfiguration == nil {
+		return d.migrationIpAddress, nil
+	}
+
+	network := vmi.Status.MigrationState.MigrationConfiguration.Network
+	clusterNetwork := d.clusterConfig.GetMigrationConfiguration().Network
+	if network == nil || (clusterNetwork != nil && *clusterNetwork == *network) {
+		return d.migrationIpAddress, nil
+	}
+
+	networkStatus, err := os.ReadFile(d.podNetworkStatusPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the network status of virt-handler: %v", err)
+	}
+	return FindMigrationIPForNetwork(string(networkStatus), *network)
+}
+
 func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 
 	vmiCopy := vmi.DeepCopy()
@@ -790,6 +824,11 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		// record that we've see the domain populated on the target's node
 		log.Log.Object(vmi).Info("The target node received the migrated domain")
 		vmiCopy.Status.MigrationState.TargetNodeDomainDetected = true
+		if migrations.IsMigrationReceiver(vmi) && vmiCopy.Status.MigrationState.StartTimestamp == nil {
+			// there is no source node in this cluster reporting the start of the migration
+			now := metav1.Now()
+			vmiCopy.Status.MigrationState.StartTimestamp = &now
+		}
 
 		// adjust QEMU process memlock limits in order to enable old virt-launcher pod's to
 		// perform hotplug host-devices on post migration.
@@ -811,6 +850,9 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		now := metav1.Now()
 		vmiCopy.Status.MigrationState.TargetNodeDomainReadyTimestamp = &now
 		d.finalizeMigration(vmiCopy)
+		if migrations.IsMigrationReceiver(vmi) {
+			d.completeReceivedMigration(vmiCopy)
+		}
 	}
 
 	if !migrations.IsMigrating(vmi) {
@@ -826,15 +868,19 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		if vmi.Status.MigrationState != nil {
 			hostAddress = vmi.Status.MigrationState.TargetNodeAddress
 		}
-		if hostAddress != d.migrationIpAddress {
+		migrationIpAddress, err := d.getMigrationIpAddress(vmi)
+		if err != nil {
+			return err
+		}
+		if hostAddress != migrationIpAddress {
 			portsList := make([]string, 0, len(destSrcPortsMap))
 
 			for k := range destSrcPortsMap {
 				portsList = append(portsList, k)
 			}
 			portsStrList := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(portsList)), ","), "[]")
-			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", d.migrationIpAddress, portsStrList))
-			vmiCopy.Status.MigrationState.TargetNodeAddress = d.migrationIpAddress
+			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.PreparingTarget.String(), fmt.Sprintf("Migration Target is listening at %s, on ports: %s", migrationIpAddress, portsStrList))
+			vmiCopy.Status.MigrationState.TargetNodeAddress = migrationIpAddress
 			vmiCopy.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPortsMap
 		}
 
@@ -866,6 +912,20 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 	return nil
 }
 
+// completeReceivedMigration makes this node the owner of a VMI received from another cluster
+// once its domain is running. Unlike migrations within the cluster, there is no source node
+// performing the handoff.
+func (d *VirtualMachineController) completeReceivedMigration(vmi *v1.VirtualMachineInstance) {
+	now := metav1.Now()
+	vmi.Status.Phase = v1.Running
+	vmi.Status.MigrationState.EndTimestamp = &now
+	vmi.Status.MigrationState.Completed = true
+	vmi.Status.MigrationTransport = v1.MigrationTransportUnix
+
+	d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), "The VirtualMachineInstance was received from another cluster.")
+	log.Log.Object(vmi).Info("migration from another cluster completed")
+}
+
 func (d *VirtualMachineController) generateEventsForVolumeStatusChange(vmi *v1.VirtualMachineInstance, newStatusMap map[string]v1.VolumeStatus) {
 	newStatusMapCopy := make(map[string]v1.VolumeStatus)
 	for k, v := range newStatusMap {
@@ -1819,6 +1879,9 @@ func (d *VirtualMachineController) migrationTargetExecute(vmi *v1.VirtualMachine
 
 	if vmiExists && vmi.IsRunning() {
 		shouldUpdate = true
+	} else if vmiExists && migrations.IsMigrationReceiver(vmi) && vmi.Status.MigrationState != nil {
+		// the VMI receives a migration from another cluster once the migration is handed off
+		shouldUpdate = true
 	}
 
 	if !vmiExists || vmi.DeletionTimestamp != nil {
@@ -2488,6 +2551,11 @@ func (d *VirtualMachineController) isPreMigrationTarget(vmi *v1.VirtualMachineIn
 		return true
 	}
 
+	// VMIs receiving a migration from another cluster are never started on their node
+	if migrations.IsMigrationReceiver(vmi) && vmi.Status.NodeName == d.host {
+		return true
+	}
+
 	return false
 }
 
@@ -2561,7 +2629,7 @@ func (d *VirtualMachineController) isMigrationSource(vmi *v1.VirtualMachineInsta
 
 	if vmi.Status.MigrationState != nil &&
 		vmi.Status.MigrationState.SourceNode == d.host &&
-		vmi.Status.MigrationState.TargetNodeAddress != "" &&
+		(vmi.Status.MigrationState.TargetNodeAddress != "" || vmi.Status.MigrationState.TargetMigrationEndpoint != "") &&
 		!vmi.Status.MigrationState.Completed {
 
 		return true
@@ -2585,15 +2653,20 @@ func (d *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 	baseDir := fmt.Sprintf(filepath.Join(d.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	migrationTargetSockets = append(migrationTargetSockets, socketFile)
 
-	isBlockMigration := vmi.Status.MigrationMethod == v1.BlockMigration
+	// the source of a decentralized migration decides on block migration in its own cluster
+	isBlockMigration := vmi.Status.MigrationMethod == v1.BlockMigration || migrations.IsMigrationReceiver(vmi)
 	migrationPortsRange := migrationproxy.GetMigrationPortsList(isBlockMigration)
 	for _, port := range migrationPortsRange {
-		key := migrationproxy.ConstructProxyKey(string(vmi.UID), port)
+		key := migrationproxy.ConstructProxyKey(migrationproxy.MigrationSocketID(vmi), port)
 		// a proxy between the target direct qemu channel and the connector in the destination pod
 		destSocketFile := migrationproxy.SourceUnixFile(baseDir, key)
 		migrationTargetSockets = append(migrationTargetSockets, destSocketFile)
 	}
-	err = d.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets)
+	var migrationConfiguration *v1.MigrationConfiguration
+	if vmi.Status.MigrationState != nil {
+		migrationConfiguration = vmi.Status.MigrationState.MigrationConfiguration
+	}
+	err = d.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets, migrationConfiguration)
 	if err != nil {
 		return err
 	}
@@ -2617,6 +2690,19 @@ func (d *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 	// pass in the virt-launcher's baseDir to reach the unix sockets.
 	baseDir := fmt.Sprintf(filepath.Join(d.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	d.migrationProxy.StopTargetListener(string(vmi.UID))
+	if endpoint := vmi.Status.MigrationState.TargetMigrationEndpoint; endpoint != "" {
+		return d.migrationProxy.StartSourceEndpointListener(
+			string(vmi.UID),
+			endpoint,
+			migrationproxy.EndpointRequest{
+				Namespace:   vmi.Namespace,
+				MigrationID: vmi.Status.MigrationState.MigrationID,
+				Token:       vmi.Status.MigrationState.TargetMigrationEndpointToken,
+			},
+			vmi.Status.MigrationMethod == v1.BlockMigration,
+			baseDir,
+		)
+	}
 	if vmi.Status.MigrationState.TargetDirectMigrationNodePorts == nil {
 		msg := "No migration proxy has been created for this vmi"
 		return fmt.Errorf("%s", msg)
@@ -2626,6 +2712,7 @@ func (d *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 		vmi.Status.MigrationState.TargetNodeAddress,
 		vmi.Status.MigrationState.TargetDirectMigrationNodePorts,
 		baseDir,
+		vmi.Status.MigrationState.MigrationConfiguration,
 	)
 	if err != nil {
 		return err
@@ -2674,7 +2761,12 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 	} else {
 		if isMigrationInProgress(origVMI, domain) {
 			// we already started this migration, no need to rerun this
-			log.DefaultLogger().Errorf("migration %s has already been started", origVMI.Status.MigrationState.MigrationUID)
+			if d.reportMigrationProgress(origVMI, client) {
+				// the VMI is requeued periodically to report the progress, this is expected
+				log.Log.Object(origVMI).V(4).Infof("migration %s has already been started", origVMI.Status.MigrationState.MigrationUID)
+			} else {
+				log.DefaultLogger().Errorf("migration %s has already been started", origVMI.Status.MigrationState.MigrationUID)
+			}
 			return nil
 		}
 
@@ -2695,6 +2787,7 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			UnsafeMigration:         *migrationConfiguration.UnsafeMigrationOverride,
 			AllowAutoConverge:       *migrationConfiguration.AllowAutoConverge,
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
+			AllowAdaptiveTuning:     migrationConfiguration.AllowAdaptiveTuning != nil && *migrationConfiguration.AllowAdaptiveTuning,
 		}
 
 		if threadCountStr, exists := origVMI.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]; exists {
@@ -2729,6 +2822,52 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 	return nil
 }
 
+// reportMigrationProgress reports the job statistics of a running outbound migration on the migration object, at
+// most once per migrationProgressReportInterval. The VMI is requeued until the migration is final, it returns whether
+// it has been requeued. Failures are only logged, since they do not affect the migration.
+func (d *VirtualMachineController) reportMigrationProgress(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) bool {
+	logger := log.Log.Object(vmi)
+	key := controller.VirtualMachineInstanceKey(vmi)
+
+	migration, err := findMigration(d.migrationIndexer, vmi, vmi.Status.MigrationState.MigrationUID)
+	if err != nil {
+		logger.Reason(err).Warning("failed to find the migration to report its progress")
+		d.queue.AddAfter(key, migrationProgressReportInterval)
+		return true
+	}
+	if migration == nil || migration.IsFinal() {
+		return false
+	}
+
+	if progress := migration.Status.Progress; progress != nil {
+		if next := progress.LastUpdateTimestamp.Add(migrationProgressReportInterval); time.Now().Before(next) {
+			d.queue.AddAfter(key, time.Until(next))
+			return true
+		}
+	}
+	d.queue.AddAfter(key, migrationProgressReportInterval)
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		logger.Reason(err).Warning("failed to get the migration job statistics")
+		return true
+	}
+	if !exists || domainStats.MigrateDomainJobInfo == nil {
+		return true
+	}
+
+	progress := newMigrationProgress(domainStats.MigrateDomainJobInfo, vmi.Status.MigrationState.Mode)
+	patchBytes, err := patch.New(patch.WithAdd("/status/progress", progress)).GeneratePayload()
+	if err != nil {
+		logger.Reason(err).Warning("failed to generate the migration progress patch")
+		return true
+	}
+	if _, err := d.clientset.VirtualMachineInstanceMigration(migration.Namespace).PatchStatus(context.Background(), migration.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		logger.Reason(err).Warningf("failed to report the progress of migration %s", migration.Name)
+	}
+	return true
+}
+
 func replaceMigratedVolumesStatus(vmi *v1.VirtualMachineInstance) {
 	replaceVolsStatus := make(map[string]*v1.PersistentVolumeClaimInfo)
 	for _, v := range vmi.Status.MigratedVolumes {
@@ -2787,8 +2926,10 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 		return nil
 	}
 
-	// Verify container disks checksum
-	err = container_disk.VerifyChecksums(d.containerDiskMounter, vmi)
+	// Verify container disks checksum, sources in other clusters do not report their checksums
+	if !migrations.IsMigrationReceiver(vmi) {
+		err = container_disk.VerifyChecksums(d.containerDiskMounter, vmi)
+	}
 	switch {
 	case goerror.Is(err, container_disk.ErrChecksumMissing):
 		// wait for checksum to be computed by the source virt-handler
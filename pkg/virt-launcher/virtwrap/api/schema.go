@@ -857,6 +857,14 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthLimit `xml:"inbound,omitempty"`
+	Outbound *BandWidthLimit `xml:"outbound,omitempty"`
+}
+
+type BandWidthLimit struct {
+	Average uint64 `xml:"average,attr"`
+	Peak    uint64 `xml:"peak,attr,omitempty"`
+	Burst   uint64 `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -876,6 +884,8 @@ type FilterRef struct {
 }
 
 type InterfaceSource struct {
+	Type    string   `xml:"type,attr,omitempty"`
+	Path    string   `xml:"path,attr,omitempty"`
 	Network string   `xml:"network,attr,omitempty"`
 	Device  string   `xml:"dev,attr,omitempty"`
 	Bridge  string   `xml:"bridge,attr,omitempty"`
@@ -55,6 +55,7 @@ import (
 	kvapi "kubevirt.io/client-go/api"
 
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
 	kubevirtpointer "kubevirt.io/kubevirt/pkg/pointer"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	sev "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
@@ -1190,6 +1191,17 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].Rom.Enabled).To(Equal("no"))
 		})
 
+		DescribeTable("should set the link state", func(state v1.InterfaceState, expectedLinkState *api.LinkState) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Interfaces[0].State = state
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Interfaces[0].LinkState).To(Equal(expectedLinkState))
+		},
+			Entry("to down when the interface is down", v1.InterfaceStateDown, &api.LinkState{State: "down"}),
+			Entry("to the default when the interface is up", v1.InterfaceStateUp, nil),
+			Entry("to the default when the state is not specified", v1.InterfaceState(""), nil),
+		)
+
 		When("NIC PCI address is specified on VMI", func() {
 			const pciAddress = "0000:81:01.0"
 			expectedPCIAddress := api.Address{
@@ -3042,6 +3054,70 @@ var _ = Describe("Converter", func() {
 	})
 })
 
+var _ = Describe("network binding plugin domain interface", func() {
+	const ifaceName = "red"
+
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{}
+		vmi.Spec.Architecture = "amd64"
+	})
+
+	DescribeTable("should attach the interface as described by the plugin device", func(pluginDevice *netbindingv2.Device, expectedIface api.Interface) {
+		iface := v1.Interface{Name: ifaceName, MacAddress: "02:00:00:00:00:01", Binding: &v1.PluginBinding{Name: "vendor"}}
+
+		domainIface, err := NetBindingPluginDomainInterface(vmi, iface, pluginDevice)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainIface).To(Equal(expectedIface))
+	},
+		Entry("with a tap device",
+			&netbindingv2.Device{Type: netbindingv2.DeviceTypeEthernet, TapDevice: "tap0", Mtu: 9000},
+			api.Interface{
+				Type:   "ethernet",
+				Target: &api.InterfaceTarget{Device: "tap0", Managed: "no"},
+				Model:  &api.Model{Type: "virtio-non-transitional"},
+				MAC:    &api.MAC{MAC: "02:00:00:00:00:01"},
+				MTU:    &api.MTU{Size: "9000"},
+				Alias:  api.NewUserDefinedAlias(ifaceName),
+			},
+		),
+		Entry("with a vhost-user socket and the MAC address set by the plugin",
+			&netbindingv2.Device{Type: netbindingv2.DeviceTypeVhostUser, SocketPath: "/var/run/plugin/red.sock", MacAddress: "02:00:00:00:00:02"},
+			api.Interface{
+				Type:   "vhostuser",
+				Source: api.InterfaceSource{Type: "unix", Path: "/var/run/plugin/red.sock", Mode: "client"},
+				Model:  &api.Model{Type: "virtio-non-transitional"},
+				MAC:    &api.MAC{MAC: "02:00:00:00:00:02"},
+				Alias:  api.NewUserDefinedAlias(ifaceName),
+			},
+		),
+		Entry("with a user backend",
+			&netbindingv2.Device{Type: netbindingv2.DeviceTypeUser, Backend: "passt"},
+			api.Interface{
+				Type:    "user",
+				Backend: &api.InterfaceBackend{Type: "passt"},
+				Model:   &api.Model{Type: "virtio-non-transitional"},
+				MAC:     &api.MAC{MAC: "02:00:00:00:00:01"},
+				Alias:   api.NewUserDefinedAlias(ifaceName),
+			},
+		),
+	)
+
+	It("should set the link down", func() {
+		iface := v1.Interface{Name: ifaceName, State: v1.InterfaceStateDown}
+
+		domainIface, err := NetBindingPluginDomainInterface(vmi, iface, &netbindingv2.Device{Type: netbindingv2.DeviceTypeEthernet, TapDevice: "tap0"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(domainIface.LinkState).To(Equal(&api.LinkState{State: string(v1.InterfaceStateDown)}))
+	})
+
+	It("should fail on an unsupported device type", func() {
+		_, err := NetBindingPluginDomainInterface(vmi, v1.Interface{Name: ifaceName}, &netbindingv2.Device{Type: "bridge"})
+		Expect(err).To(MatchError(ContainSubstring(`unsupported device type "bridge"`)))
+	})
+})
+
 var _ = Describe("disk device naming", func() {
 	It("format device name should return correct value", func() {
 		res := FormatDeviceName("sd", 0)
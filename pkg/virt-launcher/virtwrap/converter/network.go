@@ -23,6 +23,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -31,6 +32,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 
 	"kubevirt.io/kubevirt/pkg/network/dns"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
@@ -81,6 +83,10 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
+		if iface.State == v1.InterfaceStateDown {
+			domainIface.LinkState = &api.LinkState{State: string(v1.InterfaceStateDown)}
+		}
+
 		if c.DomainAttachmentByInterfaceName[iface.Name] == string(v1.Tap) {
 			// use "ethernet" interface type, since we're using pre-configured tap devices
 			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
@@ -110,6 +116,65 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 	return domainInterfaces, nil
 }
 
+// NetBindingPluginDomainInterface creates the domain interface of an interface whose binding is served by a
+// network binding plugin, attached as described by the device returned by the plugin.
+func NetBindingPluginDomainInterface(vmi *v1.VirtualMachineInstance, iface v1.Interface, pluginDevice *netbindingv2.Device) (api.Interface, error) {
+	ifaceType := GetInterfaceType(&iface)
+	domainIface := api.Interface{
+		Type: pluginDevice.GetType(),
+		Model: &api.Model{
+			Type: translateModel(vmi.Spec.Domain.Devices.UseVirtioTransitional, ifaceType, vmi.Spec.Architecture),
+		},
+		Alias: api.NewUserDefinedAlias(iface.Name),
+	}
+
+	switch pluginDevice.GetType() {
+	case netbindingv2.DeviceTypeEthernet:
+		domainIface.Target = &api.InterfaceTarget{Device: pluginDevice.GetTapDevice(), Managed: "no"}
+	case netbindingv2.DeviceTypeVhostUser:
+		// The plugin serves the socket, QEMU connects to it as a client
+		domainIface.Source = api.InterfaceSource{Type: "unix", Path: pluginDevice.GetSocketPath(), Mode: "client"}
+	case netbindingv2.DeviceTypeUser:
+		domainIface.Backend = &api.InterfaceBackend{Type: pluginDevice.GetBackend()}
+	default:
+		return api.Interface{}, fmt.Errorf("unsupported device type %q for interface %s", pluginDevice.GetType(), iface.Name)
+	}
+
+	mac := pluginDevice.GetMacAddress()
+	if mac == "" {
+		mac = iface.MacAddress
+	}
+	if mac != "" {
+		domainIface.MAC = &api.MAC{MAC: mac}
+	}
+
+	if mtu := pluginDevice.GetMtu(); mtu != 0 {
+		domainIface.MTU = &api.MTU{Size: strconv.FormatUint(uint64(mtu), 10)}
+	}
+
+	if iface.PciAddress != "" {
+		addr, err := device.NewPciAddressField(iface.PciAddress)
+		if err != nil {
+			return api.Interface{}, fmt.Errorf("failed to configure interface %s: %v", iface.Name, err)
+		}
+		domainIface.Address = addr
+	}
+
+	if iface.ACPIIndex > 0 {
+		domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
+	}
+
+	if iface.BootOrder != nil {
+		domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+	}
+
+	if iface.State == v1.InterfaceStateDown {
+		domainIface.LinkState = &api.LinkState{State: string(v1.InterfaceStateDown)}
+	}
+
+	return domainIface, nil
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
@@ -44,6 +44,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
@@ -68,6 +70,28 @@ const (
 	monitorLogInterval   = monitorLogPeriodMS / monitorSleepPeriodMS
 )
 
+const (
+	// adaptiveTuningNonConvergingIterations is how many iterations in a row have to dirty the memory faster
+	// than it is transferred before the migration is tuned
+	adaptiveTuningNonConvergingIterations = 2
+	// adaptiveTuningInitialThrottle and adaptiveTuningThrottleIncrement are the auto-converge parameters of
+	// migrations with adaptive tuning, in percent. They are more aggressive than the QEMU defaults of 20 and 10,
+	// since libvirt only accepts them when the migration starts.
+	adaptiveTuningInitialThrottle   = 30
+	adaptiveTuningThrottleIncrement = 20
+	// adaptiveTuningMaxThrottle is the maximum CPU throttle QEMU applies by default
+	adaptiveTuningMaxThrottle = 99
+	defaultMemoryPageSize     = 4096
+)
+
+type adaptiveTuningAction int
+
+const (
+	adaptiveTuningNone adaptiveTuningAction = iota
+	adaptiveTuningStartPostCopy
+	adaptiveTuningAbort
+)
+
 type migrationDisks struct {
 	shared         map[string]bool
 	generated      map[string]bool
@@ -89,6 +113,9 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	lastTunedIteration      uint64
+	nonConvergingIterations int
 }
 
 type inflightMigrationAborted struct {
@@ -320,6 +347,16 @@ func (l *LibvirtDomainManager) startMigration(vmi *v1.VirtualMachineInstance, op
 		return fmt.Errorf("cannot migration VMI until migrationState is ready")
 	}
 
+	if !l.migrationInProgress() {
+		// sidecars can veto the migration, e.g. when a license can not be moved to the target
+		if err := hooks.GetManager().PreMigration(vmi); err != nil {
+			return fmt.Errorf("executing PreMigration hooks failed: %v", err)
+		}
+		if err := netbinding.GetPluginManager().PreMigrate(vmi); err != nil {
+			return err
+		}
+	}
+
 	inProgress, err := l.initializeMigrationMetadata(vmi, v1.MigrationPreCopy)
 	if err != nil {
 		return err
@@ -452,6 +489,83 @@ func (m *migrationMonitor) shouldTriggerPostCopy(elapsed int64) bool {
 	return m.shouldTriggerTimeout(elapsed) && m.options.AllowPostCopy
 }
 
+// nextAdaptiveTuningAction decides once per iteration how to tune a migration whose guest dirties the memory
+// faster than it is transferred. Post-copy is preferred when allowed, otherwise QEMU keeps increasing the CPU
+// throttle until it reaches its maximum, at which point the migration can not converge anymore and is aborted.
+func (m *migrationMonitor) nextAdaptiveTuningAction(stats *libvirt.DomainJobInfo) adaptiveTuningAction {
+	if !stats.MemIterationSet || stats.MemIteration <= m.lastTunedIteration {
+		return adaptiveTuningNone
+	}
+	m.lastTunedIteration = stats.MemIteration
+
+	if !stats.MemBpsSet || !stats.MemDirtyRateSet || stats.MemBps == 0 {
+		return adaptiveTuningNone
+	}
+	if dirtyMemoryRate(stats) < stats.MemBps {
+		m.nonConvergingIterations = 0
+		return adaptiveTuningNone
+	}
+
+	m.nonConvergingIterations++
+	if m.nonConvergingIterations < adaptiveTuningNonConvergingIterations {
+		return adaptiveTuningNone
+	}
+	m.nonConvergingIterations = 0
+
+	switch {
+	case m.options.AllowPostCopy:
+		return adaptiveTuningStartPostCopy
+	case stats.AutoConvergeThrottleSet && stats.AutoConvergeThrottle >= adaptiveTuningMaxThrottle:
+		return adaptiveTuningAbort
+	}
+	return adaptiveTuningNone
+}
+
+// dirtyMemoryRate returns the rate, in bytes per second, at which the guest dirties its memory
+func dirtyMemoryRate(stats *libvirt.DomainJobInfo) uint64 {
+	pageSize := uint64(defaultMemoryPageSize)
+	if stats.MemPageSizeSet && stats.MemPageSize != 0 {
+		pageSize = stats.MemPageSize
+	}
+	return stats.MemDirtyRate * pageSize
+}
+
+func (m *migrationMonitor) applyAdaptiveTuningAction(dom cli.VirDomain, stats *libvirt.DomainJobInfo, action adaptiveTuningAction) *inflightMigrationAborted {
+	logger := log.Log.Object(m.vmi)
+	bToMiB := func(bytes uint64) uint64 {
+		return bytes / 1024 / 1024
+	}
+
+	switch action {
+	case adaptiveTuningStartPostCopy:
+		logger.Infof("Starting post copy mode early, the guest dirties memory at %dMiB/s while it is transferred at %dMiB/s",
+			bToMiB(dirtyMemoryRate(stats)), bToMiB(stats.MemBps))
+		m.startPostCopy(dom)
+	case adaptiveTuningAbort:
+		if err := dom.AbortJob(); err != nil {
+			logger.Reason(err).Error("failed to abort migration")
+			return nil
+		}
+		return &inflightMigrationAborted{
+			message: fmt.Sprintf("Live migration does not converge: the guest dirties memory at %dMiB/s, faster than "+
+				"it is transferred at %dMiB/s, even with the CPU throttled to %d%%, and has been aborted",
+				bToMiB(dirtyMemoryRate(stats)), bToMiB(stats.MemBps), stats.AutoConvergeThrottle),
+			abortStatus: v1.MigrationAbortSucceeded,
+		}
+	}
+	return nil
+}
+
+func (m *migrationMonitor) startPostCopy(dom cli.VirDomain) {
+	err := dom.MigrateStartPostCopy(uint32(0))
+	if err != nil {
+		log.Log.Object(m.vmi).Reason(err).Error("failed to start post migration")
+		return
+	}
+
+	m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
+}
+
 func (m *migrationMonitor) isMigrationProgressing() bool {
 	logger := log.Log.Object(m.vmi)
 
@@ -521,6 +635,11 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 	}
 	m.progressWatermark = m.remainingData
 
+	tuning := adaptiveTuningNone
+	if m.options.AllowAdaptiveTuning && !m.isMigrationPostCopy() {
+		tuning = m.nextAdaptiveTuningAction(stats)
+	}
+
 	switch {
 	case m.isMigrationPostCopy():
 		// Currently, there is nothing for us to track when in Post Copy mode.
@@ -531,17 +650,14 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 		// If we were to abort the migration due to a timeout while in post copy,
 		// then it would result in that active state being lost.
 
+	case tuning != adaptiveTuningNone:
+		return m.applyAdaptiveTuningAction(dom, stats, tuning)
+
 	case m.shouldTriggerPostCopy(elapsed):
 		logger.Info("Starting post copy mode for migration")
 		// if a migration has stalled too long, post copy will be
 		// triggered when allowPostCopy is enabled
-		err := dom.MigrateStartPostCopy(uint32(0))
-		if err != nil {
-			logger.Reason(err).Error("failed to start post migration")
-			return nil
-		}
-
-		m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
+		m.startPostCopy(dom)
 
 	case !m.isMigrationProgressing():
 		// check if the migration is still progressing
@@ -743,7 +859,7 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		parallelMigrationThreads = int(*options.ParallelMigrationThreads)
 	}
 
-	key := migrationproxy.ConstructProxyKey(string(vmi.UID), migrationproxy.LibvirtDirectMigrationPort)
+	key := migrationproxy.ConstructProxyKey(migrationproxy.MigrationSocketID(vmi), migrationproxy.LibvirtDirectMigrationPort)
 	migrURI := fmt.Sprintf("unix://%s", migrationproxy.SourceUnixFile(virtShareDir, key))
 	params := &libvirt.DomainMigrateParameters{
 		URI:                    migrURI,
@@ -757,13 +873,19 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		ParallelConnectionsSet: parallelMigrationSet,
 		ParallelConnections:    parallelMigrationThreads,
 	}
+	if options.AllowAdaptiveTuning {
+		params.AutoConvergeInitial = adaptiveTuningInitialThrottle
+		params.AutoConvergeInitialSet = true
+		params.AutoConvergeIncrement = adaptiveTuningThrottleIncrement
+		params.AutoConvergeIncrementSet = true
+	}
 
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
 	if len(copyDisks) != 0 {
 		params.MigrateDisks = copyDisks
 		params.MigrateDisksSet = true
 		// add a socket for live block migration
-		key := migrationproxy.ConstructProxyKey(string(vmi.UID), migrationproxy.LibvirtBlockMigrationPort)
+		key := migrationproxy.ConstructProxyKey(migrationproxy.MigrationSocketID(vmi), migrationproxy.LibvirtBlockMigrationPort)
 		disksURI := fmt.Sprintf("unix://%s", migrationproxy.SourceUnixFile(virtShareDir, key))
 		params.DisksURI = disksURI
 		params.DisksURISet = true
@@ -967,9 +1089,9 @@ func (l *LibvirtDomainManager) migrateHelper(vmi *v1.VirtualMachineInstance, opt
 	// initiate the live migration
 	var dstURI string
 	if virtutil.IsNonRootVMI(vmi) {
-		dstURI = fmt.Sprintf("qemu+unix:///session?socket=%s", migrationproxy.SourceUnixFile(l.virtShareDir, string(vmi.UID)))
+		dstURI = fmt.Sprintf("qemu+unix:///session?socket=%s", migrationproxy.SourceUnixFile(l.virtShareDir, migrationproxy.MigrationSocketID(vmi)))
 	} else {
-		dstURI = fmt.Sprintf("qemu+unix:///system?socket=%s", migrationproxy.SourceUnixFile(l.virtShareDir, string(vmi.UID)))
+		dstURI = fmt.Sprintf("qemu+unix:///system?socket=%s", migrationproxy.SourceUnixFile(l.virtShareDir, migrationproxy.MigrationSocketID(vmi)))
 	}
 
 	err = dom.MigrateToURI3(dstURI, params, migrateFlags)
@@ -1006,6 +1128,9 @@ func (l *LibvirtDomainManager) migrate(vmi *v1.VirtualMachineInstance, options *
 	defer close(migrationErrorChan)
 
 	log.Log.Object(vmi).Infof("Initiating live migration.")
+	if options.AllowAdaptiveTuning {
+		options = withAdaptiveTuning(options)
+	}
 	if options.UnsafeMigration {
 		log.Log.Object(vmi).Info("UNSAFE_MIGRATION flag is set, libvirt's migration checks will be disabled!")
 	}
@@ -1026,6 +1151,14 @@ func (l *LibvirtDomainManager) migrate(vmi *v1.VirtualMachineInstance, options *
 	log.Log.Object(vmi).Infof("Live migration succeeded.")
 }
 
+// withAdaptiveTuning returns the options of a migration with adaptive tuning. Auto-converge is enabled, since it can
+// only be chosen when the migration starts.
+func withAdaptiveTuning(options *cmdclient.MigrationOptions) *cmdclient.MigrationOptions {
+	tuned := *options
+	tuned.AllowAutoConverge = true
+	return &tuned
+}
+
 func (l *LibvirtDomainManager) updateVMIMigrationMode(mode v1.MigrationMode) {
 	l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
 		migrationMetadata.Mode = mode
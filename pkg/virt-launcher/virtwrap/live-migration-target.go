@@ -33,6 +33,7 @@ import (
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
@@ -53,6 +54,14 @@ func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineIns
 		return err
 	}
 
+	if err := hooks.GetManager().PostMigration(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("executing PostMigration hooks failed")
+	}
+
+	if err := netbinding.GetPluginManager().PostMigrate(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("executing network binding plugins PostMigrate failed")
+	}
+
 	return nil
 }
 
@@ -172,12 +181,11 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 	if err != nil {
 		return err
 	}
-	// TODO this should probably a OnPrepareMigration hook or something.
-	// Right now we need to call OnDefineDomain, so that additional setup, which might be done
-	// by the hook can also be done for the new target pod
+	// Older sidecars are called with the regenerated domain, so that additional setup, which might be done
+	// by the hook can also be done for the new target pod. Newer sidecars are called by the libvirt qemu hook
+	// with the domain received from the source.
 	hooksManager := hooks.GetManager()
-	_, err = hooksManager.OnDefineDomain(&dom.Spec, vmi)
-	if err != nil {
+	if err := hooksManager.OnPrepareMigrationTarget(&dom.Spec, vmi); err != nil {
 		return fmt.Errorf("executing custom preStart hooks failed: %v", err)
 	}
 
@@ -204,7 +212,7 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 		migrationPortsRange := migrationproxy.GetMigrationPortsList(isBlockMigration(vmi))
 		for _, port := range migrationPortsRange {
 			// Prepare the direct migration proxy
-			key := migrationproxy.ConstructProxyKey(string(vmi.UID), port)
+			key := migrationproxy.ConstructProxyKey(migrationproxy.MigrationSocketID(vmi), port)
 			curDirectAddress := net.JoinHostPort(loopbackAddress, strconv.Itoa(port))
 			unixSocketPath := migrationproxy.SourceUnixFile(l.virtShareDir, key)
 			migrationProxy := migrationproxy.NewSourceProxy(unixSocketPath, curDirectAddress, nil, nil, string(vmi.UID))
@@ -75,8 +75,11 @@ import (
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	netsriov "kubevirt.io/kubevirt/pkg/network/deviceinfo"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
@@ -781,6 +784,10 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
 
+	if err := setupNetBindingPlugins(vmi, domain); err != nil {
+		return domain, fmt.Errorf("preparing the network binding plugin interfaces failed: %v", err)
+	}
+
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi, l.ephemeralDiskCreator, disksInfo)
 	if err != nil {
@@ -1159,6 +1166,7 @@ func (l *LibvirtDomainManager) syncDiskHotplug(
 			logger.Reason(err).Error("detaching device")
 			return err
 		}
+		notifyHotplugHooks(vmi, hooksV1alpha4.HotplugDeviceTypeDisk, detachDisk.Alias.GetName(), hooksV1alpha4.HotplugActionUnplug)
 	}
 	// Look up all the disks to attach
 	for _, attachDisk := range getAttachedDisks(spec.Devices.Disks, domain.Spec.Devices.Disks) {
@@ -1190,6 +1198,7 @@ func (l *LibvirtDomainManager) syncDiskHotplug(
 			logger.Reason(err).Error("attaching device")
 			return err
 		}
+		notifyHotplugHooks(vmi, hooksV1alpha4.HotplugDeviceTypeDisk, attachDisk.Alias.GetName(), hooksV1alpha4.HotplugActionPlug)
 	}
 
 	// Resize and notify the VM about changed disks
@@ -1226,13 +1235,16 @@ func (l *LibvirtDomainManager) syncNetworkHotplug(
 	}
 
 	networkConfigurator := netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}, netsetup.WithDomainAttachments(domainAttachments))
-	networkInterfaceManager := newVirtIOInterfaceManager(dom, networkConfigurator)
+	networkInterfaceManager := newVirtIOInterfaceManager(dom, networkConfigurator, netbinding.GetPluginManager())
 	if err := networkInterfaceManager.hotplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}, domain); err != nil {
 		return err
 	}
 	if err := networkInterfaceManager.hotUnplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}); err != nil {
 		return err
 	}
+	if err := networkInterfaceManager.updateInterfaces(vmi, &api.Domain{Spec: *oldSpec}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1256,9 +1268,46 @@ func (l *LibvirtDomainManager) startDomain(
 	if vmi.ShouldStartPaused() {
 		l.paused.add(vmi.UID)
 	}
+
+	if err := hooks.GetManager().OnVMStarted(vmi); err != nil {
+		logger.Reason(err).Error("executing OnVMStarted hooks failed")
+	}
+	return nil
+}
+
+// setupNetBindingPlugins adds to the domain the interfaces set up by the network binding plugins,
+// which are not created by the converter.
+func setupNetBindingPlugins(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	pluginDevices, err := netbinding.GetPluginManager().Setup(vmi)
+	if err != nil {
+		return err
+	}
+
+	domainIfaces := indexedDomainInterfaces(domain)
+	for _, pluginDevice := range pluginDevices {
+		if _, exists := domainIfaces[pluginDevice.GetInterfaceName()]; exists {
+			continue
+		}
+		iface := netvmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, pluginDevice.GetInterfaceName())
+		if iface == nil {
+			return fmt.Errorf("network binding plugin returned a device for unknown interface %s", pluginDevice.GetInterfaceName())
+		}
+		domainIface, err := converter.NetBindingPluginDomainInterface(vmi, *iface, pluginDevice)
+		if err != nil {
+			return err
+		}
+		domain.Spec.Devices.Interfaces = append(domain.Spec.Devices.Interfaces, domainIface)
+	}
 	return nil
 }
 
+// notifyHotplugHooks informs the hook sidecars about a hotplugged device, a failing hook does not revert the hotplug
+func notifyHotplugHooks(vmi *v1.VirtualMachineInstance, deviceType, deviceName, action string) {
+	if err := hooks.GetManager().OnHotplug(vmi, deviceType, deviceName, action); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("executing OnHotplug hooks for %s %s failed", deviceType, deviceName)
+	}
+}
+
 func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	domain *api.Domain,
 	vmi *v1.VirtualMachineInstance,
@@ -1597,6 +1646,9 @@ func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
 		}
 		logger.Infof("Signaled pause for %s", vmi.GetObjectMeta().GetName())
 		l.paused.add(vmi.UID)
+		if err := hooks.GetManager().OnPause(vmi); err != nil {
+			logger.Reason(err).Error("executing OnPause hooks failed")
+		}
 	} else {
 		logger.Infof("Domain is not running for %s", vmi.GetObjectMeta().GetName())
 	}
@@ -1637,6 +1689,9 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 		}
 		logger.Infof("Signaled unpause for %s", vmi.GetObjectMeta().GetName())
 		l.paused.remove(vmi.UID)
+		if err := hooks.GetManager().OnUnpause(vmi); err != nil {
+			logger.Reason(err).Error("executing OnUnpause hooks failed")
+		}
 		// Try to set guest time after this commands execution.
 		// This operation is not disruptive.
 		if err := l.setGuestTime(vmi); err != nil {
@@ -2134,9 +2189,36 @@ func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgen
 	return guestInfo
 }
 
-// InterfacesStatus returns the interfaces Guest Agent reported
+// InterfacesStatus returns the interfaces Guest Agent reported, completed by the status
+// reported by the network binding plugins for interfaces the Guest Agent does not report.
 func (l *LibvirtDomainManager) InterfacesStatus() []api.InterfaceStatus {
-	return l.agentData.GetInterfaceStatus()
+	return mergeNetBindingPluginInterfacesStatus(l.agentData.GetInterfaceStatus(), netbinding.GetPluginManager().Status())
+}
+
+func mergeNetBindingPluginInterfacesStatus(interfacesStatus []api.InterfaceStatus, pluginInterfacesStatus []*netbindingv2.InterfaceStatus) []api.InterfaceStatus {
+	reportedMACs := map[string]struct{}{}
+	for _, ifaceStatus := range interfacesStatus {
+		reportedMACs[strings.ToLower(ifaceStatus.Mac)] = struct{}{}
+	}
+
+	for _, pluginIfaceStatus := range pluginInterfacesStatus {
+		// The interfaces status is matched with the VMI interfaces by MAC address
+		if pluginIfaceStatus.GetMacAddress() == "" {
+			continue
+		}
+		if _, reported := reportedMACs[strings.ToLower(pluginIfaceStatus.GetMacAddress())]; reported {
+			continue
+		}
+		ifaceStatus := api.InterfaceStatus{
+			Mac: pluginIfaceStatus.GetMacAddress(),
+			IPs: pluginIfaceStatus.GetIpAddresses(),
+		}
+		if len(ifaceStatus.IPs) > 0 {
+			ifaceStatus.Ip = ifaceStatus.IPs[0]
+		}
+		interfacesStatus = append(interfacesStatus, ifaceStatus)
+	}
+	return interfacesStatus
 }
 
 // GetGuestOSInfo returns the Guest OS version and architecture
@@ -33,6 +33,7 @@ import (
 	"time"
 
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
@@ -1668,6 +1669,150 @@ var _ = Describe("Manager", func() {
 			monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
 			monitor.startMonitor()
 		})
+		Context("with adaptive tuning", func() {
+			var vmi *v1.VirtualMachineInstance
+			var manager *LibvirtDomainManager
+			var iteration uint64
+
+			nonConvergingJobInfo := func(throttle int) *libvirt.DomainJobInfo {
+				iteration++
+				return &libvirt.DomainJobInfo{
+					Type:                    libvirt.DOMAIN_JOB_UNBOUNDED,
+					DataRemaining:           uint64(32479827394 - iteration),
+					DataRemainingSet:        true,
+					MemIteration:            iteration,
+					MemIterationSet:         true,
+					MemBps:                  100 * 1024 * 1024,
+					MemBpsSet:               true,
+					MemDirtyRate:            50 * 1024,
+					MemDirtyRateSet:         true,
+					MemPageSize:             4096,
+					MemPageSizeSet:          true,
+					AutoConvergeThrottle:    throttle,
+					AutoConvergeThrottleSet: true,
+				}
+			}
+
+			BeforeEach(func() {
+				iteration = 0
+				vmi = newVMI(testNamespace, testVmName)
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID: "111222333",
+				}
+				migrationMetadata, _ := metadataCache.Migration.Load()
+				migrationMetadata.UID = vmi.Status.MigrationState.MigrationUID
+				metadataCache.Migration.Store(migrationMetadata)
+				manager = &LibvirtDomainManager{
+					virConn:       mockConn,
+					virtShareDir:  testVirtShareDir,
+					metadataCache: metadataCache,
+				}
+			})
+
+			It("should switch to post copy early when the migration does not converge", func() {
+				migrationErrorChan := make(chan error)
+				defer close(migrationErrorChan)
+				options := &cmdclient.MigrationOptions{
+					Bandwidth:               resource.MustParse("64Mi"),
+					ProgressTimeout:         150,
+					CompletionTimeoutPerGiB: 800,
+					AllowPostCopy:           true,
+					AllowAdaptiveTuning:     true,
+				}
+
+				mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+
+				mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().DoAndReturn(func(flag libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error) {
+					if iteration >= adaptiveTuningNonConvergingIterations {
+						return &libvirt.DomainJobInfo{Type: libvirt.DOMAIN_JOB_COMPLETED}, nil
+					}
+					return nonConvergingJobInfo(0), nil
+				})
+				mockDomain.EXPECT().MigrateStartPostCopy(gomock.Eq(uint32(0))).Times(1).Return(nil)
+
+				monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+				monitor.startMonitor()
+			})
+
+			It("should abort once the throttle is at its maximum", func() {
+				migrationErrorChan := make(chan error)
+				defer close(migrationErrorChan)
+				options := &cmdclient.MigrationOptions{
+					Bandwidth:               resource.MustParse("64Mi"),
+					ProgressTimeout:         150,
+					CompletionTimeoutPerGiB: 800,
+					AllowAdaptiveTuning:     true,
+				}
+
+				mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+				mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+
+				mockDomain.EXPECT().GetJobStats(libvirt.DomainGetJobStatsFlags(0)).AnyTimes().DoAndReturn(func(flag libvirt.DomainGetJobStatsFlags) (*libvirt.DomainJobInfo, error) {
+					if iteration >= 2*adaptiveTuningNonConvergingIterations {
+						return nonConvergingJobInfo(adaptiveTuningMaxThrottle), nil
+					}
+					return nonConvergingJobInfo(20), nil
+				})
+				mockDomain.EXPECT().AbortJob().Times(1)
+
+				monitor := newMigrationMonitor(vmi, manager, options, migrationErrorChan)
+				monitor.startMonitor()
+
+				migration, _ := metadataCache.Migration.Load()
+				Expect(migration.Failed).To(BeTrue())
+				Expect(migration.FailureReason).To(ContainSubstring("does not converge"))
+			})
+
+			It("should not tune a migration which converges", func() {
+				monitor := newMigrationMonitor(vmi, manager, &cmdclient.MigrationOptions{AllowAdaptiveTuning: true}, nil)
+				for i := 0; i < 2*adaptiveTuningNonConvergingIterations; i++ {
+					stats := nonConvergingJobInfo(0)
+					stats.MemDirtyRate = 1024
+					Expect(monitor.nextAdaptiveTuningAction(stats)).To(Equal(adaptiveTuningNone))
+				}
+			})
+		})
+
+		DescribeTable("should set the auto-converge parameters", func(allowAdaptiveTuning bool) {
+			vmi := newVMI(testNamespace, testVmName)
+			domainSpec := expectedDomainFor(vmi)
+			domainXml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).ToNot(HaveOccurred())
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).AnyTimes().Return(string(domainXml), nil)
+
+			options := &cmdclient.MigrationOptions{AllowAdaptiveTuning: allowAdaptiveTuning}
+			params, err := generateMigrationParams(mockDomain, vmi, options, testVirtShareDir, domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(params.AutoConvergeInitialSet).To(Equal(allowAdaptiveTuning))
+			Expect(params.AutoConvergeIncrementSet).To(Equal(allowAdaptiveTuning))
+			if allowAdaptiveTuning {
+				Expect(params.AutoConvergeInitial).To(Equal(adaptiveTuningInitialThrottle))
+				Expect(params.AutoConvergeIncrement).To(Equal(adaptiveTuningThrottleIncrement))
+			}
+			Expect(params.ParallelConnectionsSet).To(BeFalse())
+		},
+			Entry("of migrations with adaptive tuning", true),
+			Entry("not for migrations without adaptive tuning", false),
+		)
+
+		DescribeTable("adaptive tuning options", func(options, expected *cmdclient.MigrationOptions) {
+			Expect(withAdaptiveTuning(options)).To(Equal(expected))
+		},
+			Entry("should enable auto-converge without multifd",
+				&cmdclient.MigrationOptions{AllowAdaptiveTuning: true},
+				&cmdclient.MigrationOptions{AllowAdaptiveTuning: true, AllowAutoConverge: true},
+			),
+			Entry("should keep post copy",
+				&cmdclient.MigrationOptions{AllowAdaptiveTuning: true, AllowPostCopy: true},
+				&cmdclient.MigrationOptions{AllowAdaptiveTuning: true, AllowPostCopy: true, AllowAutoConverge: true},
+			),
+			Entry("should keep the configured number of multifd channels",
+				&cmdclient.MigrationOptions{AllowAdaptiveTuning: true, ParallelMigrationThreads: virtpointer.P(uint(8))},
+				&cmdclient.MigrationOptions{AllowAdaptiveTuning: true, AllowAutoConverge: true, ParallelMigrationThreads: virtpointer.P(uint(8))},
+			),
+		)
+
 		// This is incomplete as it is not verifying that we abort. Previously it wasn't even testing anything at all
 		It("migration should be canceled when requested", func() {
 			migrationUid := types.UID("111222333")
@@ -2799,6 +2944,29 @@ var _ = Describe("migratableDomXML", func() {
 
 var _ = Describe("Manager helper functions", func() {
 
+	Context("mergeNetBindingPluginInterfacesStatus", func() {
+		guestAgentIface := api.InterfaceStatus{InterfaceName: "eth0", Mac: "02:00:00:00:00:01", Ip: "10.0.0.1", IPs: []string{"10.0.0.1"}}
+
+		DescribeTable("should report the plugin interfaces status not reported by the guest agent",
+			func(pluginInterfacesStatus []*netbindingv2.InterfaceStatus, expectedInterfacesStatus []api.InterfaceStatus) {
+				Expect(mergeNetBindingPluginInterfacesStatus([]api.InterfaceStatus{guestAgentIface}, pluginInterfacesStatus)).To(Equal(expectedInterfacesStatus))
+			},
+			Entry("without plugin interfaces status", nil, []api.InterfaceStatus{guestAgentIface}),
+			Entry("with an interface reported by the guest agent",
+				[]*netbindingv2.InterfaceStatus{{InterfaceName: "red", MacAddress: "02:00:00:00:00:01", IpAddresses: []string{"10.0.0.9"}}},
+				[]api.InterfaceStatus{guestAgentIface},
+			),
+			Entry("with an interface not reported by the guest agent",
+				[]*netbindingv2.InterfaceStatus{{InterfaceName: "red", MacAddress: "02:00:00:00:00:02", IpAddresses: []string{"10.0.0.2", "fd10::2"}}},
+				[]api.InterfaceStatus{guestAgentIface, {Mac: "02:00:00:00:00:02", Ip: "10.0.0.2", IPs: []string{"10.0.0.2", "fd10::2"}}},
+			),
+			Entry("with an interface without MAC address",
+				[]*netbindingv2.InterfaceStatus{{InterfaceName: "red", IpAddresses: []string{"10.0.0.2"}}},
+				[]api.InterfaceStatus{guestAgentIface},
+			),
+		)
+	})
+
 	Context("getVMIEphemeralDisksTotalSize", func() {
 
 		var tmpDir string
@@ -28,10 +28,15 @@ import (
 
 	"libvirt.org/go/libvirt"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	hooksV1alpha4 "kubevirt.io/kubevirt/pkg/hooks/v1alpha4"
+	"kubevirt.io/kubevirt/pkg/network/domainspec"
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -43,9 +48,16 @@ type vmConfigurator interface {
 	SetupPodNetworkPhase2(domain *api.Domain, networksToPlug []v1.Network) error
 }
 
+type netBindingPlugins interface {
+	Handles(iface v1.Interface) bool
+	Hotplug(vmi *v1.VirtualMachineInstance, ifaceName string) (*netbindingv2.Device, error)
+	Unplug(vmi *v1.VirtualMachineInstance, ifaceName string) error
+}
+
 type virtIOInterfaceManager struct {
 	dom          cli.VirDomain
 	configurator vmConfigurator
+	plugins      netBindingPlugins
 }
 
 const (
@@ -57,10 +69,12 @@ const (
 func newVirtIOInterfaceManager(
 	libvirtClient cli.VirDomain,
 	configurator vmConfigurator,
+	plugins netBindingPlugins,
 ) *virtIOInterfaceManager {
 	return &virtIOInterfaceManager{
 		dom:          libvirtClient,
 		configurator: configurator,
+		plugins:      plugins,
 	}
 }
 
@@ -68,15 +82,11 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 	for _, network := range networksToHotplugWhoseInterfacesAreNotInTheDomain(vmi, indexedDomainInterfaces(currentDomain)) {
 		log.Log.Infof("will hot plug %s", network.Name)
 
-		if err := vim.configurator.SetupPodNetworkPhase2(updatedDomain, []v1.Network{network}); err != nil {
+		relevantIface, err := vim.domainInterfaceToHotplug(vmi, network, updatedDomain)
+		if err != nil {
 			return err
 		}
 
-		relevantIface := lookupDomainInterfaceByName(updatedDomain.Spec.Devices.Interfaces, network.Name)
-		if relevantIface == nil {
-			return fmt.Errorf("could not retrieve the api.Interface object from the dummy domain")
-		}
-
 		ifaceMAC := ""
 		if relevantIface.MAC != nil {
 			ifaceMAC = relevantIface.MAC.MAC
@@ -91,12 +101,46 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 			log.Log.Reason(err).Errorf("libvirt failed to attach interface %s: %v", network.Name, err)
 			return err
 		}
+		notifyHotplugHooks(vmi, hooksV1alpha4.HotplugDeviceTypeInterface, network.Name, hooksV1alpha4.HotplugActionPlug)
 	}
 	return nil
 }
 
+// domainInterfaceToHotplug returns the domain interface of the hotplugged network. Interfaces whose binding is served
+// by a network binding plugin are prepared by the plugin, the others are set up by the VM network configurator.
+func (vim *virtIOInterfaceManager) domainInterfaceToHotplug(vmi *v1.VirtualMachineInstance, network v1.Network, updatedDomain *api.Domain) (*api.Interface, error) {
+	iface := netvmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, network.Name)
+	if iface != nil && vim.plugins.Handles(*iface) {
+		pluginDevice, err := vim.plugins.Hotplug(vmi, iface.Name)
+		if err != nil {
+			return nil, err
+		}
+		domainIface, err := converter.NetBindingPluginDomainInterface(vmi, *iface, pluginDevice)
+		if err != nil {
+			return nil, err
+		}
+		return &domainIface, nil
+	}
+	if iface != nil && iface.Binding != nil {
+		return nil, fmt.Errorf("the %s network binding plugin does not support hotplug", iface.Binding.Name)
+	}
+
+	if err := vim.configurator.SetupPodNetworkPhase2(updatedDomain, []v1.Network{network}); err != nil {
+		return nil, err
+	}
+
+	relevantIface := lookupDomainInterfaceByName(updatedDomain.Spec.Devices.Interfaces, network.Name)
+	if relevantIface == nil {
+		return nil, fmt.Errorf("could not retrieve the api.Interface object from the dummy domain")
+	}
+	return relevantIface, nil
+}
+
 func (vim *virtIOInterfaceManager) hotUnplugVirtioInterface(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain) error {
-	for _, domainIface := range interfacesToHotUnplug(vmi.Spec.Domain.Devices.Interfaces, currentDomain.Spec.Devices.Interfaces) {
+	domainIfacesToRemove := interfacesToHotUnplug(vmi.Spec.Domain.Devices.Interfaces, currentDomain.Spec.Devices.Interfaces)
+	domainIfacesToRemove = append(domainIfacesToRemove,
+		netBindingPluginInterfacesToHotUnplug(vmi.Spec.Domain.Devices.Interfaces, currentDomain.Spec.Devices.Interfaces, vim.plugins)...)
+	for _, domainIface := range domainIfacesToRemove {
 		log.Log.Infof("preparing to hot-unplug %s", domainIface.Alias.GetName())
 
 		ifaceXML, err := xml.Marshal(domainIface)
@@ -108,10 +152,78 @@ func (vim *virtIOInterfaceManager) hotUnplugVirtioInterface(vmi *v1.VirtualMachi
 			log.Log.Reason(derr).Errorf("libvirt failed to detach interface %s: %v", domainIface.Alias.GetName(), derr)
 			return derr
 		}
+		if iface := netvmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, domainIface.Alias.GetName()); iface != nil && vim.plugins.Handles(*iface) {
+			if err := vim.plugins.Unplug(vmi, iface.Name); err != nil {
+				return err
+			}
+		}
+		notifyHotplugHooks(vmi, hooksV1alpha4.HotplugDeviceTypeInterface, domainIface.Alias.GetName(), hooksV1alpha4.HotplugActionUnplug)
 	}
 	return nil
 }
 
+// updateInterfaces sets the link of the domain interfaces up or down and their bandwidth limits according to the
+// VMI spec, without detaching them.
+func (vim *virtIOInterfaceManager) updateInterfaces(vmi *v1.VirtualMachineInstance, currentDomain *api.Domain) error {
+	for _, domainIface := range interfacesToUpdate(vmi.Spec.Domain.Devices.Interfaces, currentDomain.Spec.Devices.Interfaces) {
+		log.Log.Infof("updating interface %s, link state: %s", domainIface.Alias.GetName(), domainIface.LinkState.State)
+
+		ifaceXML, err := xml.Marshal(domainIface)
+		if err != nil {
+			return err
+		}
+
+		if err := vim.dom.UpdateDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			log.Log.Reason(err).Errorf("libvirt failed to update interface %s: %v", domainIface.Alias.GetName(), err)
+			return err
+		}
+	}
+	return nil
+}
+
+func interfacesToUpdate(vmiSpecInterfaces []v1.Interface, domainSpecInterfaces []api.Interface) []api.Interface {
+	var domainIfacesToUpdate []api.Interface
+	for _, vmiIface := range vmiSpecInterfaces {
+		if vmiIface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		domainIface := lookupDomainInterfaceByName(domainSpecInterfaces, vmiIface.Name)
+		if domainIface == nil {
+			continue
+		}
+
+		requestedLinkState := v1.InterfaceStateUp
+		if vmiIface.State == v1.InterfaceStateDown {
+			requestedLinkState = v1.InterfaceStateDown
+		}
+		linkStateChanged := domainInterfaceLinkState(*domainIface) != requestedLinkState
+
+		// Bandwidth limits are applied only to interfaces backed by a tap device
+		requestedBandWidth := domainIface.BandWidth
+		if domainIface.Type == "ethernet" {
+			requestedBandWidth = domainspec.NewBandWidth(vmiIface.Bandwidth)
+		}
+		bandWidthChanged := !equality.Semantic.DeepEqual(domainIface.BandWidth, requestedBandWidth)
+
+		if !linkStateChanged && !bandWidthChanged {
+			continue
+		}
+
+		domainIface.LinkState = &api.LinkState{State: string(requestedLinkState)}
+		domainIface.BandWidth = requestedBandWidth
+		domainIfacesToUpdate = append(domainIfacesToUpdate, *domainIface)
+	}
+	return domainIfacesToUpdate
+}
+
+// domainInterfaceLinkState returns the link state of a domain interface, which is up unless it is set down
+func domainInterfaceLinkState(domainIface api.Interface) v1.InterfaceState {
+	if domainIface.LinkState != nil && domainIface.LinkState.State == string(v1.InterfaceStateDown) {
+		return v1.InterfaceStateDown
+	}
+	return v1.InterfaceStateUp
+}
+
 func interfacesToHotUnplug(vmiSpecInterfaces []v1.Interface, domainSpecInterfaces []api.Interface) []api.Interface {
 	ifaces2remove := netvmispec.FilterInterfacesSpec(vmiSpecInterfaces, func(iface v1.Interface) bool {
 		return iface.State == v1.InterfaceStateAbsent
@@ -127,6 +239,22 @@ func interfacesToHotUnplug(vmiSpecInterfaces []v1.Interface, domainSpecInterface
 	return domainIfacesToRemove
 }
 
+// netBindingPluginInterfacesToHotUnplug returns the domain interfaces of the absent interfaces whose binding is
+// served by a network binding plugin. Their devices are named by the plugin.
+func netBindingPluginInterfacesToHotUnplug(vmiSpecInterfaces []v1.Interface, domainSpecInterfaces []api.Interface, plugins netBindingPlugins) []api.Interface {
+	var domainIfacesToRemove []api.Interface
+	for _, vmiIface := range vmiSpecInterfaces {
+		if vmiIface.State != v1.InterfaceStateAbsent || !plugins.Handles(vmiIface) {
+			continue
+		}
+		// Interfaces using a tap device named by KubeVirt are already selected by interfacesToHotUnplug
+		if domainIface := lookupDomainInterfaceByName(domainSpecInterfaces, vmiIface.Name); domainIface != nil && !hasDeviceWithHashedTapName(domainIface.Target, vmiIface) {
+			domainIfacesToRemove = append(domainIfacesToRemove, *domainIface)
+		}
+	}
+	return domainIfacesToRemove
+}
+
 func hasDeviceWithHashedTapName(target *api.InterfaceTarget, vmiIface v1.Interface) bool {
 	return target != nil &&
 		target.Device == virtnetlink.GenerateTapDeviceName(namescheme.GenerateHashedInterfaceName(vmiIface.Name))
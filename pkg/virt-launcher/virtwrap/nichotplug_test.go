@@ -31,8 +31,11 @@ import (
 
 	"libvirt.org/go/libvirt"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "kubevirt.io/api/core/v1"
 
+	netbindingv2 "kubevirt.io/kubevirt/pkg/network/netbinding/v2"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
@@ -147,6 +150,7 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			networkInterfaceManager := newVirtIOInterfaceManager(
 				mockLibvirtClient(gomock.NewController(GinkgoT()), result),
 				&fakeVMConfigurator{},
+				&fakeNetBindingPlugins{},
 			)
 			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)).To(Succeed())
 		},
@@ -171,6 +175,7 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			networkInterfaceManager := newVirtIOInterfaceManager(
 				mockLibvirtClient(gomock.NewController(GinkgoT()), result),
 				configurator,
+				&fakeNetBindingPlugins{},
 			)
 			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, currentDomain, updatedDomain)).To(MatchError("boom"))
 		},
@@ -189,6 +194,74 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			libvirtClientResult{expectedError: fmt.Errorf("boom")},
 		),
 	)
+
+	Context("with a network binding plugin", func() {
+		const bindingName = "vendor-binding"
+
+		var (
+			vmi     *v1.VirtualMachineInstance
+			plugins *fakeNetBindingPlugins
+		)
+
+		BeforeEach(func() {
+			vmi = vmiWithSingleBridgeInterfaceWithPodInterfaceReady(networkName, nadName)
+			vmi.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{}
+			vmi.Spec.Domain.Devices.Interfaces[0].Binding = &v1.PluginBinding{Name: bindingName}
+			plugins = &fakeNetBindingPlugins{
+				bindingName: bindingName,
+				device:      &netbindingv2.Device{Type: netbindingv2.DeviceTypeVhostUser, SocketPath: "/var/run/plugin/n1.sock"},
+			}
+		})
+
+		It("attaches the device prepared by the plugin", func() {
+			mockDomain := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+			mockDomain.EXPECT().AttachDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).DoAndReturn(
+				func(ifaceXML string, _ libvirt.DomainDeviceModifyFlags) error {
+					Expect(ifaceXML).To(ContainSubstring(`<interface type="vhostuser">`))
+					Expect(ifaceXML).To(ContainSubstring(`<source type="unix" path="/var/run/plugin/n1.sock" mode="client"></source>`))
+					return nil
+				})
+
+			configurator := &fakeVMConfigurator{expectedError: fmt.Errorf("the configurator should not be called")}
+			networkInterfaceManager := newVirtIOInterfaceManager(mockDomain, configurator, plugins)
+			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, dummyDomain(), dummyDomain())).To(Succeed())
+			Expect(plugins.calls).To(Equal([]string{"Hotplug " + networkName}))
+		})
+
+		It("fails when the plugin fails", func() {
+			plugins.err = fmt.Errorf("boom")
+			networkInterfaceManager := newVirtIOInterfaceManager(
+				mockLibvirtClient(gomock.NewController(GinkgoT()), libvirtClientResult{}),
+				&fakeVMConfigurator{},
+				plugins,
+			)
+			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, dummyDomain(), dummyDomain())).To(MatchError("boom"))
+		})
+
+		It("fails when the binding is not served by a plugin supporting hotplug", func() {
+			plugins.bindingName = "other-binding"
+			networkInterfaceManager := newVirtIOInterfaceManager(
+				mockLibvirtClient(gomock.NewController(GinkgoT()), libvirtClientResult{}),
+				&fakeVMConfigurator{},
+				plugins,
+			)
+			Expect(networkInterfaceManager.hotplugVirtioInterface(vmi, dummyDomain(), dummyDomain())).To(
+				MatchError("the vendor-binding network binding plugin does not support hotplug"))
+			Expect(plugins.calls).To(BeEmpty())
+		})
+
+		It("detaches the absent interface and informs the plugin", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+			currentDomain := dummyDomain(networkName)
+
+			mockDomain := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+			mockDomain.EXPECT().DetachDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+
+			networkInterfaceManager := newVirtIOInterfaceManager(mockDomain, &fakeVMConfigurator{}, plugins)
+			Expect(networkInterfaceManager.hotUnplugVirtioInterface(vmi, currentDomain)).To(Succeed())
+			Expect(plugins.calls).To(Equal([]string{"Unplug " + networkName}))
+		})
+	})
 })
 
 var _ = Describe("nic hot-unplug on virt-launcher", func() {
@@ -235,6 +308,109 @@ var _ = Describe("nic hot-unplug on virt-launcher", func() {
 	)
 })
 
+var _ = Describe("nic link state and bandwidth on virt-launcher", func() {
+	const networkName = "n1"
+
+	linkDown := &api.LinkState{State: "down"}
+	linkUp := &api.LinkState{State: "up"}
+
+	inboundBandwidth := &v1.InterfaceBandwidth{
+		Inbound: &v1.InterfaceBandwidthLimit{Average: resource.MustParse("10Mi")},
+	}
+	inboundBandWidth := &api.BandWidth{Inbound: &api.BandWidthLimit{Average: 10240}}
+
+	DescribeTable("domain interfaces to update",
+		func(vmiSpecIfaces []v1.Interface, domainSpecIfaces []api.Interface, expectedDomainSpecIfaces []api.Interface) {
+			Expect(interfacesToUpdate(vmiSpecIfaces, domainSpecIfaces)).To(ConsistOf(expectedDomainSpecIfaces))
+		},
+		Entry("given 1 VMI interface without state and an associated interface in the domain",
+			[]v1.Interface{{Name: networkName}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName)}},
+			nil,
+		),
+		Entry("given 1 VMI down interface and an associated interface in the domain",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName)}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+		),
+		Entry("given 1 VMI down interface and an associated interface in the domain which is down",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			nil,
+		),
+		Entry("given 1 VMI up interface and an associated interface in the domain which is down",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateUp}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkUp}},
+		),
+		Entry("given 1 VMI interface without state and an associated interface in the domain which is down",
+			[]v1.Interface{{Name: networkName}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkUp}},
+		),
+		Entry("given 1 VMI absent interface and an associated interface in the domain which is down",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateAbsent}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias(networkName), LinkState: linkDown}},
+			nil,
+		),
+		Entry("given 1 VMI down interface and no associated interface in the domain",
+			[]v1.Interface{{Name: networkName, State: v1.InterfaceStateDown}},
+			nil,
+			nil,
+		),
+		Entry("given 1 VMI interface with bandwidth and an associated tap interface in the domain without bandwidth",
+			[]v1.Interface{{Name: networkName, Bandwidth: inboundBandwidth}},
+			[]api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName)}},
+			[]api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName), LinkState: linkUp, BandWidth: inboundBandWidth}},
+		),
+		Entry("given 1 VMI interface with bandwidth and an associated tap interface in the domain with the same bandwidth",
+			[]v1.Interface{{Name: networkName, Bandwidth: inboundBandwidth}},
+			[]api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName), BandWidth: inboundBandWidth}},
+			nil,
+		),
+		Entry("given 1 VMI interface without bandwidth and an associated tap interface in the domain with bandwidth",
+			[]v1.Interface{{Name: networkName}},
+			[]api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName), BandWidth: inboundBandWidth}},
+			[]api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName), LinkState: linkUp}},
+		),
+		Entry("given 1 VMI interface with bandwidth and an associated interface in the domain which is not a tap",
+			[]v1.Interface{{Name: networkName, Bandwidth: inboundBandwidth}},
+			[]api.Interface{{Type: "user", Alias: api.NewUserDefinedAlias(networkName)}},
+			nil,
+		),
+	)
+
+	It("should set the link of the domain interface down", func() {
+		mockDomain := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: networkName, State: v1.InterfaceStateDown}}
+		domain := &api.Domain{}
+		domain.Spec.Devices.Interfaces = []api.Interface{{Alias: api.NewUserDefinedAlias(networkName)}}
+
+		mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).DoAndReturn(
+			func(ifaceXML string, _ libvirt.DomainDeviceModifyFlags) error {
+				Expect(ifaceXML).To(ContainSubstring(`<link state="down"></link>`))
+				return nil
+			})
+		Expect(newVirtIOInterfaceManager(mockDomain, &fakeVMConfigurator{}, &fakeNetBindingPlugins{}).updateInterfaces(vmi, domain)).To(Succeed())
+	})
+
+	It("should update the bandwidth of the domain interface", func() {
+		mockDomain := cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: networkName, Bandwidth: inboundBandwidth}}
+		domain := &api.Domain{}
+		domain.Spec.Devices.Interfaces = []api.Interface{{Type: "ethernet", Alias: api.NewUserDefinedAlias(networkName)}}
+
+		mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), affectDeviceLiveAndConfigLibvirtFlags).DoAndReturn(
+			func(ifaceXML string, _ libvirt.DomainDeviceModifyFlags) error {
+				Expect(ifaceXML).To(ContainSubstring(`<bandwidth><inbound average="10240"></inbound></bandwidth>`))
+				return nil
+			})
+		Expect(newVirtIOInterfaceManager(mockDomain, &fakeVMConfigurator{}, &fakeNetBindingPlugins{}).updateInterfaces(vmi, domain)).To(Succeed())
+	})
+})
+
 var _ = Describe("domain network interfaces resources", func() {
 
 	DescribeTable("are ignored when",
@@ -377,3 +553,24 @@ type fakeVMConfigurator struct {
 func (fvc *fakeVMConfigurator) SetupPodNetworkPhase2(*api.Domain, []v1.Network) error {
 	return fvc.expectedError
 }
+
+type fakeNetBindingPlugins struct {
+	bindingName string
+	device      *netbindingv2.Device
+	err         error
+	calls       []string
+}
+
+func (f *fakeNetBindingPlugins) Handles(iface v1.Interface) bool {
+	return iface.Binding != nil && iface.Binding.Name == f.bindingName
+}
+
+func (f *fakeNetBindingPlugins) Hotplug(_ *v1.VirtualMachineInstance, ifaceName string) (*netbindingv2.Device, error) {
+	f.calls = append(f.calls, "Hotplug "+ifaceName)
+	return f.device, f.err
+}
+
+func (f *fakeNetBindingPlugins) Unplug(_ *v1.VirtualMachineInstance, ifaceName string) error {
+	f.calls = append(f.calls, "Unplug "+ifaceName)
+	return f.err
+}
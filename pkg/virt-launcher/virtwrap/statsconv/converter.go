@@ -221,13 +221,25 @@ func Convert_libvirt_DomainJobInfo_To_stats_DomainJobInfo(info *libvirt.DomainJo
 	}
 
 	return &stats.DomainJobInfo{
-		DataProcessedSet: info.DataProcessedSet,
-		DataProcessed:    info.DataProcessed,
-		MemoryBpsSet:     info.MemBpsSet,
-		MemoryBps:        info.MemBps,
-		DataRemainingSet: info.DataRemainingSet,
-		DataRemaining:    info.DataRemaining,
-		MemDirtyRateSet:  info.MemDirtyRateSet && info.MemPageSizeSet,
-		MemDirtyRate:     info.MemDirtyRate * info.MemPageSize,
+		DataProcessedSet:        info.DataProcessedSet,
+		DataProcessed:           info.DataProcessed,
+		MemoryBpsSet:            info.MemBpsSet,
+		MemoryBps:               info.MemBps,
+		DataRemainingSet:        info.DataRemainingSet,
+		DataRemaining:           info.DataRemaining,
+		MemDirtyRateSet:         info.MemDirtyRateSet && info.MemPageSizeSet,
+		MemDirtyRate:            info.MemDirtyRate * info.MemPageSize,
+		TimeElapsedSet:          info.TimeElapsedSet,
+		TimeElapsed:             info.TimeElapsed,
+		DataTotalSet:            info.DataTotalSet,
+		DataTotal:               info.DataTotal,
+		MemIterationSet:         info.MemIterationSet,
+		MemIteration:            info.MemIteration,
+		DowntimeSet:             info.DowntimeSet,
+		Downtime:                info.Downtime,
+		AutoConvergeThrottleSet: info.AutoConvergeThrottleSet,
+		AutoConvergeThrottle:    info.AutoConvergeThrottle,
+		MemPostcopyReqsSet:      info.MemPostcopyReqsSet,
+		MemPostcopyReqs:         info.MemPostcopyReqs,
 	}
 }
@@ -199,7 +199,19 @@ var Testdataexpected = `{
      "MemDirtyRate": 0,
      "MemDirtyRateSet": false,
      "MemoryBpsSet": false,
-     "MemoryBps": 0
+     "MemoryBps": 0,
+     "TimeElapsed": 0,
+     "TimeElapsedSet": false,
+     "DataTotal": 0,
+     "DataTotalSet": false,
+     "MemIteration": 0,
+     "MemIterationSet": false,
+     "Downtime": 0,
+     "DowntimeSet": false,
+     "AutoConvergeThrottle": 0,
+     "AutoConvergeThrottleSet": false,
+     "MemPostcopyReqs": 0,
+     "MemPostcopyReqsSet": false
    },
    "Name": "testName", 
    "Net": [
@@ -36,11 +36,14 @@ import (
 
 const QEMUSeaBiosDebugPipe = converter.QEMUSeaBiosDebugPipe
 const (
-	qemuConfPath        = "/etc/libvirt/qemu.conf"
-	virtqemudConfPath   = "/etc/libvirt/virtqemud.conf"
-	libvirtRuntimePath  = "/var/run/libvirt"
-	libvirtHomePath     = "/var/run/kubevirt-private/libvirt"
-	qemuNonRootConfPath = libvirtHomePath + "/qemu.conf"
+	qemuConfPath         = "/etc/libvirt/qemu.conf"
+	virtqemudConfPath    = "/etc/libvirt/virtqemud.conf"
+	libvirtRuntimePath   = "/var/run/libvirt"
+	libvirtHomePath      = "/var/run/kubevirt-private/libvirt"
+	qemuNonRootConfPath  = libvirtHomePath + "/qemu.conf"
+	qemuHooksPath        = "/etc/libvirt/hooks"
+	qemuNonRootHooksPath = libvirtHomePath + "/hooks"
+	qemuHookBinaryPath   = "/usr/bin/virt-launcher-qemu-hook"
 )
 
 var LifeCycleTranslationMap = map[libvirt.DomainState]api.LifeCycle{
@@ -517,6 +520,24 @@ func (l LibvirtWrapper) SetupLibvirt(customLogFilters *string) (err error) {
 	return nil
 }
 
+// InstallQemuHook installs virt-launcher-qemu-hook as libvirt qemu hook, it lets the hook sidecars mutate the domain
+// received on the migration target. It has to be called before virtqemud is started.
+func (l LibvirtWrapper) InstallQemuHook() error {
+	hooksPath := qemuHooksPath
+	if !l.root() {
+		hooksPath = qemuNonRootHooksPath
+	}
+
+	if err := os.MkdirAll(hooksPath, 0755); err != nil {
+		return err
+	}
+	err := os.Symlink(qemuHookBinaryPath, path.Join(hooksPath, "qemu"))
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+	return nil
+}
+
 // getLibvirtLogFilters returns libvirt debug log filters that should be enabled if enableDebugLogs is true.
 // The decision is based on the following logic:
 //   - If custom log filters are defined - they should be enabled and used.
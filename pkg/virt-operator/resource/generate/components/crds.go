@@ -26,6 +26,10 @@ import (
 
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 
+	"kubevirt.io/api/hooks"
+
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
+
 	"kubevirt.io/api/instancetype"
 
 	"kubevirt.io/api/migrations"
@@ -66,9 +70,11 @@ var (
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE   = "virtualmachinesnapshotschedules." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1beta1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clonev1alpha1.VirtualMachineCloneKind.Group
+	HOOKSIDECAR                      = "hooksidecars." + hooksv1alpha1.HookSidecarKind.Group
 	PreserveUnknownFieldsFalse       = false
 )
 
@@ -544,6 +550,50 @@ func NewVirtualMachineSnapshotContentCrd() (*extv1.CustomResourceDefinition, err
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1beta1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1beta1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Conversion: &extv1.CustomResourceConversion{
+			Strategy: extv1.NoneConverter,
+		},
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+		{Name: "Disabled", Type: "boolean", JSONPath: ".spec.disabled"},
+		{Name: "LastScheduleTime", Type: "date", JSONPath: ".status.lastScheduleTime"},
+		{Name: "NextScheduleTime", Type: "date", JSONPath: ".status.nextScheduleTime"},
+		{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -888,6 +938,45 @@ func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewHookSidecarCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = HOOKSIDECAR
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: hooksv1alpha1.HookSidecarKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    hooksv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   hooks.ResourceHookSidecars,
+			Singular: "hooksidecar",
+			Kind:     hooksv1alpha1.HookSidecarKind.Kind,
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Image", Type: "string", JSONPath: ".spec.image"},
+		{Name: "Version", Type: "string", JSONPath: ".spec.version"},
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 // NewKubeVirtPriorityClassCR is used for manifest generation
 func NewKubeVirtPriorityClassCR() *schedulingv1.PriorityClass {
 	return &schedulingv1.PriorityClass{
@@ -194,6 +194,11 @@ func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVe
 			Protocol:      corev1.ProtocolTCP,
 			ContainerPort: 8443,
 		},
+		{
+			Name:          "migration-ep",
+			Protocol:      corev1.ProtocolTCP,
+			ContainerPort: 8187,
+		},
 	}
 	container.SecurityContext = &corev1.SecurityContext{
 		Privileged: pointer.Bool(true),
@@ -296,11 +301,8 @@ func NewHandlerDaemonSet(namespace, repository, imagePrefix, version, launcherVe
 		})
 	}
 
-	// Use the downward API to access the network status annotations
-	// TODO: This is not used anymore, but can't be removed because of https://github.com/kubevirt/kubevirt/issues/10632
-	//   Since CR-based updates use the wrong install strategy, removing this volume and downgrading via CR will try to
-	//   run the previous version of virt-handler without the volume, which will fail and CrashLoop.
-	//   Please remove the volume once the above issue is fixed.
+	// Use the downward API to access the network status annotations.
+	// They are used to find the address of virt-handler on the migration networks of migration policies.
 	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
 		Name:      "podinfo",
 		MountPath: "/etc/podinfo",
@@ -649,6 +649,157 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"hooksidecar": `openAPIV3Schema:
+  description: |-
+    HookSidecar registers a hook sidecar approved by the cluster admin.
+    VMIs reference it by name or get it injected when they match its selector.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        args:
+          description: Args are passed to the sidecar container
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        image:
+          description: Image of the sidecar container
+          type: string
+        imagePullPolicy:
+          description: ImagePullPolicy of the sidecar container
+          type: string
+        resources:
+          description: Resources of the sidecar container, defaults to the resources
+            of all the hook sidecars
+          properties:
+            claims:
+              description: |-
+                Claims lists the names of resources, defined in spec.resourceClaims,
+                that are used by this container.
+
+
+                This is an alpha field and requires enabling the
+                DynamicResourceAllocation feature gate.
+
+
+                This field is immutable. It can only be set for containers.
+              items:
+                description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                properties:
+                  name:
+                    description: |-
+                      Name must match the name of one entry in pod.spec.resourceClaims of
+                      the Pod where this field is used. It makes that resource available
+                      inside a container.
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            limits:
+              additionalProperties:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              description: |-
+                Limits describes the maximum amount of compute resources allowed.
+                More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+              type: object
+            requests:
+              additionalProperties:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              description: |-
+                Requests describes the minimum amount of compute resources required.
+                If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+              type: object
+          type: object
+        selector:
+          description: |-
+            Selector matches the labels of the VMIs the sidecar is injected into,
+            in addition to the VMIs referencing it by name
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+        version:
+          description: |-
+            Version of the hook API exposed by the sidecar, e.g. v1alpha3.
+            It is passed to the sidecar with the --version argument, as expected by the sidecar-shim.
+          type: string
+      required:
+      - image
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"kubevirt": `openAPIV3Schema:
   description: KubeVirt represents the object deploying all KubeVirt resources
@@ -1174,6 +1325,13 @@ var CRDsValidation map[string]string = map[string]string{
                 Can be overridden for specific groups of VMs though migration policies.
                 Visit https://kubevirt.io/user-guide/operations/migration_policies/ for more information.
               properties:
+                allowAdaptiveTuning:
+                  description: |-
+                    AllowAdaptiveTuning lets migrations react to guests that dirty their memory faster than it is transferred.
+                    Such migrations start with auto-converge enabled, throttling the CPU by 30% and by another 20% for each
+                    iteration which does not converge. They switch to post-copy early if AllowPostCopy is true, otherwise
+                    they are aborted once the CPU throttle reached its maximum. Defaults to false
+                  type: boolean
                 allowAutoConverge:
                   description: |-
                     AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
@@ -1207,6 +1365,33 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                maintenanceWindows:
+                  description: |-
+                    MaintenanceWindows restricts when migrations that are not urgent are allowed to start.
+                    Workload update migrations, migrations triggered by evictions on nodes that are not being drained and
+                    user-requested migrations that are not marked as urgent wait until one of the windows opens.
+                    Defaults to none, meaning that migrations start as soon as possible
+                  items:
+                    description: MaintenanceWindow is a recurring time window in which
+                      migrations that are not urgent are allowed to start.
+                    properties:
+                      duration:
+                        description: |-
+                          Duration is how long the window stays open. Migrations that started in the window are not
+                          interrupted when it closes
+                        type: string
+                      schedule:
+                        description: |-
+                          Schedule is a cron expression, evaluated in UTC, of the times the window opens.
+                          It has the standard five fields (minute, hour, day of month, month and day of week),
+                          e.g. "0 22 * * 1-5" opens the window at 22:00 on weekdays
+                        type: string
+                    required:
+                    - duration
+                    - schedule
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -3921,6 +4106,8 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        allowAdaptiveTuning:
+          type: boolean
         allowAutoConverge:
           type: boolean
         allowPostCopy:
@@ -3934,6 +4121,65 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        disableTLS:
+          description: |-
+            DisableTLS disables the additional layer of encryption KubeVirt provides for live migrations
+            of the matched VMIs. Overrides the cluster-wide value.
+          type: boolean
+        maintenanceWindows:
+          description: |-
+            MaintenanceWindows restricts when migrations of the matched VMIs that are not urgent are allowed
+            to start. They replace the cluster-wide maintenance windows.
+          items:
+            description: MaintenanceWindow is a recurring time window in which migrations
+              that are not urgent are allowed to start.
+            properties:
+              duration:
+                description: |-
+                  Duration is how long the window stays open. Migrations that started in the window are not
+                  interrupted when it closes
+                type: string
+              schedule:
+                description: |-
+                  Schedule is a cron expression, evaluated in UTC, of the times the window opens.
+                  It has the standard five fields (minute, hour, day of month, month and day of week),
+                  e.g. "0 22 * * 1-5" opens the window at 22:00 on weekdays
+                type: string
+            required:
+            - duration
+            - schedule
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        network:
+          description: |-
+            Network is the name of the CNI network to use for the migrations of the matched VMIs.
+            virt-handler pods have to be attached to this network.
+          type: string
+        parallelMigrationsPerCluster:
+          description: |-
+            ParallelMigrationsPerCluster is the total number of concurrent live migrations of the VMIs
+            matched by this policy allowed cluster-wide, on top of the cluster-wide limit.
+          format: int32
+          type: integer
+        parallelOutboundMigrationsPerNode:
+          description: |-
+            ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations
+            of the VMIs matched by this policy allowed per node, on top of the cluster-wide limit.
+          format: int32
+          type: integer
+        priority:
+          description: |-
+            Priority breaks the tie between policies that match a VMI with the same number of labels.
+            The policy with the highest priority is applied. Defaults to 0
+          format: int32
+          type: integer
+        progressTimeout:
+          description: |-
+            ProgressTimeout is the maximum number of seconds a live migration of the matched VMIs is allowed
+            to make no progress before it is cancelled. Overrides the cluster-wide value.
+          format: int64
+          type: integer
         selectors:
           properties:
             namespaceSelector:
@@ -3945,11 +4191,80 @@ var CRDsValidation map[string]string = map[string]string{
                 type: string
               type: object
           type: object
+        unsafeMigrationOverride:
+          description: |-
+            UnsafeMigrationOverride allows live migrations of the matched VMIs to occur even if the
+            compatibility check indicates the migration will be unsafe to the guest. Overrides the
+            cluster-wide value.
+          type: boolean
       required:
       - selectors
       type: object
     status:
       nullable: true
+      properties:
+        conflicts:
+          description: |-
+            Conflicts lists the VMIs that other policies match with the same precedence as this policy.
+            The list is sorted by namespace and name, and truncated to 100 entries.
+          items:
+            description: |-
+              MigrationPolicyConflict describes a VMI matched by several policies with the same precedence,
+              i.e. the same number of matching labels and the same priority
+            properties:
+              appliedPolicy:
+                description: AppliedPolicy is the policy that is applied to the VMI,
+                  chosen by name in lexicographic order
+                type: string
+              policies:
+                description: Policies are the names of all the policies that match
+                  the VMI with the same precedence
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              virtualMachineInstance:
+                description: MigrationPolicyVirtualMachineInstance references a VMI
+                  matched by a migration policy
+                properties:
+                  name:
+                    type: string
+                  namespace:
+                    type: string
+                required:
+                - name
+                - namespace
+                type: object
+            required:
+            - appliedPolicy
+            - policies
+            - virtualMachineInstance
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        matchedVirtualMachineInstances:
+          description: MatchedVirtualMachineInstances is the number of VMIs this policy
+            currently applies to
+          format: int32
+          type: integer
+        virtualMachineInstances:
+          description: |-
+            VirtualMachineInstances lists the VMIs this policy currently applies to.
+            The list is sorted by namespace and name, and truncated to 100 entries.
+          items:
+            description: MigrationPolicyVirtualMachineInstance references a VMI matched
+              by a migration policy
+            properties:
+              name:
+                type: string
+              namespace:
+                type: string
+            required:
+            - name
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
       type: object
   required:
   - spec
@@ -6325,6 +6640,80 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic passing through the interface.
+                                  It can be updated on a running VM when the HotplugNICs feature gate is enabled and the
+                                  LiveUpdate VM rollout strategy is used, otherwise the update requires a restart.
+                                  Only supported for bridge and masquerade interfaces.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Average is the average rate, in bytes per second, the traffic is shaped to.
+                                          For example: 10Mi
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which can be sent at Peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                          Must not be lower than Average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Average is the average rate, in bytes per second, the traffic is shaped to.
+                                          For example: 10Mi
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which can be sent at Peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                          Must not be lower than Average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6358,6 +6747,31 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  ipv6:
+                                    description: |-
+                                      If specified will configure the IPv6 options passed to the interface.
+                                      Only supported for interfaces with masquerade binding.
+                                    properties:
+                                      delegatedPrefix:
+                                        description: |-
+                                          If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).
+                                          The prefix is routed to the guest address and its traffic leaves the pod through the NAT.
+                                        type: string
+                                      routerAdvertisements:
+                                        description: |-
+                                          If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.
+                                          They announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest
+                                          to get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the
+                                          VM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded
+                                          to the DHCPv6 address.
+                                        type: boolean
+                                    type: object
+                                  mtu:
+                                    description: |-
+                                      If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.
+                                      It must not exceed the MTU of the pod interface.
+                                    format: int32
+                                    type: integer
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -6386,6 +6800,37 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  routes:
+                                    description: If specified will replace the classless
+                                      static routes (option 121) computed from the
+                                      pod interface.
+                                    items:
+                                      description: DHCPRoute is a classless static
+                                        route passed to the interface via DHCP option
+                                        121.
+                                      properties:
+                                        destination:
+                                          description: |-
+                                            Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.
+                                            Required.
+                                          type: string
+                                        gateway:
+                                          description: |-
+                                            Gateway is the IPv4 address of the route next hop.
+                                            When omitted the destination is reachable directly on the link.
+                                          type: string
+                                      required:
+                                      - destination
+                                      type: object
+                                    type: array
+                                  searchDomains:
+                                    description: If specified will replace the DNS
+                                      search domains (option 119, and option 24 of
+                                      DHCPv6 for masquerade binding) computed from
+                                      the pod.
+                                    items:
+                                      type: string
+                                    type: array
                                   tftpServerName:
                                     description: If specified will pass option 66
                                       to interface's DHCP server
@@ -6402,8 +6847,11 @@ var CRDsValidation map[string]string = map[string]string{
                                   Deprecated: Removed in v1.3
                                 type: object
                               masquerade:
-                                description: InterfaceMasquerade connects to a given
-                                  network using netfilter rules to nat the traffic.
+                                description: |-
+                                  InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+                                  Traffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded
+                                  back to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is
+                                  sent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.
                                 type: object
                               model:
                                 description: |-
@@ -6437,6 +6885,13 @@ var CRDsValidation map[string]string = map[string]string{
                                     Default protocol TCP.
                                     The port field is mandatory
                                   properties:
+                                    endPort:
+                                      description: |-
+                                        EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.
+                                        It must not be lower than Port.
+                                        Supported only by the masquerade binding.
+                                      format: int32
+                                      type: integer
                                     name:
                                       description: |-
                                         If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -6451,7 +6906,8 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: integer
                                     protocol:
                                       description: |-
-                                        Protocol for port. Must be UDP or TCP.
+                                        Protocol for port. Must be UDP, TCP or ALL.
+                                        ALL forwards the port for any transport protocol and is supported only by the masquerade binding.
                                         Defaults to "TCP".
                                       type: string
                                   required:
@@ -6470,7 +6926,8 @@ var CRDsValidation map[string]string = map[string]string{
                               state:
                                 description: |-
                                   State represents the requested operational state of the interface.
-                                  The (only) value supported is 'absent', expressing a request to remove the interface.
+                                  The values supported are 'absent', expressing a request to remove the interface, and 'up' and 'down',
+                                  which set the link state of the interface without removing it. Defaults to 'up'
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
@@ -8504,7 +8961,16 @@ var CRDsValidation map[string]string = map[string]string{
             NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
             be generated automatically.
           type: string
-        source:
+        replicas:
+          description: |-
+            Replicas is the number of VirtualMachines cloned out of the source. Defaults to 1, at most 100.
+            All the replicas are restored from a single snapshot of the source. Each replica gets its own MAC
+            addresses and SMBios serial: the addresses in NewMacAddresses are incremented by the replica index
+            and the replica index is appended to NewSMBiosSerial.
+            Cloning more than one replica is only supported for VirtualMachine targets in the clone's namespace.
+          format: int32
+          type: integer
+        source:
           description: |-
             Source is the object that would be cloned. Currently supported source types are:
             VirtualMachine of kubevirt.io API group,
@@ -8532,10 +8998,14 @@ var CRDsValidation map[string]string = map[string]string{
             Target is the outcome of the cloning process.
             Currently supported source types are:
             - VirtualMachine of kubevirt.io API group
+            - VirtualMachineSnapshot of snapshot.kubevirt.io API group
             - Empty (nil).
             If the target is not provided, the target type would default to VirtualMachine and a random
             name would be generated for the target. The target's name can be viewed by
             inspecting status "TargetName" field below.
+            A VirtualMachineSnapshot target can only be cloned from a VirtualMachine source. The snapshot
+            is not owned by the clone and is kept after the clone is deleted, so that it can be used as
+            the source of further clones.
           properties:
             apiGroup:
               description: |-
@@ -8554,6 +9024,19 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
           x-kubernetes-map-type: atomic
+        targetNamePattern:
+          description: |-
+            TargetNamePattern is the pattern the names of the replicas are generated from, where "{{ .Index }}"
+            is replaced by the index of the replica. Defaults to "<target name>-{{ .Index }}".
+            Only used when cloning more than one replica.
+          type: string
+        targetNamespace:
+          description: |-
+            TargetNamespace is the namespace the target VirtualMachine is created in. Defaults to the
+            namespace of the clone. When it differs from the clone's namespace, the target must be a named
+            VirtualMachine, the requester must be allowed to create VirtualMachines and PersistentVolumeClaims
+            in the target namespace and the cloned volumes are moved there using CDI ObjectTransfers.
+          type: string
         template:
           description: For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
           properties:
@@ -8612,6 +9095,29 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         phase:
           type: string
+        replicas:
+          description: Replicas reports the progress of each replica when cloning
+            more than one replica
+          items:
+            description: VirtualMachineCloneReplicaStatus is the status of a single
+              replica of a clone
+            properties:
+              index:
+                format: int32
+                type: integer
+              phase:
+                type: string
+              restoreName:
+                nullable: true
+                type: string
+              targetName:
+                nullable: true
+                type: string
+            required:
+            - index
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         restoreName:
           nullable: true
           type: string
@@ -9547,6 +10053,13 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
         resource
       properties:
+        baseSnapshot:
+          description: |-
+            BaseSnapshot is the name of a VirtualMachineSnapshot of the same virtual machine, taken before the exported one.
+            When set, the extents of the volumes which changed since the base snapshot are exposed, and the changed
+            blocks can be retrieved with range requests of the raw volumes.
+            Only supported when exporting a VirtualMachineSnapshot.
+          type: string
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -9622,6 +10135,30 @@ var CRDsValidation map[string]string = map[string]string{
               description: VirtualMachineExportLink contains a list of volumes available
                 for export, as well as the URLs to obtain these volumes
               properties:
+                bundles:
+                  description: |-
+                    Bundles is a list of available bundles, which package all the exported volumes of the
+                    virtual machine together with a descriptor
+                  items:
+                    description: VirtualMachineExportBundle contains the format and
+                      URL of a bundle of the exported virtual machine
+                    properties:
+                      format:
+                        description: Format is the format of the bundle at the specified
+                          URL
+                        type: string
+                      url:
+                        description: Url is the url that contains the bundle in the
+                          format specified
+                        type: string
+                    required:
+                    - format
+                    - url
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - format
+                  x-kubernetes-list-type: map
                 cert:
                   description: Cert is the public CA certificate base64 encoded
                   type: string
@@ -9691,6 +10228,30 @@ var CRDsValidation map[string]string = map[string]string{
               description: VirtualMachineExportLink contains a list of volumes available
                 for export, as well as the URLs to obtain these volumes
               properties:
+                bundles:
+                  description: |-
+                    Bundles is a list of available bundles, which package all the exported volumes of the
+                    virtual machine together with a descriptor
+                  items:
+                    description: VirtualMachineExportBundle contains the format and
+                      URL of a bundle of the exported virtual machine
+                    properties:
+                      format:
+                        description: Format is the format of the bundle at the specified
+                          URL
+                        type: string
+                      url:
+                        description: Url is the url that contains the bundle in the
+                          format specified
+                        type: string
+                    required:
+                    - format
+                    - url
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - format
+                  x-kubernetes-list-type: map
                 cert:
                   description: Cert is the public CA certificate base64 encoded
                   type: string
@@ -11383,6 +11944,79 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic passing through the interface.
+                          It can be updated on a running VM when the HotplugNICs feature gate is enabled and the
+                          LiveUpdate VM rollout strategy is used, otherwise the update requires a restart.
+                          Only supported for bridge and masquerade interfaces.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Average is the average rate, in bytes per second, the traffic is shaped to.
+                                  For example: 10Mi
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can
+                                  be sent at Peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                  Must not be lower than Average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Average is the average rate, in bytes per second, the traffic is shaped to.
+                                  For example: 10Mi
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can
+                                  be sent at Peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                  Must not be lower than Average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -11416,6 +12050,31 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          ipv6:
+                            description: |-
+                              If specified will configure the IPv6 options passed to the interface.
+                              Only supported for interfaces with masquerade binding.
+                            properties:
+                              delegatedPrefix:
+                                description: |-
+                                  If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).
+                                  The prefix is routed to the guest address and its traffic leaves the pod through the NAT.
+                                type: string
+                              routerAdvertisements:
+                                description: |-
+                                  If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.
+                                  They announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest
+                                  to get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the
+                                  VM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded
+                                  to the DHCPv6 address.
+                                type: boolean
+                            type: object
+                          mtu:
+                            description: |-
+                              If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.
+                              It must not exceed the MTU of the pod interface.
+                            format: int32
+                            type: integer
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -11444,6 +12103,34 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routes:
+                            description: If specified will replace the classless static
+                              routes (option 121) computed from the pod interface.
+                            items:
+                              description: DHCPRoute is a classless static route passed
+                                to the interface via DHCP option 121.
+                              properties:
+                                destination:
+                                  description: |-
+                                    Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.
+                                    Required.
+                                  type: string
+                                gateway:
+                                  description: |-
+                                    Gateway is the IPv4 address of the route next hop.
+                                    When omitted the destination is reachable directly on the link.
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                          searchDomains:
+                            description: If specified will replace the DNS search
+                              domains (option 119, and option 24 of DHCPv6 for masquerade
+                              binding) computed from the pod.
+                            items:
+                              type: string
+                            type: array
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
@@ -11460,8 +12147,11 @@ var CRDsValidation map[string]string = map[string]string{
                           Deprecated: Removed in v1.3
                         type: object
                       masquerade:
-                        description: InterfaceMasquerade connects to a given network
-                          using netfilter rules to nat the traffic.
+                        description: |-
+                          InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+                          Traffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded
+                          back to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is
+                          sent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.
                         type: object
                       model:
                         description: |-
@@ -11495,6 +12185,13 @@ var CRDsValidation map[string]string = map[string]string{
                             Default protocol TCP.
                             The port field is mandatory
                           properties:
+                            endPort:
+                              description: |-
+                                EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.
+                                It must not be lower than Port.
+                                Supported only by the masquerade binding.
+                              format: int32
+                              type: integer
                             name:
                               description: |-
                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -11509,7 +12206,8 @@ var CRDsValidation map[string]string = map[string]string{
                               type: integer
                             protocol:
                               description: |-
-                                Protocol for port. Must be UDP or TCP.
+                                Protocol for port. Must be UDP, TCP or ALL.
+                                ALL forwards the port for any transport protocol and is supported only by the masquerade binding.
                                 Defaults to "TCP".
                               type: string
                           required:
@@ -11528,7 +12226,8 @@ var CRDsValidation map[string]string = map[string]string{
                       state:
                         description: |-
                           State represents the requested operational state of the interface.
-                          The (only) value supported is 'absent', expressing a request to remove the interface.
+                          The values supported are 'absent', expressing a request to remove the interface, and 'up' and 'down',
+                          which set the link state of the interface without removing it. Defaults to 'up'
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
@@ -13199,6 +13898,10 @@ var CRDsValidation map[string]string = map[string]string{
                 items:
                   type: string
                 type: array
+              linkState:
+                description: LinkState is the link state of the interface in the domain,
+                  either up or down
+                type: string
               mac:
                 description: Hardware address of a Virtual Machine interface
                 type: string
@@ -13206,6 +13909,14 @@ var CRDsValidation map[string]string = map[string]string{
                 description: Name of the interface, corresponds to name of the network
                   assigned to the interface
                 type: string
+              natRules:
+                description: |-
+                  NATRules lists the nftables rules programmed in the pod network namespace for an interface
+                  with masquerade binding. It is informational and meant for troubleshooting.
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
               queueCount:
                 description: Specifies how many queues are allocated by MultiQueue
                 format: int32
@@ -13419,6 +14130,13 @@ var CRDsValidation map[string]string = map[string]string{
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
+                allowAdaptiveTuning:
+                  description: |-
+                    AllowAdaptiveTuning lets migrations react to guests that dirty their memory faster than it is transferred.
+                    Such migrations start with auto-converge enabled, throttling the CPU by 30% and by another 20% for each
+                    iteration which does not converge. They switch to post-copy early if AllowPostCopy is true, otherwise
+                    they are aborted once the CPU throttle reached its maximum. Defaults to false
+                  type: boolean
                 allowAutoConverge:
                   description: |-
                     AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
@@ -13452,6 +14170,33 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                maintenanceWindows:
+                  description: |-
+                    MaintenanceWindows restricts when migrations that are not urgent are allowed to start.
+                    Workload update migrations, migrations triggered by evictions on nodes that are not being drained and
+                    user-requested migrations that are not marked as urgent wait until one of the windows opens.
+                    Defaults to none, meaning that migrations start as soon as possible
+                  items:
+                    description: MaintenanceWindow is a recurring time window in which
+                      migrations that are not urgent are allowed to start.
+                    properties:
+                      duration:
+                        description: |-
+                          Duration is how long the window stays open. Migrations that started in the window are not
+                          interrupted when it closes
+                        type: string
+                      schedule:
+                        description: |-
+                          Schedule is a cron expression, evaluated in UTC, of the times the window opens.
+                          It has the standard five fields (minute, hour, day of month, month and day of week),
+                          e.g. "0 22 * * 1-5" opens the window at 22:00 on weekdays
+                        type: string
+                    required:
+                    - duration
+                    - schedule
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -13494,6 +14239,11 @@ var CRDsValidation map[string]string = map[string]string{
                     indicates the migration will be unsafe to the guest. Defaults to false
                   type: boolean
               type: object
+            migrationID:
+              description: |-
+                The ID of the decentralized migration, shared by the migrations in the source and the
+                target cluster
+              type: string
             migrationPolicyName:
               description: Name of the migration policy. If string is empty, no policy
                 is matched
@@ -13533,6 +14283,14 @@ var CRDsValidation map[string]string = map[string]string{
               description: The list of ports opened for live migration on the destination
                 node
               type: object
+            targetMigrationEndpoint:
+              description: The migration endpoint of the target cluster a decentralized
+                migration connects to
+              type: string
+            targetMigrationEndpointToken:
+              description: The token the receiving migration of the target cluster
+                issued for a decentralized migration
+              type: string
             targetNode:
               description: The target node that the VMI is moving to
               type: string
@@ -13774,6 +14532,59 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        priority:
+          description: |-
+            Priority defines the order in which pending migrations are started when the parallel migration
+            limits are reached. Migrations without a priority are handled as user-triggered migrations.
+            Only KubeVirt components and users allowed to update the KubeVirt installation can set a system priority
+          enum:
+          - system-critical
+          - user-triggered
+          - system-maintenance
+          type: string
+        receive:
+          description: |-
+            Receive makes the migration the target of a decentralized migration, which receives the VMI
+            from the migration sending it from another cluster
+          properties:
+            migrationID:
+              description: |-
+                MigrationID identifies the pair of migrations in the source and the target cluster, it has
+                to match the ID of the sending migration
+              type: string
+          required:
+          - migrationID
+          type: object
+        sendTo:
+          description: |-
+            SendTo makes the migration the source of a decentralized migration, which moves the VMI to
+            the migration receiving it in another cluster
+          properties:
+            connectURL:
+              description: ConnectURL is the host:port of the migration endpoint exposed
+                by the target cluster
+              type: string
+            migrationID:
+              description: |-
+                MigrationID identifies the pair of migrations in the source and the target cluster, it has
+                to match the ID of the receiving migration
+              type: string
+            token:
+              description: |-
+                Token is the receive token issued with the receiving migration, see status.receiveToken
+                of the migration in the target cluster
+              type: string
+          required:
+          - connectURL
+          - migrationID
+          - token
+          type: object
+        urgent:
+          description: |-
+            Urgent migrations start right away, without waiting for the maintenance windows of the migration
+            configuration. Migrations evacuating a node that is being drained are always considered urgent.
+            Only KubeVirt components and users allowed to update the KubeVirt installation can create urgent migrations
+          type: boolean
         vmiName:
           description: The name of the VMI to perform the migration on. VMI must exist
             in the migration objects namespace
@@ -13807,6 +14618,13 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        expectedStartTimestamp:
+          description: |-
+            ExpectedStartTimestamp is the time at which the next maintenance window opens, while the migration
+            is waiting for it to start
+          format: date-time
+          nullable: true
+          type: string
         migrationState:
           description: Represents the status of a live migration
           properties:
@@ -13833,6 +14651,13 @@ var CRDsValidation map[string]string = map[string]string{
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
+                allowAdaptiveTuning:
+                  description: |-
+                    AllowAdaptiveTuning lets migrations react to guests that dirty their memory faster than it is transferred.
+                    Such migrations start with auto-converge enabled, throttling the CPU by 30% and by another 20% for each
+                    iteration which does not converge. They switch to post-copy early if AllowPostCopy is true, otherwise
+                    they are aborted once the CPU throttle reached its maximum. Defaults to false
+                  type: boolean
                 allowAutoConverge:
                   description: |-
                     AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
@@ -13866,6 +14691,33 @@ var CRDsValidation map[string]string = map[string]string{
                     When set to true, DisableTLS will disable the additional layer of live migration encryption
                     provided by KubeVirt. This is usually a bad idea. Defaults to false
                   type: boolean
+                maintenanceWindows:
+                  description: |-
+                    MaintenanceWindows restricts when migrations that are not urgent are allowed to start.
+                    Workload update migrations, migrations triggered by evictions on nodes that are not being drained and
+                    user-requested migrations that are not marked as urgent wait until one of the windows opens.
+                    Defaults to none, meaning that migrations start as soon as possible
+                  items:
+                    description: MaintenanceWindow is a recurring time window in which
+                      migrations that are not urgent are allowed to start.
+                    properties:
+                      duration:
+                        description: |-
+                          Duration is how long the window stays open. Migrations that started in the window are not
+                          interrupted when it closes
+                        type: string
+                      schedule:
+                        description: |-
+                          Schedule is a cron expression, evaluated in UTC, of the times the window opens.
+                          It has the standard five fields (minute, hour, day of month, month and day of week),
+                          e.g. "0 22 * * 1-5" opens the window at 22:00 on weekdays
+                        type: string
+                    required:
+                    - duration
+                    - schedule
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 matchSELinuxLevelOnMigration:
                   description: |-
                     By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
@@ -13908,6 +14760,11 @@ var CRDsValidation map[string]string = map[string]string{
                     indicates the migration will be unsafe to the guest. Defaults to false
                   type: boolean
               type: object
+            migrationID:
+              description: |-
+                The ID of the decentralized migration, shared by the migrations in the source and the
+                target cluster
+              type: string
             migrationPolicyName:
               description: Name of the migration policy. If string is empty, no policy
                 is matched
@@ -13947,6 +14804,14 @@ var CRDsValidation map[string]string = map[string]string{
               description: The list of ports opened for live migration on the destination
                 node
               type: object
+            targetMigrationEndpoint:
+              description: The migration endpoint of the target cluster a decentralized
+                migration connects to
+              type: string
+            targetMigrationEndpointToken:
+              description: The token the receiving migration of the target cluster
+                issued for a decentralized migration
+              type: string
             targetNode:
               description: The target node that the VMI is moving to
               type: string
@@ -13994,6 +14859,89 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        progress:
+          description: Progress of the running migration, as reported periodically
+            by the source node
+          properties:
+            autoConvergeThrottle:
+              description: The percentage the guest CPUs are throttled by to let the
+                migration converge
+              format: int64
+              type: integer
+            dataProcessedBytes:
+              description: The amount of data transferred so far, in bytes
+              format: int64
+              type: integer
+            dataRemainingBytes:
+              description: The amount of data which remains to be transferred, in
+                bytes
+              format: int64
+              type: integer
+            dataTotalBytes:
+              description: The total amount of data to be transferred, in bytes
+              format: int64
+              type: integer
+            dirtyMemoryRateBytes:
+              description: |-
+                The rate at which the guest dirties memory, in bytes per second. The migration only converges
+                if it is lower than the memory transfer rate.
+              format: int64
+              type: integer
+            elapsedMilliseconds:
+              description: The time elapsed since the migration started, in milliseconds
+              format: int64
+              type: integer
+            expectedDowntimeMilliseconds:
+              description: The expected downtime of the guest when switching over
+                to the target, in milliseconds
+              format: int64
+              type: integer
+            iteration:
+              description: The number of passes over the guest memory so far
+              format: int64
+              type: integer
+            lastUpdateTimestamp:
+              description: The time the progress was last updated at
+              format: date-time
+              type: string
+            memoryTransferRateBytes:
+              description: The rate at which memory is transferred, in bytes per second
+              format: int64
+              type: integer
+            mode:
+              description: The mode of the migration, PostCopy once it switched to
+                post copy
+              type: string
+            postCopyRequests:
+              description: The number of pages the target requested from the source
+                in post copy mode
+              format: int64
+              type: integer
+          required:
+          - lastUpdateTimestamp
+          type: object
+        receiveToken:
+          description: |-
+            ReceiveToken is issued for migrations receiving a decentralized migration. The sending migration
+            has to present it to the migration endpoint.
+          properties:
+            peerCertificateSHA256:
+              description: |-
+                PeerCertificateSHA256 is the SHA-256 fingerprint of the certificate of the first peer presenting
+                the token. Connections of other peers are rejected.
+              type: string
+            token:
+              description: Token is the secret the sending migration presents to the
+                migration endpoint
+              type: string
+            vmiUID:
+              description: VMIUID is the UID of the receiving VMI the token was issued
+                for
+              type: string
+          required:
+          - token
+          - vmiUID
+          type: object
       type: object
   required:
   - spec
@@ -14524,6 +15472,79 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic passing through the interface.
+                          It can be updated on a running VM when the HotplugNICs feature gate is enabled and the
+                          LiveUpdate VM rollout strategy is used, otherwise the update requires a restart.
+                          Only supported for bridge and masquerade interfaces.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Average is the average rate, in bytes per second, the traffic is shaped to.
+                                  For example: 10Mi
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can
+                                  be sent at Peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                  Must not be lower than Average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Average is the average rate, in bytes per second, the traffic is shaped to.
+                                  For example: 10Mi
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can
+                                  be sent at Peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: |-
+                                  Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                  Must not be lower than Average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -14557,6 +15578,31 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          ipv6:
+                            description: |-
+                              If specified will configure the IPv6 options passed to the interface.
+                              Only supported for interfaces with masquerade binding.
+                            properties:
+                              delegatedPrefix:
+                                description: |-
+                                  If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).
+                                  The prefix is routed to the guest address and its traffic leaves the pod through the NAT.
+                                type: string
+                              routerAdvertisements:
+                                description: |-
+                                  If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.
+                                  They announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest
+                                  to get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the
+                                  VM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded
+                                  to the DHCPv6 address.
+                                type: boolean
+                            type: object
+                          mtu:
+                            description: |-
+                              If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.
+                              It must not exceed the MTU of the pod interface.
+                            format: int32
+                            type: integer
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -14585,6 +15631,34 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routes:
+                            description: If specified will replace the classless static
+                              routes (option 121) computed from the pod interface.
+                            items:
+                              description: DHCPRoute is a classless static route passed
+                                to the interface via DHCP option 121.
+                              properties:
+                                destination:
+                                  description: |-
+                                    Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.
+                                    Required.
+                                  type: string
+                                gateway:
+                                  description: |-
+                                    Gateway is the IPv4 address of the route next hop.
+                                    When omitted the destination is reachable directly on the link.
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                          searchDomains:
+                            description: If specified will replace the DNS search
+                              domains (option 119, and option 24 of DHCPv6 for masquerade
+                              binding) computed from the pod.
+                            items:
+                              type: string
+                            type: array
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
@@ -14601,8 +15675,11 @@ var CRDsValidation map[string]string = map[string]string{
                           Deprecated: Removed in v1.3
                         type: object
                       masquerade:
-                        description: InterfaceMasquerade connects to a given network
-                          using netfilter rules to nat the traffic.
+                        description: |-
+                          InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+                          Traffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded
+                          back to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is
+                          sent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.
                         type: object
                       model:
                         description: |-
@@ -14636,6 +15713,13 @@ var CRDsValidation map[string]string = map[string]string{
                             Default protocol TCP.
                             The port field is mandatory
                           properties:
+                            endPort:
+                              description: |-
+                                EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.
+                                It must not be lower than Port.
+                                Supported only by the masquerade binding.
+                              format: int32
+                              type: integer
                             name:
                               description: |-
                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -14650,7 +15734,8 @@ var CRDsValidation map[string]string = map[string]string{
                               type: integer
                             protocol:
                               description: |-
-                                Protocol for port. Must be UDP or TCP.
+                                Protocol for port. Must be UDP, TCP or ALL.
+                                ALL forwards the port for any transport protocol and is supported only by the masquerade binding.
                                 Defaults to "TCP".
                               type: string
                           required:
@@ -14669,7 +15754,8 @@ var CRDsValidation map[string]string = map[string]string{
                       state:
                         description: |-
                           State represents the requested operational state of the interface.
-                          The (only) value supported is 'absent', expressing a request to remove the interface.
+                          The values supported are 'absent', expressing a request to remove the interface, and 'up' and 'down',
+                          which set the link state of the interface without removing it. Defaults to 'up'
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
@@ -16930,39 +18016,138 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
-                              binding:
-                                description: |-
-                                  Binding specifies the binding plugin that will be used to connect the interface to the guest.
-                                  It provides an alternative to InterfaceBindingMethod.
-                                  version: 1alphav1
-                                properties:
-                                  name:
-                                    description: |-
-                                      Name references to the binding name as denined in the kubevirt CR.
-                                      version: 1alphav1
-                                    type: string
-                                required:
-                                - name
-                                type: object
-                              bootOrder:
+                              bandwidth:
                                 description: |-
-                                  BootOrder is an integer value > 0, used to determine ordering of boot devices.
-                                  Lower values take precedence.
-                                  Each interface or disk that has a boot order must have a unique value.
-                                  Interfaces without a boot order are not tried.
-                                type: integer
-                              bridge:
-                                description: InterfaceBridge connects to a given network
-                                  via a linux bridge.
-                                type: object
-                              dhcpOptions:
-                                description: If specified the network interface will
-                                  pass additional DHCP options to the VMI
+                                  Bandwidth limits the traffic passing through the interface.
+                                  It can be updated on a running VM when the HotplugNICs feature gate is enabled and the
+                                  LiveUpdate VM rollout strategy is used, otherwise the update requires a restart.
+                                  Only supported for bridge and masquerade interfaces.
                                 properties:
-                                  bootFileName:
-                                    description: If specified will pass option 67
-                                      to interface's DHCP server
-                                    type: string
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Average is the average rate, in bytes per second, the traffic is shaped to.
+                                          For example: 10Mi
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which can be sent at Peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                          Must not be lower than Average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Average is the average rate, in bytes per second, the traffic is shaped to.
+                                          For example: 10Mi
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          which can be sent at Peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: |-
+                                          Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                          Must not be lower than Average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: |-
+                                  Binding specifies the binding plugin that will be used to connect the interface to the guest.
+                                  It provides an alternative to InterfaceBindingMethod.
+                                  version: 1alphav1
+                                properties:
+                                  name:
+                                    description: |-
+                                      Name references to the binding name as denined in the kubevirt CR.
+                                      version: 1alphav1
+                                    type: string
+                                required:
+                                - name
+                                type: object
+                              bootOrder:
+                                description: |-
+                                  BootOrder is an integer value > 0, used to determine ordering of boot devices.
+                                  Lower values take precedence.
+                                  Each interface or disk that has a boot order must have a unique value.
+                                  Interfaces without a boot order are not tried.
+                                type: integer
+                              bridge:
+                                description: InterfaceBridge connects to a given network
+                                  via a linux bridge.
+                                type: object
+                              dhcpOptions:
+                                description: If specified the network interface will
+                                  pass additional DHCP options to the VMI
+                                properties:
+                                  bootFileName:
+                                    description: If specified will pass option 67
+                                      to interface's DHCP server
+                                    type: string
+                                  ipv6:
+                                    description: |-
+                                      If specified will configure the IPv6 options passed to the interface.
+                                      Only supported for interfaces with masquerade binding.
+                                    properties:
+                                      delegatedPrefix:
+                                        description: |-
+                                          If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).
+                                          The prefix is routed to the guest address and its traffic leaves the pod through the NAT.
+                                        type: string
+                                      routerAdvertisements:
+                                        description: |-
+                                          If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.
+                                          They announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest
+                                          to get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the
+                                          VM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded
+                                          to the DHCPv6 address.
+                                        type: boolean
+                                    type: object
+                                  mtu:
+                                    description: |-
+                                      If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.
+                                      It must not exceed the MTU of the pod interface.
+                                    format: int32
+                                    type: integer
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -16991,6 +18176,37 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  routes:
+                                    description: If specified will replace the classless
+                                      static routes (option 121) computed from the
+                                      pod interface.
+                                    items:
+                                      description: DHCPRoute is a classless static
+                                        route passed to the interface via DHCP option
+                                        121.
+                                      properties:
+                                        destination:
+                                          description: |-
+                                            Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.
+                                            Required.
+                                          type: string
+                                        gateway:
+                                          description: |-
+                                            Gateway is the IPv4 address of the route next hop.
+                                            When omitted the destination is reachable directly on the link.
+                                          type: string
+                                      required:
+                                      - destination
+                                      type: object
+                                    type: array
+                                  searchDomains:
+                                    description: If specified will replace the DNS
+                                      search domains (option 119, and option 24 of
+                                      DHCPv6 for masquerade binding) computed from
+                                      the pod.
+                                    items:
+                                      type: string
+                                    type: array
                                   tftpServerName:
                                     description: If specified will pass option 66
                                       to interface's DHCP server
@@ -17007,8 +18223,11 @@ var CRDsValidation map[string]string = map[string]string{
                                   Deprecated: Removed in v1.3
                                 type: object
                               masquerade:
-                                description: InterfaceMasquerade connects to a given
-                                  network using netfilter rules to nat the traffic.
+                                description: |-
+                                  InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+                                  Traffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded
+                                  back to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is
+                                  sent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.
                                 type: object
                               model:
                                 description: |-
@@ -17042,6 +18261,13 @@ var CRDsValidation map[string]string = map[string]string{
                                     Default protocol TCP.
                                     The port field is mandatory
                                   properties:
+                                    endPort:
+                                      description: |-
+                                        EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.
+                                        It must not be lower than Port.
+                                        Supported only by the masquerade binding.
+                                      format: int32
+                                      type: integer
                                     name:
                                       description: |-
                                         If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -17056,7 +18282,8 @@ var CRDsValidation map[string]string = map[string]string{
                                       type: integer
                                     protocol:
                                       description: |-
-                                        Protocol for port. Must be UDP or TCP.
+                                        Protocol for port. Must be UDP, TCP or ALL.
+                                        ALL forwards the port for any transport protocol and is supported only by the masquerade binding.
                                         Defaults to "TCP".
                                       type: string
                                   required:
@@ -17075,7 +18302,8 @@ var CRDsValidation map[string]string = map[string]string{
                               state:
                                 description: |-
                                   State represents the requested operational state of the interface.
-                                  The (only) value supported is 'absent', expressing a request to remove the interface.
+                                  The values supported are 'absent', expressing a request to remove the interface, and 'up' and 'down',
+                                  which set the link state of the interface without removing it. Defaults to 'up'
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
@@ -18975,6 +20203,13 @@ var CRDsValidation map[string]string = map[string]string{
             zero and not specified. Defaults to 1.
           format: int32
           type: integer
+        scaleInPolicy:
+          description: |-
+            ScaleInPolicy defines which vms are removed first when the pool scales in.
+            Can be "Random", "Newest", "Oldest", "NotReady" or "NodeSpread". Defaults to Random.
+            Vms with a lower pool.kubevirt.io/deletion-cost annotation are always removed
+            before vms with a higher one, regardless of the policy.
+          type: string
         selector:
           description: |-
             Label selector for pods. Existing Poolss whose pods are
@@ -19022,8 +20257,91 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
           type: object
           x-kubernetes-map-type: atomic
+        statefulPolicy:
+          description: |-
+            StatefulPolicy turns the pool into a stateful pool, in which every index keeps its
+            DataVolumes and vms are created and removed in index order.
+          properties:
+            dataVolumeRetentionPolicy:
+              description: |-
+                DataVolumeRetentionPolicy defines what happens to the DataVolumes of the vms
+                when the pool is scaled in or deleted.
+              properties:
+                whenDeleted:
+                  description: |-
+                    WhenDeleted defines what happens to the DataVolumes when the pool is deleted.
+                    Can be "Retain" or "Delete". Defaults to Retain.
+                    Retaining DataVolumes on deletion is not supported with foreground cascading deletion of the pool.
+                  type: string
+                whenScaled:
+                  description: |-
+                    WhenScaled defines what happens to the DataVolumes when the pool is scaled in.
+                    Can be "Retain" or "Delete". Defaults to Retain.
+                  type: string
+              type: object
+            managementPolicy:
+              description: |-
+                ManagementPolicy defines the order in which vms are created and removed.
+                Can be "OrderedReady" or "Parallel". Defaults to OrderedReady.
+              type: string
+          type: object
+        updateStrategy:
+          description: UpdateStrategy defines how outdated vms are updated to the
+            current pool template.
+          properties:
+            rollingUpdate:
+              description: |-
+                RollingUpdate holds the parameters of the rolling update.
+                Only valid if Type is RollingUpdate.
+              properties:
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of vms that can be created above the desired replicas
+                    during the update. Outdated vms are deleted instead of updated in place
+                    once their replacements became ready.
+                    Value can be an absolute number (ex: 5) or a percentage of the desired
+                    replicas (ex: 10%). Absolute number is calculated from percentage by rounding up.
+                    Defaults to 0.
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    The maximum number of vms that can be unavailable during the update.
+                    Value can be an absolute number (ex: 5) or a percentage of the desired
+                    replicas (ex: 10%). Absolute number is calculated from percentage by rounding down.
+                    This can not be 0 if MaxSurge is 0.
+                    Defaults to 1.
+                  x-kubernetes-int-or-string: true
+                partition:
+                  description: |-
+                    Partition indicates the index at which the pool is partitioned for updates.
+                    Only vms with an index greater than or equal to the partition are updated.
+                    All other vms stay on their current revision.
+                    Defaults to 0.
+                  format: int32
+                  type: integer
+                pauseOnFailure:
+                  description: |-
+                    PauseOnFailure halts the rolling update when a vm which already runs
+                    the current pool revision fails, and reports the UpdatePaused condition.
+                  type: boolean
+              type: object
+            type:
+              description: |-
+                Type of the update strategy. Can be "Opportunistic" or "RollingUpdate".
+                Defaults to Opportunistic.
+              type: string
+          type: object
         virtualMachineTemplate:
-          description: Template describes the VM that will be created.
+          description: |-
+            Template describes the VM that will be created.
+            Occurrences of {{ .Index }} in the cloud-init userData and networkData of the
+            template are replaced with the index of the VM.
           properties:
             metadata:
               nullable: true
@@ -21418,6 +22736,82 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: |-
+                                          Bandwidth limits the traffic passing through the interface.
+                                          It can be updated on a running VM when the HotplugNICs feature gate is enabled and the
+                                          LiveUpdate VM rollout strategy is used, otherwise the update requires a restart.
+                                          Only supported for bridge and masquerade interfaces.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: |-
+                                                  Average is the average rate, in bytes per second, the traffic is shaped to.
+                                                  For example: 10Mi
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  bytes which can be sent at Peak
+                                                  rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: |-
+                                                  Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                                  Must not be lower than Average.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: |-
+                                                  Average is the average rate, in bytes per second, the traffic is shaped to.
+                                                  For example: 10Mi
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  bytes which can be sent at Peak
+                                                  rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: |-
+                                                  Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                                  Must not be lower than Average.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -21452,6 +22846,31 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: If specified will pass option
                                               67 to interface's DHCP server
                                             type: string
+                                          ipv6:
+                                            description: |-
+                                              If specified will configure the IPv6 options passed to the interface.
+                                              Only supported for interfaces with masquerade binding.
+                                            properties:
+                                              delegatedPrefix:
+                                                description: |-
+                                                  If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).
+                                                  The prefix is routed to the guest address and its traffic leaves the pod through the NAT.
+                                                type: string
+                                              routerAdvertisements:
+                                                description: |-
+                                                  If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.
+                                                  They announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest
+                                                  to get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the
+                                                  VM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded
+                                                  to the DHCPv6 address.
+                                                type: boolean
+                                            type: object
+                                          mtu:
+                                            description: |-
+                                              If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.
+                                              It must not exceed the MTU of the pod interface.
+                                            format: int32
+                                            type: integer
                                           ntpServers:
                                             description: If specified will pass the
                                               configured NTP server to the VM via
@@ -21482,6 +22901,37 @@ var CRDsValidation map[string]string = map[string]string{
                                               - value
                                               type: object
                                             type: array
+                                          routes:
+                                            description: If specified will replace
+                                              the classless static routes (option
+                                              121) computed from the pod interface.
+                                            items:
+                                              description: DHCPRoute is a classless
+                                                static route passed to the interface
+                                                via DHCP option 121.
+                                              properties:
+                                                destination:
+                                                  description: |-
+                                                    Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.
+                                                    Required.
+                                                  type: string
+                                                gateway:
+                                                  description: |-
+                                                    Gateway is the IPv4 address of the route next hop.
+                                                    When omitted the destination is reachable directly on the link.
+                                                  type: string
+                                              required:
+                                              - destination
+                                              type: object
+                                            type: array
+                                          searchDomains:
+                                            description: If specified will replace
+                                              the DNS search domains (option 119,
+                                              and option 24 of DHCPv6 for masquerade
+                                              binding) computed from the pod.
+                                            items:
+                                              type: string
+                                            type: array
                                           tftpServerName:
                                             description: If specified will pass option
                                               66 to interface's DHCP server
@@ -21498,9 +22948,11 @@ var CRDsValidation map[string]string = map[string]string{
                                           Deprecated: Removed in v1.3
                                         type: object
                                       masquerade:
-                                        description: InterfaceMasquerade connects
-                                          to a given network using netfilter rules
-                                          to nat the traffic.
+                                        description: |-
+                                          InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+                                          Traffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded
+                                          back to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is
+                                          sent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.
                                         type: object
                                       model:
                                         description: |-
@@ -21535,6 +22987,13 @@ var CRDsValidation map[string]string = map[string]string{
                                             Default protocol TCP.
                                             The port field is mandatory
                                           properties:
+                                            endPort:
+                                              description: |-
+                                                EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.
+                                                It must not be lower than Port.
+                                                Supported only by the masquerade binding.
+                                              format: int32
+                                              type: integer
                                             name:
                                               description: |-
                                                 If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -21549,7 +23008,8 @@ var CRDsValidation map[string]string = map[string]string{
                                               type: integer
                                             protocol:
                                               description: |-
-                                                Protocol for port. Must be UDP or TCP.
+                                                Protocol for port. Must be UDP, TCP or ALL.
+                                                ALL forwards the port for any transport protocol and is supported only by the masquerade binding.
                                                 Defaults to "TCP".
                                               type: string
                                           required:
@@ -21569,7 +23029,8 @@ var CRDsValidation map[string]string = map[string]string{
                                       state:
                                         description: |-
                                           State represents the requested operational state of the interface.
-                                          The (only) value supported is 'absent', expressing a request to remove the interface.
+                                          The values supported are 'absent', expressing a request to remove the interface, and 'up' and 'down',
+                                          which set the link state of the interface without removing it. Defaults to 'up'
                                         type: string
                                       tag:
                                         description: If specified, the virtual network
@@ -23170,6 +24631,11 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     status:
       properties:
+        availableReplicas:
+          description: AvailableReplicas is the number of ready vms which are not
+            being deleted.
+          format: int32
+          type: integer
         conditions:
           items:
             properties:
@@ -23199,12 +24665,22 @@ var CRDsValidation map[string]string = map[string]string{
           description: Canonical form of the label selector for HPA which consumes
             it through the scale subresource.
           type: string
+        outdatedReplicas:
+          description: OutdatedReplicas is the number of vms which still have to be
+            updated to the current pool revision.
+          format: int32
+          type: integer
         readyReplicas:
           format: int32
           type: integer
         replicas:
           format: int32
           type: integer
+        updatedReplicas:
+          description: UpdatedReplicas is the number of vms which are running the
+            current pool revision.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -23883,6 +25359,10 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
       properties:
+        mode:
+          description: Mode selects what is restored from the snapshot, the VM spec
+            and its volumes by default
+          type: string
         patches:
           description: |-
             If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be
@@ -23916,6 +25396,14 @@ var CRDsValidation map[string]string = map[string]string{
           x-kubernetes-map-type: atomic
         virtualMachineSnapshotName:
           type: string
+        volumeNames:
+          description: |-
+            VolumeNames restricts the restore to the named volumes of the snapshot, all the volumes
+            are restored when empty. The other volumes of the target keep their current source.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
       required:
       - target
       - virtualMachineSnapshotName
@@ -24020,6 +25508,80 @@ var CRDsValidation map[string]string = map[string]string{
             as failed.
             Defaults to DefaultFailureDeadline - 5min
           type: string
+        hooks:
+          description: |-
+            Hooks are commands run in the guest through the guest agent
+            around the freeze of the guest file systems.
+            Only users allowed to run commands in the guest through the
+            virtualmachineinstances/guestexec subresource can set them
+          properties:
+            postThaw:
+              description: |-
+                PostThaw hooks are run in order after the guest file systems are thawed.
+                They are also run when a pre freeze hook aborts the snapshot, and a failed
+                post thaw hook does not prevent the next ones from running
+              items:
+                description: SnapshotHook is a command run in the guest
+                properties:
+                  command:
+                    description: Command is the path of the executable to run in the
+                      guest followed by its arguments
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  failurePolicy:
+                    description: |-
+                      FailurePolicy is what to do when the command fails or times out.
+                      Defaults to Abort
+                    type: string
+                  name:
+                    description: Name identifies the hook in the snapshot status
+                    type: string
+                  timeout:
+                    description: |-
+                      Timeout is the time the command is allowed to run.
+                      Defaults to 30s, at most 5m
+                    type: string
+                required:
+                - command
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            preFreeze:
+              description: PreFreeze hooks are run in order before the guest file
+                systems are frozen
+              items:
+                description: SnapshotHook is a command run in the guest
+                properties:
+                  command:
+                    description: Command is the path of the executable to run in the
+                      guest followed by its arguments
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  failurePolicy:
+                    description: |-
+                      FailurePolicy is what to do when the command fails or times out.
+                      Defaults to Abort
+                    type: string
+                  name:
+                    description: Name identifies the hook in the snapshot status
+                    type: string
+                  timeout:
+                    description: |-
+                      Timeout is the time the command is allowed to run.
+                      Defaults to 30s, at most 5m
+                    type: string
+                required:
+                - command
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         source:
           description: |-
             TypedLocalObjectReference contains enough information to let you locate the
@@ -24089,6 +25651,42 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
+        hookResults:
+          items:
+            description: SnapshotHookResult is the result of a snapshot hook
+            properties:
+              completionTime:
+                format: date-time
+                nullable: true
+                type: string
+              exitCode:
+                format: int32
+                type: integer
+              message:
+                type: string
+              name:
+                type: string
+              output:
+                description: Output is the end of the standard output of the command
+                type: string
+              phase:
+                description: SnapshotHookPhase is the outcome of a snapshot hook
+                type: string
+              stage:
+                description: SnapshotHookStage is the point of the snapshot at which
+                  a hook is run
+                type: string
+              startTime:
+                format: date-time
+                nullable: true
+                type: string
+            required:
+            - name
+            - phase
+            - stage
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         indications:
           items:
             description: Indication is a way to indicate the state of the vm when
@@ -26587,6 +28185,82 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: |-
+                                              Bandwidth limits the traffic passing through the interface.
+                                              It can be updated on a running VM when the HotplugNICs feature gate is enabled and the
+                                              LiveUpdate VM rollout strategy is used, otherwise the update requires a restart.
+                                              Only supported for bridge and masquerade interfaces.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: |-
+                                                      Average is the average rate, in bytes per second, the traffic is shaped to.
+                                                      For example: 10Mi
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of bytes which can be sent at
+                                                      Peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: |-
+                                                      Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                                      Must not be lower than Average.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: |-
+                                                      Average is the average rate, in bytes per second, the traffic is shaped to.
+                                                      For example: 10Mi
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of bytes which can be sent at
+                                                      Peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: |-
+                                                      Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+                                                      Must not be lower than Average.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -26621,6 +28295,31 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: If specified will pass
                                                   option 67 to interface's DHCP server
                                                 type: string
+                                              ipv6:
+                                                description: |-
+                                                  If specified will configure the IPv6 options passed to the interface.
+                                                  Only supported for interfaces with masquerade binding.
+                                                properties:
+                                                  delegatedPrefix:
+                                                    description: |-
+                                                      If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).
+                                                      The prefix is routed to the guest address and its traffic leaves the pod through the NAT.
+                                                    type: string
+                                                  routerAdvertisements:
+                                                    description: |-
+                                                      If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.
+                                                      They announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest
+                                                      to get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the
+                                                      VM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded
+                                                      to the DHCPv6 address.
+                                                    type: boolean
+                                                type: object
+                                              mtu:
+                                                description: |-
+                                                  If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.
+                                                  It must not exceed the MTU of the pod interface.
+                                                format: int32
+                                                type: integer
                                               ntpServers:
                                                 description: If specified will pass
                                                   the configured NTP server to the
@@ -26651,6 +28350,37 @@ var CRDsValidation map[string]string = map[string]string{
                                                   - value
                                                   type: object
                                                 type: array
+                                              routes:
+                                                description: If specified will replace
+                                                  the classless static routes (option
+                                                  121) computed from the pod interface.
+                                                items:
+                                                  description: DHCPRoute is a classless
+                                                    static route passed to the interface
+                                                    via DHCP option 121.
+                                                  properties:
+                                                    destination:
+                                                      description: |-
+                                                        Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.
+                                                        Required.
+                                                      type: string
+                                                    gateway:
+                                                      description: |-
+                                                        Gateway is the IPv4 address of the route next hop.
+                                                        When omitted the destination is reachable directly on the link.
+                                                      type: string
+                                                  required:
+                                                  - destination
+                                                  type: object
+                                                type: array
+                                              searchDomains:
+                                                description: If specified will replace
+                                                  the DNS search domains (option 119,
+                                                  and option 24 of DHCPv6 for masquerade
+                                                  binding) computed from the pod.
+                                                items:
+                                                  type: string
+                                                type: array
                                               tftpServerName:
                                                 description: If specified will pass
                                                   option 66 to interface's DHCP server
@@ -26667,9 +28397,11 @@ var CRDsValidation map[string]string = map[string]string{
                                               Deprecated: Removed in v1.3
                                             type: object
                                           masquerade:
-                                            description: InterfaceMasquerade connects
-                                              to a given network using netfilter rules
-                                              to nat the traffic.
+                                            description: |-
+                                              InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+                                              Traffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded
+                                              back to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is
+                                              sent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.
                                             type: object
                                           model:
                                             description: |-
@@ -26704,6 +28436,13 @@ var CRDsValidation map[string]string = map[string]string{
                                                 Default protocol TCP.
                                                 The port field is mandatory
                                               properties:
+                                                endPort:
+                                                  description: |-
+                                                    EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.
+                                                    It must not be lower than Port.
+                                                    Supported only by the masquerade binding.
+                                                  format: int32
+                                                  type: integer
                                                 name:
                                                   description: |-
                                                     If specified, this must be an IANA_SVC_NAME and unique within the pod. Each
@@ -26718,7 +28457,8 @@ var CRDsValidation map[string]string = map[string]string{
                                                   type: integer
                                                 protocol:
                                                   description: |-
-                                                    Protocol for port. Must be UDP or TCP.
+                                                    Protocol for port. Must be UDP, TCP or ALL.
+                                                    ALL forwards the port for any transport protocol and is supported only by the masquerade binding.
                                                     Defaults to "TCP".
                                                   type: string
                                               required:
@@ -26738,7 +28478,8 @@ var CRDsValidation map[string]string = map[string]string{
                                           state:
                                             description: |-
                                               State represents the requested operational state of the interface.
-                                              The (only) value supported is 'absent', expressing a request to remove the interface.
+                                              The values supported are 'absent', expressing a request to remove the interface, and 'up' and 'down',
+                                              which set the link state of the interface without removing it. Defaults to 'up'
                                             type: string
                                           tag:
                                             description: If specified, the virtual
@@ -29014,6 +30755,42 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
+        hookResults:
+          items:
+            description: SnapshotHookResult is the result of a snapshot hook
+            properties:
+              completionTime:
+                format: date-time
+                nullable: true
+                type: string
+              exitCode:
+                format: int32
+                type: integer
+              message:
+                type: string
+              name:
+                type: string
+              output:
+                description: Output is the end of the standard output of the command
+                type: string
+              phase:
+                description: SnapshotHookPhase is the outcome of a snapshot hook
+                type: string
+              stage:
+                description: SnapshotHookStage is the point of the snapshot at which
+                  a hook is run
+                type: string
+              startTime:
+                format: date-time
+                nullable: true
+                type: string
+            required:
+            - name
+            - phase
+            - stage
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         readyToUse:
           type: boolean
         volumeSnapshotStatus:
@@ -29046,5 +30823,183 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule defines the periodic snapshotting of
+    VMs
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is set on the created VirtualMachineSnapshots
+          type: string
+        disabled:
+          description: Disabled suspends the creation of snapshots, retention still
+            applies
+          type: boolean
+        failureDeadline:
+          description: FailureDeadline is set on the created VirtualMachineSnapshots
+          type: string
+        retention:
+          description: |-
+            Retention defines which of the snapshots created by the schedule are kept.
+            Without retention, all the snapshots are kept.
+          properties:
+            daily:
+              description: Daily is the number of days for which the most recent snapshot
+                of the day is kept
+              format: int32
+              type: integer
+            last:
+              description: Last is the number of most recent snapshots which are kept,
+                at least 1
+              format: int32
+              type: integer
+            maxAge:
+              description: MaxAge is the age after which snapshots are deleted, whatever
+                the keep rules
+              type: string
+            monthly:
+              description: Monthly is the number of months for which the most recent
+                snapshot of the month is kept
+              format: int32
+              type: integer
+            weekly:
+              description: Weekly is the number of weeks for which the most recent
+                snapshot of the week is kept
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: |-
+            Schedule is a cron expression with the five standard fields, like "0 2 * * *", evaluated in UTC.
+            The @hourly, @daily, @weekly, @monthly and @yearly macros are accepted as well.
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines of the namespace of the
+            schedule which are snapshotted
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: |-
+                  A label selector requirement is a selector that contains values, a key, and an operator that
+                  relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: |-
+                      operator represents a key's relationship to a set of values.
+                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: |-
+                      values is an array of string values. If the operator is In or NotIn,
+                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                      the values array must be empty. This array is replaced during a strategic
+                      merge patch.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: |-
+                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+          x-kubernetes-map-type: atomic
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+        nextScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+        virtualMachines:
+          items:
+            description: VirtualMachineSnapshotScheduleVMStatus is the status of the
+              snapshots of a VM created by a schedule
+            properties:
+              lastFailure:
+                description: Error is the last error encountered during the snapshot/restore
+                properties:
+                  message:
+                    type: string
+                  time:
+                    format: date-time
+                    type: string
+                type: object
+              lastSnapshotName:
+                type: string
+              lastSuccessTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastSuccessfulSnapshotName:
+                type: string
+              name:
+                description: Name is the name of the VirtualMachine
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - name
+          x-kubernetes-list-type: map
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
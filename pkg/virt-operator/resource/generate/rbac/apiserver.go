@@ -29,6 +29,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/hooks"
 	"kubevirt.io/api/migrations"
 )
 
@@ -256,6 +257,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					hooks.GroupName,
+				},
+				Resources: []string{
+					hooks.ResourceHookSidecars,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
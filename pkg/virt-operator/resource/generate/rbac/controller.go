@@ -31,6 +31,7 @@ import (
 	"kubevirt.io/api/instancetype"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/hooks"
 	"kubevirt.io/api/migrations"
 )
 
@@ -372,7 +373,10 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/removevolume",
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
+					"virtualmachineinstances/guestexec",
 					"virtualmachineinstances/softreboot",
+					"virtualmachines/addvolume",
+					"virtualmachines/removevolume",
 					"virtualmachineinstances/sev/setupsession",
 					"virtualmachineinstances/sev/injectlaunchsecret",
 				},
@@ -491,6 +495,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					hooks.GroupName,
+				},
+				Resources: []string{
+					hooks.ResourceHookSidecars,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceMigrationPolicies + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
@@ -23,6 +23,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"kubevirt.io/kubevirt/pkg/virt-config/deprecation"
 
@@ -314,4 +315,49 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 			Entry("with MacvtapGate", deprecation.MacvtapGate, deprecation.MacvtapDiscontinueMessage),
 		)
 	})
+
+	Context("with migration maintenance windows", func() {
+		admit := func(migrationConfiguration *v1.MigrationConfiguration) *admissionv1.AdmissionResponse {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter := NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+
+			kv := v1.KubeVirt{}
+			kvBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			kv.Spec.Configuration.MigrationConfiguration = migrationConfiguration
+			kvUpdatedBytes, err := json.Marshal(kv)
+			Expect(err).ToNot(HaveOccurred())
+
+			return admitter.Admit(&admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  KubeVirtGroupVersionResource,
+					Operation: admissionv1.Update,
+					OldObject: runtime.RawExtension{Raw: kvBytes},
+					Object:    runtime.RawExtension{Raw: kvUpdatedBytes},
+				},
+			})
+		}
+
+		It("should accept valid maintenance windows", func() {
+			response := admit(&v1.MigrationConfiguration{
+				MaintenanceWindows: []v1.MaintenanceWindow{
+					{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: 6 * time.Hour}},
+				},
+			})
+			Expect(response.Allowed).To(BeTrue())
+		})
+
+		It("should reject invalid maintenance windows", func() {
+			response := admit(&v1.MigrationConfiguration{
+				MaintenanceWindows: []v1.MaintenanceWindow{
+					{Schedule: "0 22 * * *", Duration: metav1.Duration{Duration: 6 * time.Hour}},
+					{Schedule: "tonight", Duration: metav1.Duration{Duration: 6 * time.Hour}},
+				},
+			})
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(HaveLen(1))
+			Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.configuration.migrations.maintenanceWindows[1].schedule"))
+		})
+	})
 })
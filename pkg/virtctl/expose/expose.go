@@ -327,6 +327,14 @@ func podNetworkPorts(vmiSpec *virtv1.VirtualMachineInstanceSpec) []v1.ServicePor
 			if device.Name == podNetworkName {
 				ports := []v1.ServicePort{}
 				for i, port := range device.Ports {
+					// Services support specific protocols only, a port of all protocols is exposed for TCP and UDP
+					if port.Protocol == "ALL" {
+						ports = append(ports,
+							v1.ServicePort{Name: fmt.Sprintf("port-%d-tcp", i+1), Protocol: v1.ProtocolTCP, Port: port.Port},
+							v1.ServicePort{Name: fmt.Sprintf("port-%d-udp", i+1), Protocol: v1.ProtocolUDP, Port: port.Port},
+						)
+						continue
+					}
 					ports = append(ports, v1.ServicePort{Name: fmt.Sprintf("port-%d", i+1), Protocol: v1.Protocol(port.Protocol), Port: port.Port})
 				}
 				return ports
@@ -434,7 +434,7 @@ var _ = Describe("Expose", func() {
 })
 
 func addPodNetworkWithPorts(spec *v1.VirtualMachineInstanceSpec) {
-	ports := []v1.Port{{Name: "a", Protocol: "TCP", Port: 80}, {Name: "b", Protocol: "UDP", Port: 81}}
+	ports := []v1.Port{{Name: "a", Protocol: "TCP", Port: 80}, {Name: "b", Protocol: "UDP", Port: 81}, {Name: "c", Protocol: "ALL", Port: 53}}
 	spec.Networks = append(spec.Networks, v1.Network{Name: "pod", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}})
 	spec.Domain.Devices.Interfaces = append(spec.Domain.Devices.Interfaces, v1.Interface{Name: "pod", Ports: ports})
 }
@@ -445,6 +445,8 @@ func prependServicePortReactor(kubeclient *fake.Clientset) {
 		Expect(ok).To(BeTrue())
 		Expect(update.GetObject().(*k8sv1.Service).Spec.Ports[0]).To(Equal(k8sv1.ServicePort{Name: "port-1", Protocol: "TCP", Port: 80}))
 		Expect(update.GetObject().(*k8sv1.Service).Spec.Ports[1]).To(Equal(k8sv1.ServicePort{Name: "port-2", Protocol: "UDP", Port: 81}))
+		Expect(update.GetObject().(*k8sv1.Service).Spec.Ports[2]).To(Equal(k8sv1.ServicePort{Name: "port-3-tcp", Protocol: "TCP", Port: 53}))
+		Expect(update.GetObject().(*k8sv1.Service).Spec.Ports[3]).To(Equal(k8sv1.ServicePort{Name: "port-3-udp", Protocol: "UDP", Port: 53}))
 		return false, nil, nil
 	})
 }
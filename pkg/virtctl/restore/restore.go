@@ -0,0 +1,166 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/api/core"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_RESTORE = "restore"
+
+	nameFlag   = "name"
+	targetFlag = "target"
+	modeFlag   = "mode"
+	volumeFlag = "volume"
+)
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+
+	name        string
+	target      string
+	mode        string
+	volumeNames []string
+}
+
+// NewCommand returns a cobra.Command to restore a VM from a VirtualMachineSnapshot
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := command{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:     "restore (VirtualMachineSnapshot)",
+		Short:   "Restore a virtual machine, or some of its volumes, from a VirtualMachineSnapshot",
+		Args:    templates.ExactArgs(COMMAND_RESTORE, 1),
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, args)
+		},
+	}
+
+	cmd.Flags().StringVar(&c.name, nameFlag, "", "Name of the VirtualMachineRestore, generated from the snapshot name when not set")
+	cmd.Flags().StringVar(&c.target, targetFlag, "", "Name of the VM to restore, the source VM of the snapshot when not set")
+	cmd.Flags().StringVar(&c.mode, modeFlag, string(snapshotv1.RestoreModeFull),
+		fmt.Sprintf("What to restore: %s for the VM spec and volumes, %s for the VM spec only, %s for the volumes as new standalone PVCs, %s for the selected volumes of the running VM",
+			snapshotv1.RestoreModeFull, snapshotv1.RestoreModeSpecOnly, snapshotv1.RestoreModeVolumesOnly, snapshotv1.RestoreModeOnline))
+	cmd.Flags().StringArrayVar(&c.volumeNames, volumeFlag, nil, "Name of a volume of the snapshot to restore, can be repeated. All the volumes are restored when not set")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Restore the VM and all the volumes of the snapshot 'mysnapshot':
+  {{ProgramName}} restore mysnapshot
+
+  # Restore only the volume 'datadisk' of the VM 'myvm' from the snapshot 'mysnapshot':
+  {{ProgramName}} restore mysnapshot --target=myvm --volume=datadisk
+
+  # Restore only the spec of the VM, keeping its current disks:
+  {{ProgramName}} restore mysnapshot --mode=SpecOnly
+
+  # Restore the volume 'datadisk' as a new standalone PVC without touching the VM:
+  {{ProgramName}} restore mysnapshot --mode=VolumesOnly --volume=datadisk
+
+  # Swap the hotplugged volume 'datadisk' of the running VM with its restored copy:
+  {{ProgramName}} restore mysnapshot --mode=Online --volume=datadisk`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	snapshotName := args[0]
+
+	mode := snapshotv1.VirtualMachineRestoreMode(c.mode)
+	switch mode {
+	case snapshotv1.RestoreModeFull, snapshotv1.RestoreModeSpecOnly, snapshotv1.RestoreModeVolumesOnly, snapshotv1.RestoreModeOnline:
+	default:
+		return fmt.Errorf("invalid restore mode %q, supported modes are %s, %s, %s and %s",
+			c.mode, snapshotv1.RestoreModeFull, snapshotv1.RestoreModeSpecOnly, snapshotv1.RestoreModeVolumesOnly, snapshotv1.RestoreModeOnline)
+	}
+
+	if mode == snapshotv1.RestoreModeSpecOnly && len(c.volumeNames) > 0 {
+		return fmt.Errorf("volumes can not be selected with %s mode", mode)
+	}
+
+	if mode == snapshotv1.RestoreModeOnline && len(c.volumeNames) == 0 {
+		return fmt.Errorf("volumes have to be selected with %s mode", mode)
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	snapshot, err := virtClient.VirtualMachineSnapshot(namespace).Get(context.Background(), snapshotName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VirtualMachineSnapshot %s: %v", snapshotName, err)
+	}
+
+	target := c.target
+	if target == "" {
+		target = snapshot.Spec.Source.Name
+	}
+
+	apiGroup := core.GroupName
+	restore := &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.name,
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VirtualMachine",
+				Name:     target,
+			},
+			VirtualMachineSnapshotName: snapshotName,
+			VolumeNames:                c.volumeNames,
+		},
+	}
+	if restore.Name == "" {
+		restore.GenerateName = fmt.Sprintf("restore-%s-", snapshotName)
+	}
+	if mode != snapshotv1.RestoreModeFull {
+		restore.Spec.Mode = &mode
+	}
+
+	restore, err = virtClient.VirtualMachineRestore(namespace).Create(context.Background(), restore, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineRestore: %v", err)
+	}
+
+	cmd.Printf("VirtualMachineRestore %s created\n", restore.Name)
+	return nil
+}
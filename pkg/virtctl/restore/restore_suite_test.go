@@ -0,0 +1,11 @@
+package restore_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestRestore(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,101 @@
+package restore_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/restore"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Restore", func() {
+	const (
+		snapshotName = "snapshot"
+		restoreName  = "myrestore"
+		vmName       = "vm"
+	)
+
+	var kubevirtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset(&snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      snapshotName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+		})
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	getRestore := func() *snapshotv1.VirtualMachineRestore {
+		vmRestore, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.Background(), restoreName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmRestore
+	}
+
+	It("should fail with missing input parameters", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(restore.COMMAND_RESTORE)
+		Expect(cmd()).To(HaveOccurred())
+	})
+
+	It("should restore the source VM of the snapshot", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(restore.COMMAND_RESTORE, snapshotName, "--name", restoreName)
+		Expect(cmd()).To(Succeed())
+
+		vmRestore := getRestore()
+		Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(snapshotName))
+		Expect(vmRestore.Spec.Target.Kind).To(Equal("VirtualMachine"))
+		Expect(vmRestore.Spec.Target.Name).To(Equal(vmName))
+		Expect(vmRestore.Spec.Mode).To(BeNil())
+		Expect(vmRestore.Spec.VolumeNames).To(BeEmpty())
+	})
+
+	It("should restore the selected volumes of the target VM", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(restore.COMMAND_RESTORE, snapshotName, "--name", restoreName,
+			"--target", "other-vm", "--mode", "VolumesOnly", "--volume", "disk1", "--volume", "disk2")
+		Expect(cmd()).To(Succeed())
+
+		vmRestore := getRestore()
+		Expect(vmRestore.Spec.Target.Name).To(Equal("other-vm"))
+		Expect(vmRestore.Spec.Mode).To(HaveValue(Equal(snapshotv1.RestoreModeVolumesOnly)))
+		Expect(vmRestore.Spec.VolumeNames).To(Equal([]string{"disk1", "disk2"}))
+	})
+
+	DescribeTable("should reject", func(errMsg string, args ...string) {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(append([]string{restore.COMMAND_RESTORE, snapshotName}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("an invalid mode", `invalid restore mode "Partial"`, "--mode", "Partial"),
+		Entry("volumes with spec only mode", "volumes can not be selected with SpecOnly mode", "--mode", "SpecOnly", "--volume", "disk1"),
+		Entry("online mode without volumes", "volumes have to be selected with Online mode", "--mode", "Online"),
+	)
+
+	It("should fail when the snapshot does not exist", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(restore.COMMAND_RESTORE, "missing")
+		Expect(cmd()).To(MatchError(ContainSubstring("error getting VirtualMachineSnapshot missing")))
+	})
+})
@@ -49,6 +49,8 @@ var (
 	volumeName   string
 	persist      bool
 	dryRun       bool
+
+	watchMigration bool
 )
 
 type Command struct {
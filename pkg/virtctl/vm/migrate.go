@@ -22,15 +22,27 @@ package vm
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
-const COMMAND_MIGRATE = "migrate"
+const (
+	COMMAND_MIGRATE = "migrate"
+
+	watchArg = "watch"
+)
+
+// MigrationWatchInterval is how often the progress of a watched migration is polled
+var MigrationWatchInterval = 2 * time.Second
 
 func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
@@ -44,6 +56,7 @@ func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().BoolVar(&watchMigration, watchArg, false, "If true, watch the progress of the migration until it is completed or failed.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -65,5 +78,86 @@ func (o *Command) migrateRun(args []string) error {
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
 
+	if !watchMigration || dryRun {
+		return nil
+	}
+	return watchMigrationProgress(virtClient, namespace, vmiName)
+}
+
+func watchMigrationProgress(virtClient kubecli.KubevirtClient, namespace, vmiName string) error {
+	migrations, err := virtClient.VirtualMachineInstanceMigration(namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmiName),
+	})
+	if err != nil {
+		return fmt.Errorf("Error fetching virtual machine instance migration list %v", err)
+	}
+	var migration *v1.VirtualMachineInstanceMigration
+	for i := range migrations.Items {
+		if !migrations.Items[i].IsFinal() {
+			migration = &migrations.Items[i]
+			break
+		}
+	}
+	if migration == nil {
+		return fmt.Errorf("Found no active migration for %s", vmiName)
+	}
+
+	migrationName := migration.Name
+	for {
+		fmt.Println(formatMigrationProgress(migration))
+		if migration.IsFinal() {
+			break
+		}
+		time.Sleep(MigrationWatchInterval)
+		migration, err = virtClient.VirtualMachineInstanceMigration(namespace).Get(context.Background(), migrationName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("Error fetching virtual machine instance migration %s: %v", migrationName, err)
+		}
+	}
+
+	if migration.Status.Phase == v1.MigrationFailed {
+		return fmt.Errorf("Migration %s of VM %s failed", migrationName, vmiName)
+	}
 	return nil
 }
+
+func formatMigrationProgress(migration *v1.VirtualMachineInstanceMigration) string {
+	parts := []string{fmt.Sprintf("phase: %s", migration.Status.Phase)}
+	progress := migration.Status.Progress
+	if progress == nil {
+		return strings.Join(parts, ", ")
+	}
+
+	if progress.Mode != "" {
+		parts = append(parts, fmt.Sprintf("mode: %s", progress.Mode))
+	}
+	if progress.DataProcessedBytes != nil && progress.DataTotalBytes != nil {
+		parts = append(parts, fmt.Sprintf("processed: %s of %s", formatBytes(*progress.DataProcessedBytes), formatBytes(*progress.DataTotalBytes)))
+	}
+	if progress.DataRemainingBytes != nil {
+		parts = append(parts, fmt.Sprintf("remaining: %s", formatBytes(*progress.DataRemainingBytes)))
+	}
+	if progress.MemoryTransferRateBytes != nil {
+		parts = append(parts, fmt.Sprintf("transfer rate: %s/s", formatBytes(*progress.MemoryTransferRateBytes)))
+	}
+	if progress.DirtyMemoryRateBytes != nil {
+		parts = append(parts, fmt.Sprintf("dirty rate: %s/s", formatBytes(*progress.DirtyMemoryRateBytes)))
+	}
+	if progress.Iteration != nil {
+		parts = append(parts, fmt.Sprintf("iteration: %d", *progress.Iteration))
+	}
+	if progress.ExpectedDowntimeMilliseconds != nil {
+		parts = append(parts, fmt.Sprintf("expected downtime: %s", time.Duration(*progress.ExpectedDowntimeMilliseconds)*time.Millisecond))
+	}
+	if progress.AutoConvergeThrottle != nil {
+		parts = append(parts, fmt.Sprintf("throttle: %d%%", *progress.AutoConvergeThrottle))
+	}
+	if progress.PostCopyRequests != nil {
+		parts = append(parts, fmt.Sprintf("post-copy requests: %d", *progress.PostCopyRequests))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatBytes(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
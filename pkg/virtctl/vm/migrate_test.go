@@ -21,6 +21,8 @@ package vm_test
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -30,6 +32,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/vm"
 	"kubevirt.io/kubevirt/tests/clientcmd"
 )
 
@@ -70,4 +74,73 @@ var _ = Describe("Migrate command", func() {
 		Entry("with default", &v1.MigrateOptions{}),
 		Entry("with dry-run option", &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}),
 	)
+
+	Context("with --watch", func() {
+		var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+		var migration *v1.VirtualMachineInstanceMigration
+		var listOptions k8smetav1.ListOptions
+
+		BeforeEach(func() {
+			migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+			migration = kubecli.NewMinimalMigration(fmt.Sprintf("%s-%s", vmName, "migration"))
+			listOptions = k8smetav1.ListOptions{LabelSelector: fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmName)}
+
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Migrate(context.Background(), vmName, &v1.MigrateOptions{}).Return(nil).Times(1)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).Return(migrationInterface).AnyTimes()
+
+			interval := vm.MigrationWatchInterval
+			vm.MigrationWatchInterval = time.Millisecond
+			DeferCleanup(func() {
+				vm.MigrationWatchInterval = interval
+			})
+		})
+
+		It("should watch the migration until it succeeded", func() {
+			migration.Status.Phase = v1.MigrationRunning
+			running := migration.DeepCopy()
+			running.Status.Progress = &v1.MigrationProgress{
+				DataTotalBytes:     pointer.P(int64(4096)),
+				DataProcessedBytes: pointer.P(int64(1024)),
+				DataRemainingBytes: pointer.P(int64(3072)),
+				Iteration:          pointer.P(int64(1)),
+			}
+			succeeded := migration.DeepCopy()
+			succeeded.Status.Phase = v1.MigrationSucceeded
+
+			migrationInterface.EXPECT().List(context.Background(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*migration},
+			}, nil).Times(1)
+			gomock.InOrder(
+				migrationInterface.EXPECT().Get(context.Background(), migration.Name, k8smetav1.GetOptions{}).Return(running, nil),
+				migrationInterface.EXPECT().Get(context.Background(), migration.Name, k8smetav1.GetOptions{}).Return(succeeded, nil),
+			)
+
+			Expect(clientcmd.NewRepeatableVirtctlCommand("migrate", "--watch", vmName)()).To(Succeed())
+		})
+
+		It("should fail if the watched migration failed", func() {
+			migration.Status.Phase = v1.MigrationScheduling
+			failed := migration.DeepCopy()
+			failed.Status.Phase = v1.MigrationFailed
+
+			migrationInterface.EXPECT().List(context.Background(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*migration},
+			}, nil).Times(1)
+			migrationInterface.EXPECT().Get(context.Background(), migration.Name, k8smetav1.GetOptions{}).Return(failed, nil).Times(1)
+
+			err := clientcmd.NewRepeatableVirtctlCommand("migrate", "--watch", vmName)()
+			Expect(err).To(MatchError(ContainSubstring("failed")))
+		})
+
+		It("should fail if no active migration is found", func() {
+			migration.Status.Phase = v1.MigrationSucceeded
+			migrationInterface.EXPECT().List(context.Background(), listOptions).Return(&v1.VirtualMachineInstanceMigrationList{
+				Items: []v1.VirtualMachineInstanceMigration{*migration},
+			}, nil).Times(1)
+
+			err := clientcmd.NewRepeatableVirtctlCommand("migrate", "--watch", vmName)()
+			Expect(err).To(MatchError(ContainSubstring("Found no active migration")))
+		})
+	})
 })
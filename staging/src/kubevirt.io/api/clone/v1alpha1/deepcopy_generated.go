@@ -105,6 +105,32 @@ func (in *VirtualMachineCloneList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineCloneReplicaStatus) DeepCopyInto(out *VirtualMachineCloneReplicaStatus) {
+	*out = *in
+	if in.RestoreName != nil {
+		in, out := &in.RestoreName, &out.RestoreName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetName != nil {
+		in, out := &in.TargetName, &out.TargetName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineCloneReplicaStatus.
+func (in *VirtualMachineCloneReplicaStatus) DeepCopy() *VirtualMachineCloneReplicaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineCloneReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 	*out = *in
@@ -118,6 +144,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(v1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetNamespace != nil {
+		in, out := &in.TargetNamespace, &out.TargetNamespace
+		*out = new(string)
+		**out = **in
+	}
 	if in.AnnotationFilters != nil {
 		in, out := &in.AnnotationFilters, &out.AnnotationFilters
 		*out = make([]string, len(*in))
@@ -141,6 +172,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -183,6 +219,13 @@ func (in *VirtualMachineCloneStatus) DeepCopyInto(out *VirtualMachineCloneStatus
 		*out = new(string)
 		**out = **in
 	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]VirtualMachineCloneReplicaStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -59,13 +59,24 @@ type VirtualMachineCloneSpec struct {
 	// Target is the outcome of the cloning process.
 	// Currently supported source types are:
 	// - VirtualMachine of kubevirt.io API group
+	// - VirtualMachineSnapshot of snapshot.kubevirt.io API group
 	// - Empty (nil).
 	// If the target is not provided, the target type would default to VirtualMachine and a random
 	// name would be generated for the target. The target's name can be viewed by
 	// inspecting status "TargetName" field below.
+	// A VirtualMachineSnapshot target can only be cloned from a VirtualMachine source. The snapshot
+	// is not owned by the clone and is kept after the clone is deleted, so that it can be used as
+	// the source of further clones.
 	// +optional
 	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
 
+	// TargetNamespace is the namespace the target VirtualMachine is created in. Defaults to the
+	// namespace of the clone. When it differs from the clone's namespace, the target must be a named
+	// VirtualMachine, the requester must be allowed to create VirtualMachines and PersistentVolumeClaims
+	// in the target namespace and the cloned volumes are moved there using CDI ObjectTransfers.
+	// +optional
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+
 	// Example use: "!some/key*".
 	// For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
 	// +optional
@@ -88,6 +99,18 @@ type VirtualMachineCloneSpec struct {
 	// be generated automatically.
 	// +optional
 	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+	// Replicas is the number of VirtualMachines cloned out of the source. Defaults to 1, at most 100.
+	// All the replicas are restored from a single snapshot of the source. Each replica gets its own MAC
+	// addresses and SMBios serial: the addresses in NewMacAddresses are incremented by the replica index
+	// and the replica index is appended to NewSMBiosSerial.
+	// Cloning more than one replica is only supported for VirtualMachine targets in the clone's namespace.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// TargetNamePattern is the pattern the names of the replicas are generated from, where "{{ .Index }}"
+	// is replaced by the index of the replica. Defaults to "<target name>-{{ .Index }}".
+	// Only used when cloning more than one replica.
+	// +optional
+	TargetNamePattern string `json:"targetNamePattern,omitempty"`
 }
 
 type VirtualMachineClonePhase string
@@ -97,6 +120,7 @@ const (
 	SnapshotInProgress VirtualMachineClonePhase = "SnapshotInProgress"
 	CreatingTargetVM   VirtualMachineClonePhase = "CreatingTargetVM"
 	RestoreInProgress  VirtualMachineClonePhase = "RestoreInProgress"
+	TransferInProgress VirtualMachineClonePhase = "TransferInProgress"
 	Succeeded          VirtualMachineClonePhase = "Succeeded"
 	Failed             VirtualMachineClonePhase = "Failed"
 	Unknown            VirtualMachineClonePhase = "Unknown"
@@ -125,6 +149,27 @@ type VirtualMachineCloneStatus struct {
 	// +optional
 	// +nullable
 	TargetName *string `json:"targetName,omitempty"`
+
+	// Replicas reports the progress of each replica when cloning more than one replica
+	// +optional
+	// +listType=atomic
+	Replicas []VirtualMachineCloneReplicaStatus `json:"replicas,omitempty"`
+}
+
+// VirtualMachineCloneReplicaStatus is the status of a single replica of a clone
+type VirtualMachineCloneReplicaStatus struct {
+	Index int32 `json:"index"`
+
+	// +optional
+	Phase VirtualMachineClonePhase `json:"phase,omitempty"`
+
+	// +optional
+	// +nullable
+	RestoreName *string `json:"restoreName,omitempty"`
+
+	// +optional
+	// +nullable
+	TargetName *string `json:"targetName,omitempty"`
 }
 
 // ConditionType is the const type for Conditions
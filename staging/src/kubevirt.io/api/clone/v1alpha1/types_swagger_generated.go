@@ -18,12 +18,15 @@ func (VirtualMachineCloneTemplateFilters) SwaggerDoc() map[string]string {
 func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"source":            "Source is the object that would be cloned. Currently supported source types are:\nVirtualMachine of kubevirt.io API group,\nVirtualMachineSnapshot of snapshot.kubevirt.io API group",
-		"target":            "Target is the outcome of the cloning process.\nCurrently supported source types are:\n- VirtualMachine of kubevirt.io API group\n- Empty (nil).\nIf the target is not provided, the target type would default to VirtualMachine and a random\nname would be generated for the target. The target's name can be viewed by\ninspecting status \"TargetName\" field below.\n+optional",
+		"target":            "Target is the outcome of the cloning process.\nCurrently supported source types are:\n- VirtualMachine of kubevirt.io API group\n- VirtualMachineSnapshot of snapshot.kubevirt.io API group\n- Empty (nil).\nIf the target is not provided, the target type would default to VirtualMachine and a random\nname would be generated for the target. The target's name can be viewed by\ninspecting status \"TargetName\" field below.\nA VirtualMachineSnapshot target can only be cloned from a VirtualMachine source. The snapshot\nis not owned by the clone and is kept after the clone is deleted, so that it can be used as\nthe source of further clones.\n+optional",
+		"targetNamespace":   "TargetNamespace is the namespace the target VirtualMachine is created in. Defaults to the\nnamespace of the clone. When it differs from the clone's namespace, the target must be a named\nVirtualMachine, the requester must be allowed to create VirtualMachines and PersistentVolumeClaims\nin the target namespace and the cloned volumes are moved there using CDI ObjectTransfers.\n+optional",
 		"annotationFilters": "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"labelFilters":      "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"template":          "For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional",
 		"newMacAddresses":   "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the\nvalue is the new mac address. If this field is not specified, a new MAC address will\nbe generated automatically, as for any interface that is not included in this map.\n+optional",
 		"newSMBiosSerial":   "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will\nbe generated automatically.\n+optional",
+		"replicas":          "Replicas is the number of VirtualMachines cloned out of the source. Defaults to 1, at most 100.\nAll the replicas are restored from a single snapshot of the source. Each replica gets its own MAC\naddresses and SMBios serial: the addresses in NewMacAddresses are incremented by the replica index\nand the replica index is appended to NewSMBiosSerial.\nCloning more than one replica is only supported for VirtualMachine targets in the clone's namespace.\n+optional",
+		"targetNamePattern": "TargetNamePattern is the pattern the names of the replicas are generated from, where \"{{ .Index }}\"\nis replaced by the index of the replica. Defaults to \"<target name>-{{ .Index }}\".\nOnly used when cloning more than one replica.\n+optional",
 	}
 }
 
@@ -35,6 +38,16 @@ func (VirtualMachineCloneStatus) SwaggerDoc() map[string]string {
 		"snapshotName": "+optional\n+nullable",
 		"restoreName":  "+optional\n+nullable",
 		"targetName":   "+optional\n+nullable",
+		"replicas":     "Replicas reports the progress of each replica when cloning more than one replica\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineCloneReplicaStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineCloneReplicaStatus is the status of a single replica of a clone",
+		"phase":       "+optional",
+		"restoreName": "+optional\n+nullable",
+		"targetName":  "+optional\n+nullable",
 	}
 }
 
@@ -748,6 +748,26 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]DHCPRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(DHCPv6Options)
+		**out = **in
+	}
 	return
 }
 
@@ -777,6 +797,38 @@ func (in *DHCPPrivateOptions) DeepCopy() *DHCPPrivateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRoute) DeepCopyInto(out *DHCPRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRoute.
+func (in *DHCPRoute) DeepCopy() *DHCPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPv6Options) DeepCopyInto(out *DHCPv6Options) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPv6Options.
+func (in *DHCPv6Options) DeepCopy() *DHCPv6Options {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPv6Options)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	*out = *in
@@ -1839,6 +1891,43 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecOptions) DeepCopyInto(out *GuestExecOptions) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecOptions.
+func (in *GuestExecOptions) DeepCopy() *GuestExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecResult) DeepCopyInto(out *GuestExecResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecResult.
+func (in *GuestExecResult) DeepCopy() *GuestExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -2127,6 +2216,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2140,6 +2234,59 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(InterfaceBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(InterfaceBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidthLimit) DeepCopyInto(out *InterfaceBandwidthLimit) {
+	*out = *in
+	out.Average = in.Average.DeepCopy()
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidthLimit.
+func (in *InterfaceBandwidthLimit) DeepCopy() *InterfaceBandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2899,6 +3046,23 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -3097,6 +3261,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AllowAdaptiveTuning != nil {
+		in, out := &in.AllowAdaptiveTuning, &out.AllowAdaptiveTuning
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DisableTLS != nil {
 		in, out := &in.DisableTLS, &out.DisableTLS
 		*out = new(bool)
@@ -3112,6 +3281,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3125,6 +3299,89 @@ func (in *MigrationConfiguration) DeepCopy() *MigrationConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationProgress) DeepCopyInto(out *MigrationProgress) {
+	*out = *in
+	in.LastUpdateTimestamp.DeepCopyInto(&out.LastUpdateTimestamp)
+	if in.ElapsedMilliseconds != nil {
+		in, out := &in.ElapsedMilliseconds, &out.ElapsedMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DataTotalBytes != nil {
+		in, out := &in.DataTotalBytes, &out.DataTotalBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DataProcessedBytes != nil {
+		in, out := &in.DataProcessedBytes, &out.DataProcessedBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DataRemainingBytes != nil {
+		in, out := &in.DataRemainingBytes, &out.DataRemainingBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MemoryTransferRateBytes != nil {
+		in, out := &in.MemoryTransferRateBytes, &out.MemoryTransferRateBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DirtyMemoryRateBytes != nil {
+		in, out := &in.DirtyMemoryRateBytes, &out.DirtyMemoryRateBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Iteration != nil {
+		in, out := &in.Iteration, &out.Iteration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ExpectedDowntimeMilliseconds != nil {
+		in, out := &in.ExpectedDowntimeMilliseconds, &out.ExpectedDowntimeMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoConvergeThrottle != nil {
+		in, out := &in.AutoConvergeThrottle, &out.AutoConvergeThrottle
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PostCopyRequests != nil {
+		in, out := &in.PostCopyRequests, &out.PostCopyRequests
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationProgress.
+func (in *MigrationProgress) DeepCopy() *MigrationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationReceiveToken) DeepCopyInto(out *MigrationReceiveToken) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationReceiveToken.
+func (in *MigrationReceiveToken) DeepCopy() *MigrationReceiveToken {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationReceiveToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultusNetwork) DeepCopyInto(out *MultusNetwork) {
 	*out = *in
@@ -4951,7 +5208,7 @@ func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInsta
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -5042,9 +5299,40 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationSource) DeepCopyInto(out *VirtualMachineInstanceMigrationSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationSource.
+func (in *VirtualMachineInstanceMigrationSource) DeepCopy() *VirtualMachineInstanceMigrationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(MigrationPriority)
+		**out = **in
+	}
+	if in.SendTo != nil {
+		in, out := &in.SendTo, &out.SendTo
+		*out = new(VirtualMachineInstanceMigrationSource)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(VirtualMachineInstanceMigrationTarget)
+		**out = **in
+	}
 	return
 }
 
@@ -5130,6 +5418,20 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopyInto(out *VirtualMachin
 		*out = new(VirtualMachineInstanceMigrationState)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExpectedStartTimestamp != nil {
+		in, out := &in.ExpectedStartTimestamp, &out.ExpectedStartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(MigrationProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReceiveToken != nil {
+		in, out := &in.ReceiveToken, &out.ReceiveToken
+		*out = new(MigrationReceiveToken)
+		**out = **in
+	}
 	return
 }
 
@@ -5143,6 +5445,22 @@ func (in *VirtualMachineInstanceMigrationStatus) DeepCopy() *VirtualMachineInsta
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationTarget) DeepCopyInto(out *VirtualMachineInstanceMigrationTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationTarget.
+func (in *VirtualMachineInstanceMigrationTarget) DeepCopy() *VirtualMachineInstanceMigrationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachineInstanceNetworkInterface) {
 	*out = *in
@@ -5151,6 +5469,11 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NATRules != nil {
+		in, out := &in.NATRules, &out.NATRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1277,15 +1277,51 @@ type Interface struct {
 	// +optional
 	ACPIIndex int `json:"acpiIndex,omitempty"`
 	// State represents the requested operational state of the interface.
-	// The (only) value supported is `absent`, expressing a request to remove the interface.
+	// The values supported are `absent`, expressing a request to remove the interface, and `up` and `down`,
+	// which set the link state of the interface without removing it. Defaults to `up`
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Bandwidth limits the traffic passing through the interface.
+	// It can be updated on a running VM when the HotplugNICs feature gate is enabled and the
+	// LiveUpdate VM rollout strategy is used, otherwise the update requires a restart.
+	// Only supported for bridge and masquerade interfaces.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+}
+
+// InterfaceBandwidth holds the traffic shaping limits of an interface.
+// Directions are relative to the guest: inbound traffic is received by the guest
+// and outbound traffic is sent by the guest.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *InterfaceBandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *InterfaceBandwidthLimit `json:"outbound,omitempty"`
+}
+
+// InterfaceBandwidthLimit describes the rates allowed in one direction of an interface.
+// The values are rounded down to kibibytes.
+type InterfaceBandwidthLimit struct {
+	// Average is the average rate, in bytes per second, the traffic is shaped to.
+	// For example: 10Mi
+	Average resource.Quantity `json:"average"`
+	// Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.
+	// Must not be lower than Average.
+	// +optional
+	Peak *resource.Quantity `json:"peak,omitempty"`
+	// Burst is the amount of bytes which can be sent at Peak rate.
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
 }
 
 type InterfaceState string
 
 const (
 	InterfaceStateAbsent InterfaceState = "absent"
+	InterfaceStateUp     InterfaceState = "up"
+	InterfaceStateDown   InterfaceState = "down"
 )
 
 // Extra DHCP options to use in the interface.
@@ -1302,6 +1338,20 @@ type DHCPOptions struct {
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// If specified will replace the classless static routes (option 121) computed from the pod interface.
+	// +optional
+	Routes []DHCPRoute `json:"routes,omitempty"`
+	// If specified will replace the DNS search domains (option 119, and option 24 of DHCPv6 for masquerade binding) computed from the pod.
+	// +optional
+	SearchDomains []string `json:"searchDomains,omitempty"`
+	// If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.
+	// It must not exceed the MTU of the pod interface.
+	// +optional
+	MTU *uint32 `json:"mtu,omitempty"`
+	// If specified will configure the IPv6 options passed to the interface.
+	// Only supported for interfaces with masquerade binding.
+	// +optional
+	IPv6 *DHCPv6Options `json:"ipv6,omitempty"`
 }
 
 func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
@@ -1332,6 +1382,34 @@ type DHCPPrivateOptions struct {
 	Value string `json:"value"`
 }
 
+// DHCPRoute is a classless static route passed to the interface via DHCP option 121.
+type DHCPRoute struct {
+	// Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.
+	// Required.
+	Destination string `json:"destination"`
+	// Gateway is the IPv4 address of the route next hop.
+	// When omitted the destination is reachable directly on the link.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// DHCPv6Options defines the IPv6 options passed to the interface.
+// They are only supported with masquerade binding: interfaces with bridge binding get none of them,
+// their guest is not served an IPv6 address by the built-in DHCPv6 server.
+type DHCPv6Options struct {
+	// If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).
+	// The prefix is routed to the guest address and its traffic leaves the pod through the NAT.
+	// +optional
+	DelegatedPrefix string `json:"delegatedPrefix,omitempty"`
+	// If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.
+	// They announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest
+	// to get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the
+	// VM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded
+	// to the DHCPv6 address.
+	// +optional
+	RouterAdvertisements bool `json:"routerAdvertisements,omitempty"`
+}
+
 // Represents the method which will be used to connect the interface to the guest.
 // Only one of its members may be specified.
 type InterfaceBindingMethod struct {
@@ -1362,6 +1440,9 @@ type InterfaceBridge struct{}
 type DeprecatedInterfaceSlirp struct{}
 
 // InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.
+// Traffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded
+// back to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is
+// sent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.
 type InterfaceMasquerade struct{}
 
 // InterfaceSRIOV connects to a given network by passing-through an SR-IOV PCI device via vfio.
@@ -1392,13 +1473,19 @@ type Port struct {
 	// referred to by services.
 	// +optional
 	Name string `json:"name,omitempty"`
-	// Protocol for port. Must be UDP or TCP.
+	// Protocol for port. Must be UDP, TCP or ALL.
+	// ALL forwards the port for any transport protocol and is supported only by the masquerade binding.
 	// Defaults to "TCP".
 	// +optional
 	Protocol string `json:"protocol,omitempty"`
 	// Number of port to expose for the virtual machine.
 	// This must be a valid port number, 0 < x < 65536.
 	Port int32 `json:"port"`
+	// EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.
+	// It must not be lower than Port.
+	// Supported only by the masquerade binding.
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
 }
 
 type AccessCredentialSecretSource struct {
@@ -677,7 +677,25 @@ func (Interface) SwaggerDoc() map[string]string {
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
-		"state":       "State represents the requested operational state of the interface.\nThe (only) value supported is `absent`, expressing a request to remove the interface.\n+optional",
+		"state":       "State represents the requested operational state of the interface.\nThe values supported are `absent`, expressing a request to remove the interface, and `up` and `down`,\nwhich set the link state of the interface without removing it. Defaults to `up`\n+optional",
+		"bandwidth":   "Bandwidth limits the traffic passing through the interface.\nIt can be updated on a running VM when the HotplugNICs feature gate is enabled and the\nLiveUpdate VM rollout strategy is used, otherwise the update requires a restart.\nOnly supported for bridge and masquerade interfaces.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth holds the traffic shaping limits of an interface.\nDirections are relative to the guest: inbound traffic is received by the guest\nand outbound traffic is sent by the guest.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (InterfaceBandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "InterfaceBandwidthLimit describes the rates allowed in one direction of an interface.\nThe values are rounded down to kibibytes.",
+		"average": "Average is the average rate, in bytes per second, the traffic is shaped to.\nFor example: 10Mi",
+		"peak":    "Peak is the maximum rate, in bytes per second, the traffic is allowed to reach while sending bursts.\nMust not be lower than Average.\n+optional",
+		"burst":   "Burst is the amount of bytes which can be sent at Peak rate.\n+optional",
 	}
 }
 
@@ -688,6 +706,10 @@ func (DHCPOptions) SwaggerDoc() map[string]string {
 		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
 		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
 		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"routes":         "If specified will replace the classless static routes (option 121) computed from the pod interface.\n+optional",
+		"searchDomains":  "If specified will replace the DNS search domains (option 119, and option 24 of DHCPv6 for masquerade binding) computed from the pod.\n+optional",
+		"mtu":            "If specified will replace the MTU (option 26, and the MTU of the IPv6 router advertisements) computed from the pod interface.\nIt must not exceed the MTU of the pod interface.\n+optional",
+		"ipv6":           "If specified will configure the IPv6 options passed to the interface.\nOnly supported for interfaces with masquerade binding.\n+optional",
 	}
 }
 
@@ -699,6 +721,22 @@ func (DHCPPrivateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DHCPRoute) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "DHCPRoute is a classless static route passed to the interface via DHCP option 121.",
+		"destination": "Destination is the IPv4 network of the route in CIDR notation, 0.0.0.0/0 for the default route.\nRequired.",
+		"gateway":     "Gateway is the IPv4 address of the route next hop.\nWhen omitted the destination is reachable directly on the link.\n+optional",
+	}
+}
+
+func (DHCPv6Options) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "DHCPv6Options defines the IPv6 options passed to the interface.\nThey are only supported with masquerade binding: interfaces with bridge binding get none of them,\ntheir guest is not served an IPv6 address by the built-in DHCPv6 server.",
+		"delegatedPrefix":      "If specified will delegate the IPv6 prefix, in CIDR notation, to the interface via DHCPv6 prefix delegation (IA_PD).\nThe prefix is routed to the guest address and its traffic leaves the pod through the NAT.\n+optional",
+		"routerAdvertisements": "If set, router advertisements are sent to the interface, periodically and in reply to router solicitations.\nThey announce a default route, the MTU and the on-link prefix of the interface IPv6 address, and tell the guest\nto get its address over DHCPv6. The prefix is offered for stateless address autoconfiguration (SLAAC) when the\nVM IPv6 network is a /64: the SLAAC addresses leave the pod through the NAT, inbound traffic is only forwarded\nto the DHCPv6 address.\n+optional",
+	}
+}
+
 func (InterfaceBindingMethod) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":        "Represents the method which will be used to connect the interface to the guest.\nOnly one of its members may be specified.",
@@ -722,7 +760,7 @@ func (DeprecatedInterfaceSlirp) SwaggerDoc() map[string]string {
 
 func (InterfaceMasquerade) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.",
+		"": "InterfaceMasquerade connects to a given network using netfilter rules to nat the traffic.\nTraffic the guest sends to the pod IP, or to the IP of a Service backed by its own pod, is forwarded\nback to the guest like inbound traffic. Service traffic leaves the pod masqueraded to the pod IP and is\nsent back to the pod by the node network, which masquerades it like for any pod reaching its own Service.",
 	}
 }
 
@@ -755,8 +793,9 @@ func (Port) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "Port represents a port to expose from the virtual machine.\nDefault protocol TCP.\nThe port field is mandatory",
 		"name":     "If specified, this must be an IANA_SVC_NAME and unique within the pod. Each\nnamed port in a pod must have a unique name. Name for the port that can be\nreferred to by services.\n+optional",
-		"protocol": "Protocol for port. Must be UDP or TCP.\nDefaults to \"TCP\".\n+optional",
+		"protocol": "Protocol for port. Must be UDP, TCP or ALL.\nALL forwards the port for any transport protocol and is supported only by the masquerade binding.\nDefaults to \"TCP\".\n+optional",
 		"port":     "Number of port to expose for the virtual machine.\nThis must be a valid port number, 0 < x < 65536.",
+		"endPort":  "EndPort, if specified, exposes the range of ports from Port to EndPort, inclusive.\nIt must not be lower than Port.\nSupported only by the masquerade binding.\n+optional",
 	}
 }
 
@@ -623,6 +623,11 @@ const (
 	// VirtualMachineInstanceMigrationAbortRequested indicates that live migration abort has been requested
 	VirtualMachineInstanceMigrationAbortRequested          VirtualMachineInstanceMigrationConditionType = "migrationAbortRequested"
 	VirtualMachineInstanceMigrationRejectedByResourceQuota VirtualMachineInstanceMigrationConditionType = "migrationRejectedByResourceQuota"
+	// VirtualMachineInstanceMigrationWaitingForMaintenanceWindow indicates that the migration is queued until a maintenance window opens
+	VirtualMachineInstanceMigrationWaitingForMaintenanceWindow VirtualMachineInstanceMigrationConditionType = "migrationWaitingForMaintenanceWindow"
+	// VirtualMachineInstanceMigrationPreempted indicates that the target pod of the migration is removed to make room for a
+	// migration with a higher priority, the migration is pending again once the target pod is gone
+	VirtualMachineInstanceMigrationPreempted VirtualMachineInstanceMigrationConditionType = "migrationPreempted"
 )
 
 type VirtualMachineInstanceCondition struct {
@@ -660,6 +665,16 @@ func (m *VirtualMachineInstanceMigration) IsRunning() bool {
 	return true
 }
 
+// IsDecentralizedSource returns true if the migration sends the VMI to another cluster
+func (m *VirtualMachineInstanceMigration) IsDecentralizedSource() bool {
+	return m.Spec.SendTo != nil
+}
+
+// IsDecentralizedTarget returns true if the migration receives the VMI from another cluster
+func (m *VirtualMachineInstanceMigration) IsDecentralizedTarget() bool {
+	return m.Spec.Receive != nil
+}
+
 // The migration phase indicates that the target pod should have already been created
 func (m *VirtualMachineInstanceMigration) TargetIsCreated() bool {
 	return m.Status.Phase != MigrationPhaseUnset &&
@@ -690,6 +705,13 @@ type VirtualMachineInstanceNetworkInterface struct {
 	InfoSource string `json:"infoSource,omitempty"`
 	// Specifies how many queues are allocated by MultiQueue
 	QueueCount int32 `json:"queueCount,omitempty"`
+	// LinkState is the link state of the interface in the domain, either up or down
+	LinkState InterfaceState `json:"linkState,omitempty"`
+	// NATRules lists the nftables rules programmed in the pod network namespace for an interface
+	// with masquerade binding. It is informational and meant for troubleshooting.
+	// +optional
+	// +listType=atomic
+	NATRules []string `json:"natRules,omitempty"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -756,6 +778,13 @@ type VirtualMachineInstanceMigrationState struct {
 	FailureReason string `json:"failureReason,omitempty"`
 	// The VirtualMachineInstanceMigration object associated with this migration
 	MigrationUID types.UID `json:"migrationUid,omitempty"`
+	// The ID of the decentralized migration, shared by the migrations in the source and the
+	// target cluster
+	MigrationID string `json:"migrationID,omitempty"`
+	// The migration endpoint of the target cluster a decentralized migration connects to
+	TargetMigrationEndpoint string `json:"targetMigrationEndpoint,omitempty"`
+	// The token the receiving migration of the target cluster issued for a decentralized migration
+	TargetMigrationEndpointToken string `json:"targetMigrationEndpointToken,omitempty"`
 	// Lets us know if the vmi is currently running pre or post copy migration
 	Mode MigrationMode `json:"mode,omitempty"`
 	// Name of the migration policy. If string is empty, no policy is matched
@@ -879,6 +908,13 @@ const (
 	// Machine Instance migration job. Needed because with CRDs we can't use field
 	// selectors. Used on VirtualMachineInstance.
 	MigrationTargetNodeNameLabel string = "kubevirt.io/migrationTargetNodeName"
+	// This label holds the ID of a decentralized migration, it allows finding the migration
+	// receiving a VMI from another cluster. Used on VirtualMachineInstanceMigration.
+	MigrationIDLabel string = "kubevirt.io/migration-id"
+	// This annotation indicates that a VMI is created to receive a decentralized migration
+	// from another cluster. Its pod is scheduled, but the guest is not started.
+	// Used on VirtualMachineInstance.
+	MigrationReceiverAnnotation string = "kubevirt.io/migration-receiver"
 	// This annotation indicates that a migration is the result of an
 	// automated evacuation
 	EvacuationMigrationAnnotation string = "kubevirt.io/evacuationMigration"
@@ -1351,8 +1387,61 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+	// Urgent migrations start right away, without waiting for the maintenance windows of the migration
+	// configuration. Migrations evacuating a node that is being drained are always considered urgent.
+	// Only KubeVirt components and users allowed to update the KubeVirt installation can create urgent migrations
+	// +optional
+	Urgent bool `json:"urgent,omitempty"`
+	// Priority defines the order in which pending migrations are started when the parallel migration
+	// limits are reached. Migrations without a priority are handled as user-triggered migrations.
+	// Only KubeVirt components and users allowed to update the KubeVirt installation can set a system priority
+	// +optional
+	Priority *MigrationPriority `json:"priority,omitempty"`
+	// SendTo makes the migration the source of a decentralized migration, which moves the VMI to
+	// the migration receiving it in another cluster
+	// +optional
+	SendTo *VirtualMachineInstanceMigrationSource `json:"sendTo,omitempty"`
+	// Receive makes the migration the target of a decentralized migration, which receives the VMI
+	// from the migration sending it from another cluster
+	// +optional
+	Receive *VirtualMachineInstanceMigrationTarget `json:"receive,omitempty"`
 }
 
+// VirtualMachineInstanceMigrationSource describes where the source of a decentralized migration
+// sends the VMI to
+type VirtualMachineInstanceMigrationSource struct {
+	// MigrationID identifies the pair of migrations in the source and the target cluster, it has
+	// to match the ID of the receiving migration
+	MigrationID string `json:"migrationID"`
+	// ConnectURL is the host:port of the migration endpoint exposed by the target cluster
+	ConnectURL string `json:"connectURL"`
+	// Token is the receive token issued with the receiving migration, see status.receiveToken
+	// of the migration in the target cluster
+	Token string `json:"token"`
+}
+
+// VirtualMachineInstanceMigrationTarget describes which decentralized migration the target
+// receives the VMI from
+type VirtualMachineInstanceMigrationTarget struct {
+	// MigrationID identifies the pair of migrations in the source and the target cluster, it has
+	// to match the ID of the sending migration
+	MigrationID string `json:"migrationID"`
+}
+
+// MigrationPriority defines the order in which pending migrations are started, and which
+// migrations get preempted to make room for more important ones
+// +kubebuilder:validation:Enum=system-critical;user-triggered;system-maintenance
+type MigrationPriority string
+
+const (
+	// MigrationPrioritySystemCritical is used by migrations evacuating nodes, e.g. during a node drain
+	MigrationPrioritySystemCritical MigrationPriority = "system-critical"
+	// MigrationPriorityUserTriggered is used by migrations requested by users
+	MigrationPriorityUserTriggered MigrationPriority = "user-triggered"
+	// MigrationPrioritySystemMaintenance is used by migrations updating workloads, e.g. after an upgrade
+	MigrationPrioritySystemMaintenance MigrationPriority = "system-maintenance"
+)
+
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
 type VirtualMachineInstanceMigrationPhaseTransitionTimestamp struct {
 	// Phase is the status of the VirtualMachineInstanceMigrationPhase in kubernetes world. It is not the VirtualMachineInstanceMigrationPhase status, but partially correlates to it.
@@ -1371,6 +1460,71 @@ type VirtualMachineInstanceMigrationStatus struct {
 	PhaseTransitionTimestamps []VirtualMachineInstanceMigrationPhaseTransitionTimestamp `json:"phaseTransitionTimestamps,omitempty"`
 	// Represents the status of a live migration
 	MigrationState *VirtualMachineInstanceMigrationState `json:"migrationState,omitempty"`
+	// ExpectedStartTimestamp is the time at which the next maintenance window opens, while the migration
+	// is waiting for it to start
+	// +optional
+	// +nullable
+	ExpectedStartTimestamp *metav1.Time `json:"expectedStartTimestamp,omitempty"`
+	// Progress of the running migration, as reported periodically by the source node
+	// +optional
+	Progress *MigrationProgress `json:"progress,omitempty"`
+	// ReceiveToken is issued for migrations receiving a decentralized migration. The sending migration
+	// has to present it to the migration endpoint.
+	// +optional
+	ReceiveToken *MigrationReceiveToken `json:"receiveToken,omitempty"`
+}
+
+// MigrationReceiveToken authorizes a single peer cluster to send a VMI to the receiving migration
+type MigrationReceiveToken struct {
+	// Token is the secret the sending migration presents to the migration endpoint
+	Token string `json:"token"`
+	// VMIUID is the UID of the receiving VMI the token was issued for
+	VMIUID types.UID `json:"vmiUID"`
+	// PeerCertificateSHA256 is the SHA-256 fingerprint of the certificate of the first peer presenting
+	// the token. Connections of other peers are rejected.
+	// +optional
+	PeerCertificateSHA256 string `json:"peerCertificateSHA256,omitempty"`
+}
+
+// MigrationProgress reports the progress of a running migration, based on the job statistics of the hypervisor
+// on the source node. Fields are omitted when the hypervisor does not report them.
+type MigrationProgress struct {
+	// The time the progress was last updated at
+	LastUpdateTimestamp metav1.Time `json:"lastUpdateTimestamp"`
+	// The time elapsed since the migration started, in milliseconds
+	// +optional
+	ElapsedMilliseconds *int64 `json:"elapsedMilliseconds,omitempty"`
+	// The total amount of data to be transferred, in bytes
+	// +optional
+	DataTotalBytes *int64 `json:"dataTotalBytes,omitempty"`
+	// The amount of data transferred so far, in bytes
+	// +optional
+	DataProcessedBytes *int64 `json:"dataProcessedBytes,omitempty"`
+	// The amount of data which remains to be transferred, in bytes
+	// +optional
+	DataRemainingBytes *int64 `json:"dataRemainingBytes,omitempty"`
+	// The rate at which memory is transferred, in bytes per second
+	// +optional
+	MemoryTransferRateBytes *int64 `json:"memoryTransferRateBytes,omitempty"`
+	// The rate at which the guest dirties memory, in bytes per second. The migration only converges
+	// if it is lower than the memory transfer rate.
+	// +optional
+	DirtyMemoryRateBytes *int64 `json:"dirtyMemoryRateBytes,omitempty"`
+	// The number of passes over the guest memory so far
+	// +optional
+	Iteration *int64 `json:"iteration,omitempty"`
+	// The expected downtime of the guest when switching over to the target, in milliseconds
+	// +optional
+	ExpectedDowntimeMilliseconds *int64 `json:"expectedDowntimeMilliseconds,omitempty"`
+	// The percentage the guest CPUs are throttled by to let the migration converge
+	// +optional
+	AutoConvergeThrottle *int64 `json:"autoConvergeThrottle,omitempty"`
+	// The mode of the migration, PostCopy once it switched to post copy
+	// +optional
+	Mode MigrationMode `json:"mode,omitempty"`
+	// The number of pages the target requested from the source in post copy mode
+	// +optional
+	PostCopyRequests *int64 `json:"postCopyRequests,omitempty"`
 }
 
 // VirtualMachineInstanceMigrationPhase is a label for the condition of a VirtualMachineInstanceMigration at the current time.
@@ -2310,6 +2464,28 @@ type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
 }
 
+// GuestExecOptions represent a command to run in the guest through the guest agent
+type GuestExecOptions struct {
+	// Command is the path of the executable to run in the guest
+	Command string `json:"command"`
+	// Args are the arguments passed to the command
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the command is allowed to run before it is considered failed
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GuestExecResult represent the outcome of a command run in the guest
+type GuestExecResult struct {
+	// ExitCode is the exit code of the command
+	ExitCode int32 `json:"exitCode"`
+	// StdOut is the standard output of the command
+	// +optional
+	StdOut string `json:"stdOut,omitempty"`
+}
+
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
 type VirtualMachineMemoryDumpRequest struct {
 	// ClaimName is the name of the pvc that will contain the memory dump
@@ -2645,6 +2821,11 @@ type MigrationConfiguration struct {
 	// If set to true, migrations will still start in pre-copy, but switch to post-copy when
 	// CompletionTimeoutPerGiB triggers. Defaults to false
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+	// AllowAdaptiveTuning lets migrations react to guests that dirty their memory faster than it is transferred.
+	// Such migrations start with auto-converge enabled, throttling the CPU by 30% and by another 20% for each
+	// iteration which does not converge. They switch to post-copy early if AllowPostCopy is true, otherwise
+	// they are aborted once the CPU throttle reached its maximum. Defaults to false
+	AllowAdaptiveTuning *bool `json:"allowAdaptiveTuning,omitempty"`
 	// When set to true, DisableTLS will disable the additional layer of live migration encryption
 	// provided by KubeVirt. This is usually a bad idea. Defaults to false
 	DisableTLS *bool `json:"disableTLS,omitempty"`
@@ -2656,6 +2837,24 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// MaintenanceWindows restricts when migrations that are not urgent are allowed to start.
+	// Workload update migrations, migrations triggered by evictions on nodes that are not being drained and
+	// user-requested migrations that are not marked as urgent wait until one of the windows opens.
+	// Defaults to none, meaning that migrations start as soon as possible
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring time window in which migrations that are not urgent are allowed to start.
+type MaintenanceWindow struct {
+	// Schedule is a cron expression, evaluated in UTC, of the times the window opens.
+	// It has the standard five fields (minute, hour, day of month, month and day of week),
+	// e.g. "0 22 * * 1-5" opens the window at 22:00 on weekdays
+	Schedule string `json:"schedule"`
+	// Duration is how long the window stays open. Migrations that started in the window are not
+	// interrupted when it closes
+	Duration metav1.Duration `json:"duration"`
 }
 
 // DiskVerification holds container disks verification limits
@@ -194,6 +194,8 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"interfaceName": "The interface name inside the Virtual Machine",
 		"infoSource":    "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
 		"queueCount":    "Specifies how many queues are allocated by MultiQueue",
+		"linkState":     "LinkState is the link state of the interface in the domain, either up or down",
+		"natRules":      "NATRules lists the nftables rules programmed in the pod network namespace for an interface\nwith masquerade binding. It is informational and meant for troubleshooting.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -229,6 +231,9 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"abortStatus":                    "Indicates the final status of the live migration abortion",
 		"failureReason":                  "Contains the reason why the migration failed",
 		"migrationUid":                   "The VirtualMachineInstanceMigration object associated with this migration",
+		"migrationID":                    "The ID of the decentralized migration, shared by the migrations in the source and the\ntarget cluster",
+		"targetMigrationEndpoint":        "The migration endpoint of the target cluster a decentralized migration connects to",
+		"targetMigrationEndpointToken":   "The token the receiving migration of the target cluster issued for a decentralized migration",
 		"mode":                           "Lets us know if the vmi is currently running pre or post copy migration",
 		"migrationPolicyName":            "Name of the migration policy. If string is empty, no policy is matched",
 		"migrationConfiguration":         "Migration configurations to apply",
@@ -313,7 +318,27 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"vmiName":  "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"urgent":   "Urgent migrations start right away, without waiting for the maintenance windows of the migration\nconfiguration. Migrations evacuating a node that is being drained are always considered urgent.\nOnly KubeVirt components and users allowed to update the KubeVirt installation can create urgent migrations\n+optional",
+		"priority": "Priority defines the order in which pending migrations are started when the parallel migration\nlimits are reached. Migrations without a priority are handled as user-triggered migrations.\nOnly KubeVirt components and users allowed to update the KubeVirt installation can set a system priority\n+optional",
+		"sendTo":   "SendTo makes the migration the source of a decentralized migration, which moves the VMI to\nthe migration receiving it in another cluster\n+optional",
+		"receive":  "Receive makes the migration the target of a decentralized migration, which receives the VMI\nfrom the migration sending it from another cluster\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationSource describes where the source of a decentralized migration\nsends the VMI to",
+		"migrationID": "MigrationID identifies the pair of migrations in the source and the target cluster, it has\nto match the ID of the receiving migration",
+		"connectURL":  "ConnectURL is the host:port of the migration endpoint exposed by the target cluster",
+		"token":       "Token is the receive token issued with the receiving migration, see status.receiveToken\nof the migration in the target cluster",
+	}
+}
+
+func (VirtualMachineInstanceMigrationTarget) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceMigrationTarget describes which decentralized migration the target\nreceives the VMI from",
+		"migrationID": "MigrationID identifies the pair of migrations in the source and the target cluster, it has\nto match the ID of the sending migration",
 	}
 }
 
@@ -330,6 +355,36 @@ func (VirtualMachineInstanceMigrationStatus) SwaggerDoc() map[string]string {
 		"":                          "VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.",
 		"phaseTransitionTimestamps": "PhaseTransitionTimestamp is the timestamp of when the last phase change occurred\n+listType=atomic\n+optional",
 		"migrationState":            "Represents the status of a live migration",
+		"expectedStartTimestamp":    "ExpectedStartTimestamp is the time at which the next maintenance window opens, while the migration\nis waiting for it to start\n+optional\n+nullable",
+		"progress":                  "Progress of the running migration, as reported periodically by the source node\n+optional",
+		"receiveToken":              "ReceiveToken is issued for migrations receiving a decentralized migration. The sending migration\nhas to present it to the migration endpoint.\n+optional",
+	}
+}
+
+func (MigrationReceiveToken) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "MigrationReceiveToken authorizes a single peer cluster to send a VMI to the receiving migration",
+		"token":                 "Token is the secret the sending migration presents to the migration endpoint",
+		"vmiUID":                "VMIUID is the UID of the receiving VMI the token was issued for",
+		"peerCertificateSHA256": "PeerCertificateSHA256 is the SHA-256 fingerprint of the certificate of the first peer presenting\nthe token. Connections of other peers are rejected.\n+optional",
+	}
+}
+
+func (MigrationProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                             "MigrationProgress reports the progress of a running migration, based on the job statistics of the hypervisor\non the source node. Fields are omitted when the hypervisor does not report them.",
+		"lastUpdateTimestamp":          "The time the progress was last updated at",
+		"elapsedMilliseconds":          "The time elapsed since the migration started, in milliseconds\n+optional",
+		"dataTotalBytes":               "The total amount of data to be transferred, in bytes\n+optional",
+		"dataProcessedBytes":           "The amount of data transferred so far, in bytes\n+optional",
+		"dataRemainingBytes":           "The amount of data which remains to be transferred, in bytes\n+optional",
+		"memoryTransferRateBytes":      "The rate at which memory is transferred, in bytes per second\n+optional",
+		"dirtyMemoryRateBytes":         "The rate at which the guest dirties memory, in bytes per second. The migration only converges\nif it is lower than the memory transfer rate.\n+optional",
+		"iteration":                    "The number of passes over the guest memory so far\n+optional",
+		"expectedDowntimeMilliseconds": "The expected downtime of the guest when switching over to the target, in milliseconds\n+optional",
+		"autoConvergeThrottle":         "The percentage the guest CPUs are throttled by to let the migration converge\n+optional",
+		"mode":                         "The mode of the migration, PostCopy once it switched to post copy\n+optional",
+		"postCopyRequests":             "The number of pages the target requested from the source in post copy mode\n+optional",
 	}
 }
 
@@ -675,6 +730,23 @@ func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestExecOptions represent a command to run in the guest through the guest agent",
+		"command":        "Command is the path of the executable to run in the guest",
+		"args":           "Args are the arguments passed to the command\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time the command is allowed to run before it is considered failed\n+optional",
+	}
+}
+
+func (GuestExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "GuestExecResult represent the outcome of a command run in the guest",
+		"exitCode": "ExitCode is the exit code of the command",
+		"stdOut":   "StdOut is the standard output of the command\n+optional",
+	}
+}
+
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
@@ -830,9 +902,19 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"progressTimeout":                   "ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.\nHitting this timeout means a migration transferred 0 data for that many seconds. The migration is\nthen considered stuck and therefore cancelled. Defaults to 150",
 		"unsafeMigrationOverride":           "UnsafeMigrationOverride allows live migrations to occur even if the compatibility check\nindicates the migration will be unsafe to the guest. Defaults to false",
 		"allowPostCopy":                     "AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs\nto successfully live-migrate. However, events like a network failure can cause a VMI crash.\nIf set to true, migrations will still start in pre-copy, but switch to post-copy when\nCompletionTimeoutPerGiB triggers. Defaults to false",
+		"allowAdaptiveTuning":               "AllowAdaptiveTuning lets migrations react to guests that dirty their memory faster than it is transferred.\nSuch migrations start with auto-converge enabled, throttling the CPU by 30% and by another 20% for each\niteration which does not converge. They switch to post-copy early if AllowPostCopy is true, otherwise\nthey are aborted once the CPU throttle reached its maximum. Defaults to false",
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"maintenanceWindows":                "MaintenanceWindows restricts when migrations that are not urgent are allowed to start.\nWorkload update migrations, migrations triggered by evictions on nodes that are not being drained and\nuser-requested migrations that are not marked as urgent wait until one of the windows opens.\nDefaults to none, meaning that migrations start as soon as possible\n+listType=atomic\n+optional",
+	}
+}
+
+func (MaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "MaintenanceWindow is a recurring time window in which migrations that are not urgent are allowed to start.",
+		"schedule": "Schedule is a cron expression, evaluated in UTC, of the times the window opens.\nIt has the standard five fields (minute, hour, day of month, month and day of week),\ne.g. \"0 22 * * 1-5\" opens the window at 22:00 on weekdays",
+		"duration": "Duration is how long the window stays open. Migrations that started in the window are not\ninterrupted when it closes",
 	}
 }
 
@@ -76,6 +76,22 @@ func (in *VirtualMachineExport) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineExportBundle) DeepCopyInto(out *VirtualMachineExportBundle) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineExportBundle.
+func (in *VirtualMachineExportBundle) DeepCopy() *VirtualMachineExportBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineExportBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineExportLink) DeepCopyInto(out *VirtualMachineExportLink) {
 	*out = *in
@@ -91,6 +107,11 @@ func (in *VirtualMachineExportLink) DeepCopyInto(out *VirtualMachineExportLink)
 		*out = make([]VirtualMachineExportManifest, len(*in))
 		copy(*out, *in)
 	}
+	if in.Bundles != nil {
+		in, out := &in.Bundles, &out.Bundles
+		*out = make([]VirtualMachineExportBundle, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -193,6 +214,11 @@ func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.BaseSnapshot != nil {
+		in, out := &in.BaseSnapshot, &out.BaseSnapshot
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
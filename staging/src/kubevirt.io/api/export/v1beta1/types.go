@@ -67,6 +67,13 @@ type VirtualMachineExportSpec struct {
 	// If this field is omitted, a reasonable default is applied.
 	// +optional
 	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+
+	// BaseSnapshot is the name of a VirtualMachineSnapshot of the same virtual machine, taken before the exported one.
+	// When set, the extents of the volumes which changed since the base snapshot are exposed, and the changed
+	// blocks can be retrieved with range requests of the raw volumes.
+	// Only supported when exporting a VirtualMachineSnapshot.
+	// +optional
+	BaseSnapshot *string `json:"baseSnapshot,omitempty"`
 }
 
 // VirtualMachineExportPhase is the current phase of the VirtualMachineExport
@@ -139,8 +146,30 @@ type VirtualMachineExportLink struct {
 	// +listMapKey=type
 	// +optional
 	Manifests []VirtualMachineExportManifest `json:"manifests,omitempty"`
+
+	// Bundles is a list of available bundles, which package all the exported volumes of the
+	// virtual machine together with a descriptor
+	// +listType=map
+	// +listMapKey=format
+	// +optional
+	Bundles []VirtualMachineExportBundle `json:"bundles,omitempty"`
 }
 
+// VirtualMachineExportBundle contains the format and URL of a bundle of the exported virtual machine
+type VirtualMachineExportBundle struct {
+	// Format is the format of the bundle at the specified URL
+	Format ExportBundleFormat `json:"format"`
+	// Url is the url that contains the bundle in the format specified
+	Url string `json:"url"`
+}
+
+type ExportBundleFormat string
+
+const (
+	// OVA is a tar archive of an OVF descriptor of the virtual machine and of its volumes in streamOptimized VMDK format
+	OVA ExportBundleFormat = "ova"
+)
+
 // VirtualMachineExportManifest contains the type and URL of the exported manifest
 type VirtualMachineExportManifest struct {
 	// Type is the type of manifest returned
@@ -176,10 +205,19 @@ const (
 	KubeVirtRaw ExportVolumeFormat = "raw"
 	// KubeVirtGZ is the volume in gzipped RAW format.
 	KubeVirtGz ExportVolumeFormat = "gzip"
+	// KubeVirtZstd is the volume in zstd compressed RAW format.
+	KubeVirtZstd ExportVolumeFormat = "zstd"
+	// KubeVirtQcow2 is the volume in sparse, uncompressed qcow2 format. Unallocated and all-zero ranges of the volume
+	// are left unallocated, which requires reading the allocated ranges of the volume before the download starts.
+	// Clusters are not compressed so that the size of the image is known up front, use KubeVirtGz or KubeVirtZstd
+	// for a compressed download.
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
 	// Dir is an uncompressed directory, which points to the root of a PersistentVolumeClaim, exposed using a FileServer https://pkg.go.dev/net/http#FileServer
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// Extents is a JSON description of the extents of the RAW volume which changed since the base snapshot
+	Extents ExportVolumeFormat = "extents"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
@@ -21,6 +21,7 @@ func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
 		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
 		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"baseSnapshot":   "BaseSnapshot is the name of a VirtualMachineSnapshot of the same virtual machine, taken before the exported one.\nWhen set, the extents of the volumes which changed since the base snapshot are exposed, and the changed\nblocks can be retrieved with range requests of the raw volumes.\nOnly supported when exporting a VirtualMachineSnapshot.\n+optional",
 	}
 }
 
@@ -51,6 +52,15 @@ func (VirtualMachineExportLink) SwaggerDoc() map[string]string {
 		"cert":      "Cert is the public CA certificate base64 encoded",
 		"volumes":   "Volumes is a list of available volumes to export\n+listType=map\n+listMapKey=name\n+optional",
 		"manifests": "Manifests is a list of available manifests for the export\n+listType=map\n+listMapKey=type\n+optional",
+		"bundles":   "Bundles is a list of available bundles, which package all the exported volumes of the\nvirtual machine together with a descriptor\n+listType=map\n+listMapKey=format\n+optional",
+	}
+}
+
+func (VirtualMachineExportBundle) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineExportBundle contains the format and URL of a bundle of the exported virtual machine",
+		"format": "Format is the format of the bundle at the specified URL",
+		"url":    "Url is the url that contains the bundle in the format specified",
 	}
 }
 
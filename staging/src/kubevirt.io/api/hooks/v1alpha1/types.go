@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package v1alpha1
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HookSidecar registers a hook sidecar approved by the cluster admin.
+// VMIs reference it by name or get it injected when they match its selector.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+type HookSidecar struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              HookSidecarSpec `json:"spec" valid:"required"`
+}
+
+type HookSidecarSpec struct {
+	// Image of the sidecar container
+	Image string `json:"image"`
+	// ImagePullPolicy of the sidecar container
+	//+optional
+	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Version of the hook API exposed by the sidecar, e.g. v1alpha3.
+	// It is passed to the sidecar with the --version argument, as expected by the sidecar-shim.
+	//+optional
+	Version string `json:"version,omitempty"`
+	// Args are passed to the sidecar container
+	//+listType=atomic
+	//+optional
+	Args []string `json:"args,omitempty"`
+	// Resources of the sidecar container, defaults to the resources of all the hook sidecars
+	//+optional
+	Resources *k8sv1.ResourceRequirements `json:"resources,omitempty"`
+	// Selector matches the labels of the VMIs the sidecar is injected into,
+	// in addition to the VMIs referencing it by name
+	//+optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// HookSidecarList is a list of HookSidecar
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type HookSidecarList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []HookSidecar `json:"items"`
+}
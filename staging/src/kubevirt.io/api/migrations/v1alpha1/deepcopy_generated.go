@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -53,7 +54,7 @@ func (in *MigrationPolicy) DeepCopyInto(out *MigrationPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -75,6 +76,28 @@ func (in *MigrationPolicy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationPolicyConflict) DeepCopyInto(out *MigrationPolicyConflict) {
+	*out = *in
+	out.VirtualMachineInstance = in.VirtualMachineInstance
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyConflict.
+func (in *MigrationPolicyConflict) DeepCopy() *MigrationPolicyConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationPolicyConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicyList) DeepCopyInto(out *MigrationPolicyList) {
 	*out = *in
@@ -116,6 +139,11 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(Selectors)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	if in.AllowAutoConverge != nil {
 		in, out := &in.AllowAutoConverge, &out.AllowAutoConverge
 		*out = new(bool)
@@ -136,6 +164,46 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.AllowAdaptiveTuning != nil {
+		in, out := &in.AllowAdaptiveTuning, &out.AllowAdaptiveTuning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ProgressTimeout != nil {
+		in, out := &in.ProgressTimeout, &out.ProgressTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UnsafeMigrationOverride != nil {
+		in, out := &in.UnsafeMigrationOverride, &out.UnsafeMigrationOverride
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableTLS != nil {
+		in, out := &in.DisableTLS, &out.DisableTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(string)
+		**out = **in
+	}
+	if in.ParallelMigrationsPerCluster != nil {
+		in, out := &in.ParallelMigrationsPerCluster, &out.ParallelMigrationsPerCluster
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ParallelOutboundMigrationsPerNode != nil {
+		in, out := &in.ParallelOutboundMigrationsPerNode, &out.ParallelOutboundMigrationsPerNode
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]v1.MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -152,6 +220,18 @@ func (in *MigrationPolicySpec) DeepCopy() *MigrationPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicyStatus) DeepCopyInto(out *MigrationPolicyStatus) {
 	*out = *in
+	if in.VirtualMachineInstances != nil {
+		in, out := &in.VirtualMachineInstances, &out.VirtualMachineInstances
+		*out = make([]MigrationPolicyVirtualMachineInstance, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]MigrationPolicyConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -165,6 +245,22 @@ func (in *MigrationPolicyStatus) DeepCopy() *MigrationPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationPolicyVirtualMachineInstance) DeepCopyInto(out *MigrationPolicyVirtualMachineInstance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyVirtualMachineInstance.
+func (in *MigrationPolicyVirtualMachineInstance) DeepCopy() *MigrationPolicyVirtualMachineInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationPolicyVirtualMachineInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Selectors) DeepCopyInto(out *Selectors) {
 	*out = *in
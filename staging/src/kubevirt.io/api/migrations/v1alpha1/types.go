@@ -43,6 +43,11 @@ type MigrationPolicy struct {
 type MigrationPolicySpec struct {
 	Selectors *Selectors `json:"selectors"`
 
+	// Priority breaks the tie between policies that match a VMI with the same number of labels.
+	// The policy with the highest priority is applied. Defaults to 0
+	//+optional
+	Priority *int32 `json:"priority,omitempty"`
+
 	//+optional
 	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
 	//+optional
@@ -51,6 +56,38 @@ type MigrationPolicySpec struct {
 	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
 	//+optional
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+	//+optional
+	AllowAdaptiveTuning *bool `json:"allowAdaptiveTuning,omitempty"`
+	// ProgressTimeout is the maximum number of seconds a live migration of the matched VMIs is allowed
+	// to make no progress before it is cancelled. Overrides the cluster-wide value.
+	//+optional
+	ProgressTimeout *int64 `json:"progressTimeout,omitempty"`
+	// UnsafeMigrationOverride allows live migrations of the matched VMIs to occur even if the
+	// compatibility check indicates the migration will be unsafe to the guest. Overrides the
+	// cluster-wide value.
+	//+optional
+	UnsafeMigrationOverride *bool `json:"unsafeMigrationOverride,omitempty"`
+	// DisableTLS disables the additional layer of encryption KubeVirt provides for live migrations
+	// of the matched VMIs. Overrides the cluster-wide value.
+	//+optional
+	DisableTLS *bool `json:"disableTLS,omitempty"`
+	// Network is the name of the CNI network to use for the migrations of the matched VMIs.
+	// virt-handler pods have to be attached to this network.
+	//+optional
+	Network *string `json:"network,omitempty"`
+	// ParallelMigrationsPerCluster is the total number of concurrent live migrations of the VMIs
+	// matched by this policy allowed cluster-wide, on top of the cluster-wide limit.
+	//+optional
+	ParallelMigrationsPerCluster *uint32 `json:"parallelMigrationsPerCluster,omitempty"`
+	// ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations
+	// of the VMIs matched by this policy allowed per node, on top of the cluster-wide limit.
+	//+optional
+	ParallelOutboundMigrationsPerNode *uint32 `json:"parallelOutboundMigrationsPerNode,omitempty"`
+	// MaintenanceWindows restricts when migrations of the matched VMIs that are not urgent are allowed
+	// to start. They replace the cluster-wide maintenance windows.
+	//+listType=atomic
+	//+optional
+	MaintenanceWindows []k6tv1.MaintenanceWindow `json:"maintenanceWindows,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -63,6 +100,36 @@ type Selectors struct {
 }
 
 type MigrationPolicyStatus struct {
+	// MatchedVirtualMachineInstances is the number of VMIs this policy currently applies to
+	//+optional
+	MatchedVirtualMachineInstances int32 `json:"matchedVirtualMachineInstances,omitempty"`
+	// VirtualMachineInstances lists the VMIs this policy currently applies to.
+	// The list is sorted by namespace and name, and truncated to 100 entries.
+	// +listType=atomic
+	//+optional
+	VirtualMachineInstances []MigrationPolicyVirtualMachineInstance `json:"virtualMachineInstances,omitempty"`
+	// Conflicts lists the VMIs that other policies match with the same precedence as this policy.
+	// The list is sorted by namespace and name, and truncated to 100 entries.
+	// +listType=atomic
+	//+optional
+	Conflicts []MigrationPolicyConflict `json:"conflicts,omitempty"`
+}
+
+// MigrationPolicyVirtualMachineInstance references a VMI matched by a migration policy
+type MigrationPolicyVirtualMachineInstance struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// MigrationPolicyConflict describes a VMI matched by several policies with the same precedence,
+// i.e. the same number of matching labels and the same priority
+type MigrationPolicyConflict struct {
+	VirtualMachineInstance MigrationPolicyVirtualMachineInstance `json:"virtualMachineInstance"`
+	// Policies are the names of all the policies that match the VMI with the same precedence
+	// +listType=atomic
+	Policies []string `json:"policies"`
+	// AppliedPolicy is the policy that is applied to the VMI, chosen by name in lexicographic order
+	AppliedPolicy string `json:"appliedPolicy"`
 }
 
 // MigrationPolicyList is a list of MigrationPolicy
@@ -99,6 +166,36 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		changed = true
 		*clusterMigrationConfigurations.AllowPostCopy = *policySpec.AllowPostCopy
 	}
+	if policySpec.AllowAdaptiveTuning != nil {
+		changed = true
+		allowAdaptiveTuning := *policySpec.AllowAdaptiveTuning
+		clusterMigrationConfigurations.AllowAdaptiveTuning = &allowAdaptiveTuning
+	}
+
+	if policySpec.ProgressTimeout != nil {
+		changed = true
+		progressTimeout := *policySpec.ProgressTimeout
+		clusterMigrationConfigurations.ProgressTimeout = &progressTimeout
+	}
+	if policySpec.UnsafeMigrationOverride != nil {
+		changed = true
+		unsafeMigrationOverride := *policySpec.UnsafeMigrationOverride
+		clusterMigrationConfigurations.UnsafeMigrationOverride = &unsafeMigrationOverride
+	}
+	if policySpec.DisableTLS != nil {
+		changed = true
+		disableTLS := *policySpec.DisableTLS
+		clusterMigrationConfigurations.DisableTLS = &disableTLS
+	}
+	if policySpec.Network != nil {
+		changed = true
+		network := *policySpec.Network
+		clusterMigrationConfigurations.Network = &network
+	}
+	if policySpec.MaintenanceWindows != nil {
+		changed = true
+		clusterMigrationConfigurations.MaintenanceWindows = append([]k6tv1.MaintenanceWindow{}, policySpec.MaintenanceWindows...)
+	}
 
 	return changed, nil
 }
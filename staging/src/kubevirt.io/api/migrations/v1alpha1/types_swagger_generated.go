@@ -11,10 +11,19 @@ func (MigrationPolicy) SwaggerDoc() map[string]string {
 
 func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"allowAutoConverge":       "+optional",
-		"bandwidthPerMigration":   "+optional",
-		"completionTimeoutPerGiB": "+optional",
-		"allowPostCopy":           "+optional",
+		"priority":                          "Priority breaks the tie between policies that match a VMI with the same number of labels.\nThe policy with the highest priority is applied. Defaults to 0\n+optional",
+		"allowAutoConverge":                 "+optional",
+		"bandwidthPerMigration":             "+optional",
+		"completionTimeoutPerGiB":           "+optional",
+		"allowPostCopy":                     "+optional",
+		"allowAdaptiveTuning":               "+optional",
+		"progressTimeout":                   "ProgressTimeout is the maximum number of seconds a live migration of the matched VMIs is allowed\nto make no progress before it is cancelled. Overrides the cluster-wide value.\n+optional",
+		"unsafeMigrationOverride":           "UnsafeMigrationOverride allows live migrations of the matched VMIs to occur even if the\ncompatibility check indicates the migration will be unsafe to the guest. Overrides the\ncluster-wide value.\n+optional",
+		"disableTLS":                        "DisableTLS disables the additional layer of encryption KubeVirt provides for live migrations\nof the matched VMIs. Overrides the cluster-wide value.\n+optional",
+		"network":                           "Network is the name of the CNI network to use for the migrations of the matched VMIs.\nvirt-handler pods have to be attached to this network.\n+optional",
+		"parallelMigrationsPerCluster":      "ParallelMigrationsPerCluster is the total number of concurrent live migrations of the VMIs\nmatched by this policy allowed cluster-wide, on top of the cluster-wide limit.\n+optional",
+		"parallelOutboundMigrationsPerNode": "ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations\nof the VMIs matched by this policy allowed per node, on top of the cluster-wide limit.\n+optional",
+		"maintenanceWindows":                "MaintenanceWindows restricts when migrations of the matched VMIs that are not urgent are allowed\nto start. They replace the cluster-wide maintenance windows.\n+listType=atomic\n+optional",
 	}
 }
 
@@ -26,7 +35,25 @@ func (Selectors) SwaggerDoc() map[string]string {
 }
 
 func (MigrationPolicyStatus) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"matchedVirtualMachineInstances": "MatchedVirtualMachineInstances is the number of VMIs this policy currently applies to\n+optional",
+		"virtualMachineInstances":        "VirtualMachineInstances lists the VMIs this policy currently applies to.\nThe list is sorted by namespace and name, and truncated to 100 entries.\n+listType=atomic\n+optional",
+		"conflicts":                      "Conflicts lists the VMIs that other policies match with the same precedence as this policy.\nThe list is sorted by namespace and name, and truncated to 100 entries.\n+listType=atomic\n+optional",
+	}
+}
+
+func (MigrationPolicyVirtualMachineInstance) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MigrationPolicyVirtualMachineInstance references a VMI matched by a migration policy",
+	}
+}
+
+func (MigrationPolicyConflict) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "MigrationPolicyConflict describes a VMI matched by several policies with the same precedence,\ni.e. the same number of matching labels and the same priority",
+		"policies":      "Policies are the names of all the policies that match the VMI with the same precedence\n+listType=atomic",
+		"appliedPolicy": "AppliedPolicy is the policy that is applied to the VMI, chosen by name in lexicographic order",
+	}
 }
 
 func (MigrationPolicyList) SwaggerDoc() map[string]string {
@@ -22,12 +22,24 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
 
 const (
 	VirtualMachinePoolKind = "VirtualMachinePool"
+
+	// VirtualMachinePoolDeletionCostAnnotation can be set on vms of a pool to influence
+	// which vms are removed first when the pool scales in. Vms with a lower cost are
+	// preferred for deletion. The value must be a 32 bit integer, invalid values are
+	// treated like a cost of 0, which is also the default.
+	VirtualMachinePoolDeletionCostAnnotation = "pool.kubevirt.io/deletion-cost"
+
+	// VirtualMachinePoolDataVolumeRetentionFinalizer is added to stateful pools which retain
+	// their DataVolumes on deletion. It makes sure that the vms of the pool are removed without
+	// their DataVolumes before the pool is gone.
+	VirtualMachinePoolDataVolumeRetentionFinalizer = "pool.kubevirt.io/datavolume-retention"
 )
 
 // VirtualMachinePool resource contains a VirtualMachine configuration
@@ -64,6 +76,11 @@ const (
 	// VirtualMachinePoolReplicaPaused is added in a pool when the pool got paused by the controller.
 	// After this condition was added, it is safe to remove or add vms by hand and adjust the replica count manually
 	VirtualMachinePoolReplicaPaused VirtualMachinePoolConditionType = "ReplicaPaused"
+
+	// VirtualMachinePoolUpdatePaused is added in a pool when a rolling update got halted
+	// because an already updated vm failed and the update strategy requests to pause on failure.
+	// The rollout continues once the failed vms recover or the pool template changes again.
+	VirtualMachinePoolUpdatePaused VirtualMachinePoolConditionType = "UpdatePaused"
 )
 
 // +k8s:openapi-gen=true
@@ -89,6 +106,163 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// UpdatedReplicas is the number of vms which are running the current pool revision.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// AvailableReplicas is the number of ready vms which are not being deleted.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty" optional:"true"`
+
+	// OutdatedReplicas is the number of vms which still have to be updated to the current pool revision.
+	// +optional
+	OutdatedReplicas int32 `json:"outdatedReplicas,omitempty" optional:"true"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategyType string
+
+const (
+	// VirtualMachinePoolOpportunisticUpdateStrategyType updates all outdated vms of a pool at once.
+	// This is the default.
+	VirtualMachinePoolOpportunisticUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "Opportunistic"
+
+	// VirtualMachinePoolRollingUpdateStrategyType updates outdated vms of a pool in a
+	// controlled way, limited by the RollingUpdate parameters.
+	VirtualMachinePoolRollingUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "RollingUpdate"
+)
+
+// VirtualMachinePoolUpdateStrategy defines how outdated vms of a pool get updated
+// to the current pool template.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// Type of the update strategy. Can be "Opportunistic" or "RollingUpdate".
+	// Defaults to Opportunistic.
+	// +optional
+	Type VirtualMachinePoolUpdateStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate holds the parameters of the rolling update.
+	// Only valid if Type is RollingUpdate.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// The maximum number of vms that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired
+	// replicas (ex: 10%). Absolute number is calculated from percentage by rounding down.
+	// This can not be 0 if MaxSurge is 0.
+	// Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of vms that can be created above the desired replicas
+	// during the update. Outdated vms are deleted instead of updated in place
+	// once their replacements became ready.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired
+	// replicas (ex: 10%). Absolute number is calculated from percentage by rounding up.
+	// Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// Partition indicates the index at which the pool is partitioned for updates.
+	// Only vms with an index greater than or equal to the partition are updated.
+	// All other vms stay on their current revision.
+	// Defaults to 0.
+	// +optional
+	Partition *int32 `json:"partition,omitempty"`
+
+	// PauseOnFailure halts the rolling update when a vm which already runs
+	// the current pool revision fails, and reports the UpdatePaused condition.
+	// +optional
+	PauseOnFailure bool `json:"pauseOnFailure,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInPolicy string
+
+const (
+	// VirtualMachinePoolRandomScaleInPolicy removes randomly selected vms on scale in.
+	// This is the default.
+	VirtualMachinePoolRandomScaleInPolicy VirtualMachinePoolScaleInPolicy = "Random"
+
+	// VirtualMachinePoolNewestScaleInPolicy removes the most recently created vms first.
+	VirtualMachinePoolNewestScaleInPolicy VirtualMachinePoolScaleInPolicy = "Newest"
+
+	// VirtualMachinePoolOldestScaleInPolicy removes the least recently created vms first.
+	VirtualMachinePoolOldestScaleInPolicy VirtualMachinePoolScaleInPolicy = "Oldest"
+
+	// VirtualMachinePoolNotReadyScaleInPolicy removes vms which are not ready first.
+	VirtualMachinePoolNotReadyScaleInPolicy VirtualMachinePoolScaleInPolicy = "NotReady"
+
+	// VirtualMachinePoolNodeSpreadScaleInPolicy removes vms from the most crowded zones
+	// and nodes first, to keep the remaining vms evenly spread across the cluster.
+	VirtualMachinePoolNodeSpreadScaleInPolicy VirtualMachinePoolScaleInPolicy = "NodeSpread"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolManagementPolicyType string
+
+const (
+	// VirtualMachinePoolOrderedReadyManagementPolicy creates vms one at a time in increasing
+	// index order, each only after all vms with a lower index are ready. Vms are removed one
+	// at a time in decreasing index order. This is the default for stateful pools.
+	VirtualMachinePoolOrderedReadyManagementPolicy VirtualMachinePoolManagementPolicyType = "OrderedReady"
+
+	// VirtualMachinePoolParallelManagementPolicy creates and removes vms in parallel.
+	VirtualMachinePoolParallelManagementPolicy VirtualMachinePoolManagementPolicyType = "Parallel"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolDataVolumeRetentionPolicyType string
+
+const (
+	// VirtualMachinePoolRetainDataVolumeRetentionPolicy keeps the DataVolumes of a vm when the vm is removed.
+	// The DataVolumes are orphaned, a vm which is re-created with the same index adopts them again.
+	VirtualMachinePoolRetainDataVolumeRetentionPolicy VirtualMachinePoolDataVolumeRetentionPolicyType = "Retain"
+
+	// VirtualMachinePoolDeleteDataVolumeRetentionPolicy removes the DataVolumes of a vm together with the vm.
+	VirtualMachinePoolDeleteDataVolumeRetentionPolicy VirtualMachinePoolDataVolumeRetentionPolicyType = "Delete"
+)
+
+// VirtualMachinePoolDataVolumeRetentionPolicy defines what happens to the DataVolumes
+// created from the DataVolumeTemplates of the pool vms when the vms are removed.
+// Retained DataVolumes lose their owner reference. The vm re-created with the same index
+// becomes their owner again, retained DataVolumes which are not adopted, like the ones of
+// a deleted pool, are not garbage collected and have to be deleted manually.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolDataVolumeRetentionPolicy struct {
+	// WhenDeleted defines what happens to the DataVolumes when the pool is deleted.
+	// Can be "Retain" or "Delete". Defaults to Retain.
+	// Retaining DataVolumes on deletion is not supported with foreground cascading deletion of the pool.
+	// +optional
+	WhenDeleted VirtualMachinePoolDataVolumeRetentionPolicyType `json:"whenDeleted,omitempty"`
+
+	// WhenScaled defines what happens to the DataVolumes when the pool is scaled in.
+	// Can be "Retain" or "Delete". Defaults to Retain.
+	// +optional
+	WhenScaled VirtualMachinePoolDataVolumeRetentionPolicyType `json:"whenScaled,omitempty"`
+}
+
+// VirtualMachinePoolStatefulPolicy makes a pool behave like a StatefulSet. Every index
+// keeps the DataVolumes created from the DataVolumeTemplates of its vm across removal
+// and re-creation of the vm.
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolStatefulPolicy struct {
+	// ManagementPolicy defines the order in which vms are created and removed.
+	// Can be "OrderedReady" or "Parallel". Defaults to OrderedReady.
+	// +optional
+	ManagementPolicy VirtualMachinePoolManagementPolicyType `json:"managementPolicy,omitempty"`
+
+	// DataVolumeRetentionPolicy defines what happens to the DataVolumes of the vms
+	// when the pool is scaled in or deleted.
+	// +optional
+	DataVolumeRetentionPolicy *VirtualMachinePoolDataVolumeRetentionPolicy `json:"dataVolumeRetentionPolicy,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -103,11 +277,29 @@ type VirtualMachinePoolSpec struct {
 	Selector *metav1.LabelSelector `json:"selector" valid:"required"`
 
 	// Template describes the VM that will be created.
+	// Occurrences of {{ .Index }} in the cloud-init userData and networkData of the
+	// template are replaced with the index of the VM.
 	VirtualMachineTemplate *VirtualMachineTemplateSpec `json:"virtualMachineTemplate" valid:"required"`
 
 	// Indicates that the pool is paused.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+
+	// UpdateStrategy defines how outdated vms are updated to the current pool template.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// ScaleInPolicy defines which vms are removed first when the pool scales in.
+	// Can be "Random", "Newest", "Oldest", "NotReady" or "NodeSpread". Defaults to Random.
+	// Vms with a lower pool.kubevirt.io/deletion-cost annotation are always removed
+	// before vms with a higher one, regardless of the policy.
+	// +optional
+	ScaleInPolicy VirtualMachinePoolScaleInPolicy `json:"scaleInPolicy,omitempty"`
+
+	// StatefulPolicy turns the pool into a stateful pool, in which every index keeps its
+	// DataVolumes and vms are created and removed in index order.
+	// +optional
+	StatefulPolicy *VirtualMachinePoolStatefulPolicy `json:"statefulPolicy,omitempty"`
 }
 
 // VirtualMachinePoolList is a list of VirtualMachinePool resources.
@@ -26,9 +26,46 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                  "+k8s:openapi-gen=true",
+		"conditions":        "+listType=atomic",
+		"labelSelector":     "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updatedReplicas":   "UpdatedReplicas is the number of vms which are running the current pool revision.\n+optional",
+		"availableReplicas": "AvailableReplicas is the number of ready vms which are not being deleted.\n+optional",
+		"outdatedReplicas":  "OutdatedReplicas is the number of vms which still have to be updated to the current pool revision.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachinePoolUpdateStrategy defines how outdated vms of a pool get updated\nto the current pool template.\n\n+k8s:openapi-gen=true",
+		"type":          "Type of the update strategy. Can be \"Opportunistic\" or \"RollingUpdate\".\nDefaults to Opportunistic.\n+optional",
+		"rollingUpdate": "RollingUpdate holds the parameters of the rolling update.\nOnly valid if Type is RollingUpdate.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"maxUnavailable": "The maximum number of vms that can be unavailable during the update.\nValue can be an absolute number (ex: 5) or a percentage of the desired\nreplicas (ex: 10%). Absolute number is calculated from percentage by rounding down.\nThis can not be 0 if MaxSurge is 0.\nDefaults to 1.\n+optional",
+		"maxSurge":       "The maximum number of vms that can be created above the desired replicas\nduring the update. Outdated vms are deleted instead of updated in place\nonce their replacements became ready.\nValue can be an absolute number (ex: 5) or a percentage of the desired\nreplicas (ex: 10%). Absolute number is calculated from percentage by rounding up.\nDefaults to 0.\n+optional",
+		"partition":      "Partition indicates the index at which the pool is partitioned for updates.\nOnly vms with an index greater than or equal to the partition are updated.\nAll other vms stay on their current revision.\nDefaults to 0.\n+optional",
+		"pauseOnFailure": "PauseOnFailure halts the rolling update when a vm which already runs\nthe current pool revision fails, and reports the UpdatePaused condition.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolDataVolumeRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachinePoolDataVolumeRetentionPolicy defines what happens to the DataVolumes\ncreated from the DataVolumeTemplates of the pool vms when the vms are removed.\nRetained DataVolumes lose their owner reference. The vm re-created with the same index\nbecomes their owner again, retained DataVolumes which are not adopted, like the ones of\na deleted pool, are not garbage collected and have to be deleted manually.\n\n+k8s:openapi-gen=true",
+		"whenDeleted": "WhenDeleted defines what happens to the DataVolumes when the pool is deleted.\nCan be \"Retain\" or \"Delete\". Defaults to Retain.\nRetaining DataVolumes on deletion is not supported with foreground cascading deletion of the pool.\n+optional",
+		"whenScaled":  "WhenScaled defines what happens to the DataVolumes when the pool is scaled in.\nCan be \"Retain\" or \"Delete\". Defaults to Retain.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolStatefulPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "VirtualMachinePoolStatefulPolicy makes a pool behave like a StatefulSet. Every index\nkeeps the DataVolumes created from the DataVolumeTemplates of its vm across removal\nand re-creation of the vm.\n\n+k8s:openapi-gen=true",
+		"managementPolicy":          "ManagementPolicy defines the order in which vms are created and removed.\nCan be \"OrderedReady\" or \"Parallel\". Defaults to OrderedReady.\n+optional",
+		"dataVolumeRetentionPolicy": "DataVolumeRetentionPolicy defines what happens to the DataVolumes of the vms\nwhen the pool is scaled in or deleted.\n+optional",
 	}
 }
 
@@ -37,8 +74,11 @@ func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 		"":                       "+k8s:openapi-gen=true",
 		"replicas":               "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
 		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
-		"virtualMachineTemplate": "Template describes the VM that will be created.",
+		"virtualMachineTemplate": "Template describes the VM that will be created.\nOccurrences of {{ .Index }} in the cloud-init userData and networkData of the\ntemplate are replaced with the index of the VM.",
 		"paused":                 "Indicates that the pool is paused.\n+optional",
+		"updateStrategy":         "UpdateStrategy defines how outdated vms are updated to the current pool template.\n+optional",
+		"scaleInPolicy":          "ScaleInPolicy defines which vms are removed first when the pool scales in.\nCan be \"Random\", \"Newest\", \"Oldest\", \"NotReady\" or \"NodeSpread\". Defaults to Random.\nVms with a lower pool.kubevirt.io/deletion-cost annotation are always removed\nbefore vms with a higher one, regardless of the policy.\n+optional",
+		"statefulPolicy":         "StatefulPolicy turns the pool into a stateful pool, in which every index keeps its\nDataVolumes and vms are created and removed in index order.\n+optional",
 	}
 }
 
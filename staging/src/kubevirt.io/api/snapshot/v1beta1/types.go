@@ -71,6 +71,103 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// Hooks are commands run in the guest through the guest agent
+	// around the freeze of the guest file systems.
+	// Only users allowed to run commands in the guest through the
+	// virtualmachineinstances/guestexec subresource can set them
+	// +optional
+	Hooks *SnapshotHooks `json:"hooks,omitempty"`
+}
+
+// SnapshotHooks are the commands run in the guest when taking an online snapshot
+type SnapshotHooks struct {
+	// PreFreeze hooks are run in order before the guest file systems are frozen
+	// +optional
+	// +listType=atomic
+	PreFreeze []SnapshotHook `json:"preFreeze,omitempty"`
+
+	// PostThaw hooks are run in order after the guest file systems are thawed.
+	// They are also run when a pre freeze hook aborts the snapshot, and a failed
+	// post thaw hook does not prevent the next ones from running
+	// +optional
+	// +listType=atomic
+	PostThaw []SnapshotHook `json:"postThaw,omitempty"`
+}
+
+// SnapshotHook is a command run in the guest
+type SnapshotHook struct {
+	// Name identifies the hook in the snapshot status
+	Name string `json:"name"`
+
+	// Command is the path of the executable to run in the guest followed by its arguments
+	// +listType=atomic
+	Command []string `json:"command"`
+
+	// Timeout is the time the command is allowed to run.
+	// Defaults to 30s, at most 5m
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailurePolicy is what to do when the command fails or times out.
+	// Defaults to Abort
+	// +optional
+	FailurePolicy *SnapshotHookFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// SnapshotHookFailurePolicy defines what to do when a snapshot hook fails
+type SnapshotHookFailurePolicy string
+
+const (
+	// SnapshotHookFailurePolicyAbort fails the snapshot when the hook fails
+	SnapshotHookFailurePolicyAbort SnapshotHookFailurePolicy = "Abort"
+
+	// SnapshotHookFailurePolicyContinue records the failure and continues the snapshot
+	SnapshotHookFailurePolicyContinue SnapshotHookFailurePolicy = "Continue"
+)
+
+// SnapshotHookStage is the point of the snapshot at which a hook is run
+type SnapshotHookStage string
+
+const (
+	SnapshotHookStagePreFreeze SnapshotHookStage = "PreFreeze"
+	SnapshotHookStagePostThaw  SnapshotHookStage = "PostThaw"
+)
+
+// SnapshotHookPhase is the outcome of a snapshot hook
+type SnapshotHookPhase string
+
+const (
+	SnapshotHookRunning   SnapshotHookPhase = "Running"
+	SnapshotHookSucceeded SnapshotHookPhase = "Succeeded"
+	SnapshotHookFailed    SnapshotHookPhase = "Failed"
+)
+
+// SnapshotHookResult is the result of a snapshot hook
+type SnapshotHookResult struct {
+	Name string `json:"name"`
+
+	Stage SnapshotHookStage `json:"stage"`
+
+	Phase SnapshotHookPhase `json:"phase"`
+
+	// +optional
+	// +nullable
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// Output is the end of the standard output of the command
+	// +optional
+	Output string `json:"output,omitempty"`
+
+	// +optional
+	Message *string `json:"message,omitempty"`
 }
 
 // Indication is a way to indicate the state of the vm when taking the snapshot
@@ -125,6 +222,10 @@ type VirtualMachineSnapshotStatus struct {
 
 	// +optional
 	SnapshotVolumes *SnapshotVolumesLists `json:"snapshotVolumes,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	HookResults []SnapshotHookResult `json:"hookResults,omitempty"`
 }
 
 // SnapshotVolumesLists includes the list of volumes which were included in the snapshot and volumes which were excluded from the snapshot
@@ -270,6 +371,10 @@ type VirtualMachineSnapshotContentStatus struct {
 	// +optional
 	// +listType=atomic
 	VolumeSnapshotStatus []VolumeSnapshotStatus `json:"volumeSnapshotStatus,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	HookResults []SnapshotHookResult `json:"hookResults,omitempty"`
 }
 
 // VirtualMachineSnapshotContentList is a list of VirtualMachineSnapshot resources
@@ -324,8 +429,34 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// Mode selects what is restored from the snapshot, the VM spec and its volumes by default
+	// +optional
+	Mode *VirtualMachineRestoreMode `json:"mode,omitempty"`
+
+	// VolumeNames restricts the restore to the named volumes of the snapshot, all the volumes
+	// are restored when empty. The other volumes of the target keep their current source.
+	// +optional
+	// +listType=set
+	VolumeNames []string `json:"volumeNames,omitempty"`
 }
 
+// VirtualMachineRestoreMode defines what is restored from a snapshot
+type VirtualMachineRestoreMode string
+
+const (
+	// RestoreModeFull restores the VM spec and the volumes
+	RestoreModeFull VirtualMachineRestoreMode = "Full"
+	// RestoreModeSpecOnly restores the VM spec and keeps the current volumes of the VM
+	RestoreModeSpecOnly VirtualMachineRestoreMode = "SpecOnly"
+	// RestoreModeVolumesOnly restores the volumes as new standalone PVCs and leaves the VM untouched,
+	// the restore completes once the PVCs are bound
+	RestoreModeVolumesOnly VirtualMachineRestoreMode = "VolumesOnly"
+	// RestoreModeOnline restores the selected data volumes of a running VM by hot swapping them,
+	// the VM spec is left untouched
+	RestoreModeOnline VirtualMachineRestoreMode = "Online"
+)
+
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
 type VirtualMachineRestoreStatus struct {
 	// +optional
@@ -367,3 +498,121 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// SnapshotScheduleLabel is set on the VirtualMachineSnapshots created by a VirtualMachineSnapshotSchedule,
+// with the name of the schedule
+const SnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+// VirtualMachineSnapshotSchedule defines the periodic snapshotting of VMs
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule is a cron expression with the five standard fields, like "0 2 * * *", evaluated in UTC.
+	// The @hourly, @daily, @weekly, @monthly and @yearly macros are accepted as well.
+	Schedule string `json:"schedule"`
+
+	// Selector selects the VirtualMachines of the namespace of the schedule which are snapshotted
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Disabled suspends the creation of snapshots, retention still applies
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Retention defines which of the snapshots created by the schedule are kept.
+	// Without retention, all the snapshots are kept.
+	// +optional
+	Retention *SnapshotRetentionPolicy `json:"retention,omitempty"`
+
+	// DeletionPolicy is set on the created VirtualMachineSnapshots
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// FailureDeadline is set on the created VirtualMachineSnapshots
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// SnapshotRetentionPolicy defines which of the snapshots of a VM created by a schedule are kept.
+// A snapshot is kept as long as any of the keep rules selects it and it is not older than MaxAge.
+// When none of the keep rules is set, all the snapshots not older than MaxAge are kept.
+// Failed snapshots are deleted once a more recent snapshot of the VM succeeded. Until then, the policy applies to
+// them separately from the succeeded snapshots.
+type SnapshotRetentionPolicy struct {
+	// Last is the number of most recent snapshots which are kept, at least 1
+	// +optional
+	Last *int32 `json:"last,omitempty"`
+
+	// Daily is the number of days for which the most recent snapshot of the day is kept
+	// +optional
+	Daily *int32 `json:"daily,omitempty"`
+
+	// Weekly is the number of weeks for which the most recent snapshot of the week is kept
+	// +optional
+	Weekly *int32 `json:"weekly,omitempty"`
+
+	// Monthly is the number of months for which the most recent snapshot of the month is kept
+	// +optional
+	Monthly *int32 `json:"monthly,omitempty"`
+
+	// MaxAge is the age after which snapshots are deleted, whatever the keep rules
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	VirtualMachines []VirtualMachineSnapshotScheduleVMStatus `json:"virtualMachines,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleVMStatus is the status of the snapshots of a VM created by a schedule
+type VirtualMachineSnapshotScheduleVMStatus struct {
+	// Name is the name of the VirtualMachine
+	Name string `json:"name"`
+
+	// +optional
+	LastSnapshotName *string `json:"lastSnapshotName,omitempty"`
+
+	// +optional
+	// +nullable
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// +optional
+	LastSuccessfulSnapshotName *string `json:"lastSuccessfulSnapshotName,omitempty"`
+
+	// +optional
+	LastFailure *Error `json:"lastFailure,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
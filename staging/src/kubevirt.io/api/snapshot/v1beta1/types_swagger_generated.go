@@ -14,6 +14,36 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"hooks":           "Hooks are commands run in the guest through the guest agent\naround the freeze of the guest file systems.\nOnly users allowed to run commands in the guest through the\nvirtualmachineinstances/guestexec subresource can set them\n+optional",
+	}
+}
+
+func (SnapshotHooks) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "SnapshotHooks are the commands run in the guest when taking an online snapshot",
+		"preFreeze": "PreFreeze hooks are run in order before the guest file systems are frozen\n+optional\n+listType=atomic",
+		"postThaw":  "PostThaw hooks are run in order after the guest file systems are thawed.\nThey are also run when a pre freeze hook aborts the snapshot, and a failed\npost thaw hook does not prevent the next ones from running\n+optional\n+listType=atomic",
+	}
+}
+
+func (SnapshotHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "SnapshotHook is a command run in the guest",
+		"name":          "Name identifies the hook in the snapshot status",
+		"command":       "Command is the path of the executable to run in the guest followed by its arguments\n+listType=atomic",
+		"timeout":       "Timeout is the time the command is allowed to run.\nDefaults to 30s, at most 5m\n+optional",
+		"failurePolicy": "FailurePolicy is what to do when the command fails or times out.\nDefaults to Abort\n+optional",
+	}
+}
+
+func (SnapshotHookResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "SnapshotHookResult is the result of a snapshot hook",
+		"startTime":      "+optional\n+nullable",
+		"completionTime": "+optional\n+nullable",
+		"exitCode":       "+optional",
+		"output":         "Output is the end of the standard output of the command\n+optional",
+		"message":        "+optional",
 	}
 }
 
@@ -29,6 +59,7 @@ func (VirtualMachineSnapshotStatus) SwaggerDoc() map[string]string {
 		"conditions":                        "+optional\n+listType=atomic",
 		"indications":                       "+optional\n+listType=set",
 		"snapshotVolumes":                   "+optional",
+		"hookResults":                       "+optional\n+listType=atomic",
 	}
 }
 
@@ -112,6 +143,7 @@ func (VirtualMachineSnapshotContentStatus) SwaggerDoc() map[string]string {
 		"readyToUse":           "+optional",
 		"error":                "+optional",
 		"volumeSnapshotStatus": "+optional\n+listType=atomic",
+		"hookResults":          "+optional\n+listType=atomic",
 	}
 }
 
@@ -139,9 +171,11 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":        "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target":  "initially only VirtualMachine type supported",
-		"patches": "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"":            "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+		"target":      "initially only VirtualMachine type supported",
+		"patches":     "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"mode":        "Mode selects what is restored from the snapshot, the VM spec and its volumes by default\n+optional",
+		"volumeNames": "VolumeNames restricts the restore to the named volumes of the snapshot, all the volumes\nare restored when empty. The other volumes of the target keep their current source.\n+optional\n+listType=set",
 	}
 }
 
@@ -168,3 +202,60 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule defines the periodic snapshotting of VMs\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":        "Schedule is a cron expression with the five standard fields, like \"0 2 * * *\", evaluated in UTC.\nThe @hourly, @daily, @weekly, @monthly and @yearly macros are accepted as well.",
+		"selector":        "Selector selects the VirtualMachines of the namespace of the schedule which are snapshotted",
+		"disabled":        "Disabled suspends the creation of snapshots, retention still applies\n+optional",
+		"retention":       "Retention defines which of the snapshots created by the schedule are kept.\nWithout retention, all the snapshots are kept.\n+optional",
+		"deletionPolicy":  "DeletionPolicy is set on the created VirtualMachineSnapshots\n+optional",
+		"failureDeadline": "FailureDeadline is set on the created VirtualMachineSnapshots\n+optional",
+	}
+}
+
+func (SnapshotRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "SnapshotRetentionPolicy defines which of the snapshots of a VM created by a schedule are kept.\nA snapshot is kept as long as any of the keep rules selects it and it is not older than MaxAge.\nWhen none of the keep rules is set, all the snapshots not older than MaxAge are kept.\nFailed snapshots are deleted once a more recent snapshot of the VM succeeded. Until then, the policy applies to\nthem separately from the succeeded snapshots.",
+		"last":    "Last is the number of most recent snapshots which are kept, at least 1\n+optional",
+		"daily":   "Daily is the number of days for which the most recent snapshot of the day is kept\n+optional",
+		"weekly":  "Weekly is the number of weeks for which the most recent snapshot of the week is kept\n+optional",
+		"monthly": "Monthly is the number of months for which the most recent snapshot of the month is kept\n+optional",
+		"maxAge":  "MaxAge is the age after which snapshots are deleted, whatever the keep rules\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "+optional\n+nullable",
+		"nextScheduleTime": "+optional\n+nullable",
+		"error":            "+optional",
+		"virtualMachines":  "+optional\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleVMStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "VirtualMachineSnapshotScheduleVMStatus is the status of the snapshots of a VM created by a schedule",
+		"name":                       "Name is the name of the VirtualMachine",
+		"lastSnapshotName":           "+optional",
+		"lastSuccessTime":            "+optional\n+nullable",
+		"lastSuccessfulSnapshotName": "+optional",
+		"lastFailure":                "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -28,6 +28,7 @@ import (
 	kubevirtv1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/core/v1"
 	exportv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1beta1"
+	hooksv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/hooks/v1alpha1"
 	instancetypev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1"
@@ -43,6 +44,7 @@ type Interface interface {
 	KubevirtV1() kubevirtv1.KubevirtV1Interface
 	ExportV1alpha1() exportv1alpha1.ExportV1alpha1Interface
 	ExportV1beta1() exportv1beta1.ExportV1beta1Interface
+	HooksV1alpha1() hooksv1alpha1.HooksV1alpha1Interface
 	InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface
 	InstancetypeV1alpha2() instancetypev1alpha2.InstancetypeV1alpha2Interface
 	InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta1Interface
@@ -60,6 +62,7 @@ type Clientset struct {
 	kubevirtV1           *kubevirtv1.KubevirtV1Client
 	exportV1alpha1       *exportv1alpha1.ExportV1alpha1Client
 	exportV1beta1        *exportv1beta1.ExportV1beta1Client
+	hooksV1alpha1        *hooksv1alpha1.HooksV1alpha1Client
 	instancetypeV1alpha1 *instancetypev1alpha1.InstancetypeV1alpha1Client
 	instancetypeV1alpha2 *instancetypev1alpha2.InstancetypeV1alpha2Client
 	instancetypeV1beta1  *instancetypev1beta1.InstancetypeV1beta1Client
@@ -89,6 +92,11 @@ func (c *Clientset) ExportV1beta1() exportv1beta1.ExportV1beta1Interface {
 	return c.exportV1beta1
 }
 
+// HooksV1alpha1 retrieves the HooksV1alpha1Client
+func (c *Clientset) HooksV1alpha1() hooksv1alpha1.HooksV1alpha1Interface {
+	return c.hooksV1alpha1
+}
+
 // InstancetypeV1alpha1 retrieves the InstancetypeV1alpha1Client
 func (c *Clientset) InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface {
 	return c.instancetypeV1alpha1
@@ -161,6 +169,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.hooksV1alpha1, err = hooksv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.instancetypeV1alpha1, err = instancetypev1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
@@ -205,6 +217,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 	cs.kubevirtV1 = kubevirtv1.NewForConfigOrDie(c)
 	cs.exportV1alpha1 = exportv1alpha1.NewForConfigOrDie(c)
 	cs.exportV1beta1 = exportv1beta1.NewForConfigOrDie(c)
+	cs.hooksV1alpha1 = hooksv1alpha1.NewForConfigOrDie(c)
 	cs.instancetypeV1alpha1 = instancetypev1alpha1.NewForConfigOrDie(c)
 	cs.instancetypeV1alpha2 = instancetypev1alpha2.NewForConfigOrDie(c)
 	cs.instancetypeV1beta1 = instancetypev1beta1.NewForConfigOrDie(c)
@@ -224,6 +237,7 @@ func New(c rest.Interface) *Clientset {
 	cs.kubevirtV1 = kubevirtv1.New(c)
 	cs.exportV1alpha1 = exportv1alpha1.New(c)
 	cs.exportV1beta1 = exportv1beta1.New(c)
+	cs.hooksV1alpha1 = hooksv1alpha1.New(c)
 	cs.instancetypeV1alpha1 = instancetypev1alpha1.New(c)
 	cs.instancetypeV1alpha2 = instancetypev1alpha2.New(c)
 	cs.instancetypeV1beta1 = instancetypev1beta1.New(c)
@@ -33,6 +33,8 @@ import (
 	fakeexportv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1/fake"
 	exportv1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1beta1"
 	fakeexportv1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1beta1/fake"
+	hooksv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/hooks/v1alpha1"
+	fakehooksv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/hooks/v1alpha1/fake"
 	instancetypev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
 	fakeinstancetypev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1/fake"
 	instancetypev1alpha2 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha2"
@@ -116,6 +118,11 @@ func (c *Clientset) ExportV1beta1() exportv1beta1.ExportV1beta1Interface {
 	return &fakeexportv1beta1.FakeExportV1beta1{Fake: &c.Fake}
 }
 
+// HooksV1alpha1 retrieves the HooksV1alpha1Client
+func (c *Clientset) HooksV1alpha1() hooksv1alpha1.HooksV1alpha1Interface {
+	return &fakehooksv1alpha1.FakeHooksV1alpha1{Fake: &c.Fake}
+}
+
 // InstancetypeV1alpha1 retrieves the InstancetypeV1alpha1Client
 func (c *Clientset) InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface {
 	return &fakeinstancetypev1alpha1.FakeInstancetypeV1alpha1{Fake: &c.Fake}
@@ -28,6 +28,7 @@ import (
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	exportv1alpha1 "kubevirt.io/api/export/v1alpha1"
 	exportv1beta1 "kubevirt.io/api/export/v1beta1"
+	hooksv1alpha1 "kubevirt.io/api/hooks/v1alpha1"
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
@@ -45,6 +46,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	kubevirtv1.AddToScheme,
 	exportv1alpha1.AddToScheme,
 	exportv1beta1.AddToScheme,
+	hooksv1alpha1.AddToScheme,
 	instancetypev1alpha1.AddToScheme,
 	instancetypev1alpha2.AddToScheme,
 	instancetypev1beta1.AddToScheme,
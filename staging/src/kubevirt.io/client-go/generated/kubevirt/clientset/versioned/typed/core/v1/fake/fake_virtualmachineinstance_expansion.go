@@ -85,6 +85,13 @@ func (c *FakeVirtualMachineInstances) SoftReboot(ctx context.Context, name strin
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.GuestExecOptions) (v1.GuestExecResult, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "guestexec", name, guestExecOptions), nil)
+
+	return v1.GuestExecResult{}, err
+}
+
 func (c *FakeVirtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	_, err := c.Fake.
 		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "guestosinfo", name), &v1.VirtualMachineInstanceGuestAgentInfo{})
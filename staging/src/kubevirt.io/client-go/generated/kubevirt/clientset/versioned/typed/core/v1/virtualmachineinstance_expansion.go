@@ -48,6 +48,7 @@ type VirtualMachineInstanceExpansion interface {
 	Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error
 	Unfreeze(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
+	GuestExec(ctx context.Context, name string, guestExecOptions *v1.GuestExecOptions) (v1.GuestExecResult, error)
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
@@ -188,6 +189,31 @@ func (c *virtualMachineInstances) SoftReboot(ctx context.Context, name string) e
 		Error()
 }
 
+func (c *virtualMachineInstances) GuestExec(ctx context.Context, name string, guestExecOptions *v1.GuestExecOptions) (v1.GuestExecResult, error) {
+	result := v1.GuestExecResult{}
+	body, err := json.Marshal(guestExecOptions)
+	if err != nil {
+		return result, err
+	}
+
+	// Unmarshal the raw response for the same reason as in GuestOsInfo
+	rawResult, err := c.client.Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.ns).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestexec").
+		Body(body).
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return result, err
+	}
+
+	err = json.Unmarshal(rawResult, &result)
+	return result, err
+}
+
 func (c *virtualMachineInstances) GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error) {
 	guestInfo := v1.VirtualMachineInstanceGuestAgentInfo{}
 	// WORKAROUND: